@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/ecto/internal/ghostapi"
 )
 
 var authCmd = &cobra.Command{
@@ -20,6 +21,7 @@ var authAddCmd = &cobra.Command{
 		name := args[0]
 		url, _ := cmd.Flags().GetString("url")
 		key, _ := cmd.Flags().GetString("key")
+		useKeyring, _ := cmd.Flags().GetBool("keyring")
 
 		if url == "" || key == "" {
 			return fmt.Errorf("--url 和 --key 是必需的")
@@ -30,11 +32,22 @@ var authAddCmd = &cobra.Command{
 			return err
 		}
 
+		// --keyring 时密钥存入系统钥匙串, config.json 只留占位符
+		if useKeyring {
+			if err := config.KeyringSet(name, key); err != nil {
+				return err
+			}
+			key = config.KeyringPlaceholder
+		}
+
 		if err := cfg.AddSite(name, url, key); err != nil {
 			return err
 		}
 
 		printf("已添加站点 %q\n", name)
+		if useKeyring {
+			println("API 密钥已存入系统钥匙串")
+		}
 		if cfg.DefaultSite == name {
 			println("已设置为默认站点")
 		}
@@ -113,13 +126,55 @@ var authRemoveCmd = &cobra.Command{
 	},
 }
 
+var authTestCmd = &cobra.Command{
+	Use:   "test [名称]",
+	Short: "测试站点连接和 API 权限",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := siteName
+		if len(args) > 0 {
+			name = args[0]
+		}
+
+		client, err := config.GetActiveClient(name)
+		if err != nil {
+			return err
+		}
+
+		info, err := client.GetSite()
+		if err != nil {
+			return fmt.Errorf("连接失败: %w", err)
+		}
+		printf("✓ 连接成功: %s\n", info.Title)
+		printf("  Ghost 版本: %s\n", info.Version)
+
+		// /settings/ 需要完整 Admin 权限, 用它探测密钥的权限范围
+		site, err := config.GetActiveSite(name)
+		if err != nil {
+			return err
+		}
+		api, err := ghostapi.NewClient(site.URL, site.APIKey)
+		if err != nil {
+			return err
+		}
+		if _, err := api.Get("/settings/", nil); err != nil {
+			printf("  权限范围: 受限 (无法读取设置: %v)\n", err)
+		} else {
+			println("  权限范围: 完整 Admin 访问")
+		}
+		return nil
+	},
+}
+
 func init() {
 	authAddCmd.Flags().String("url", "", "Ghost 站点 URL")
 	authAddCmd.Flags().String("key", "", "管理 API 密钥")
+	authAddCmd.Flags().Bool("keyring", false, "密钥存入系统钥匙串而非明文配置")
 
 	authCmd.AddCommand(authAddCmd)
 	authCmd.AddCommand(authListCmd)
 	authCmd.AddCommand(authDefaultCmd)
 	authCmd.AddCommand(authRemoveCmd)
+	authCmd.AddCommand(authTestCmd)
 	rootCmd.AddCommand(authCmd)
 }
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthTest(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "auth", "test")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "✓ 连接成功")
+	assert.Contains(t, stdout, "Ghost 版本")
+	assert.Contains(t, stdout, "完整 Admin 访问")
+}
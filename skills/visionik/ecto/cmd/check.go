@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+)
+
+// checkPost 是内容检查需要的文章字段 (含 libecto 结构没有的
+// meta_description)。
+type checkPost struct {
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	Slug            string `json:"slug"`
+	HTML            string `json:"html"`
+	FeatureImage    string `json:"feature_image"`
+	MetaDescription string `json:"meta_description"`
+}
+
+// checkIssue 是报告中的一条问题。
+type checkIssue struct {
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+	Detail string `json:"detail,omitempty"`
+}
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "检查已发布内容的常见问题",
+	Long: `扫描已发布的文章, 报告失效的站内链接、缺失的特色图片和
+缺失的 SEO 描述。发现问题时退出码非零, 可作为部署前的 CI 门禁。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		asJSON, _ := cmd.Flags().GetBool("json")
+
+		site, err := config.GetActiveSite(siteName)
+		if err != nil {
+			return err
+		}
+		posts, err := fetchCheckPosts()
+		if err != nil {
+			return err
+		}
+
+		// 页面别名也算有效链接目标
+		pages, err := fetchAllSitePages("published", 100)
+		if err != nil {
+			return err
+		}
+		pageSlugs := make([]string, 0, len(pages))
+		for _, pg := range pages {
+			pageSlugs = append(pageSlugs, pg.Slug)
+		}
+
+		issues := runContentChecks(site.URL, posts, pageSlugs)
+
+		if asJSON {
+			if err := outputJSON(map[string]interface{}{
+				"checked": len(posts),
+				"issues":  issues,
+			}); err != nil {
+				return err
+			}
+		} else {
+			for _, issue := range issues {
+				printf("[%s] %s (%s)", issue.Type, issue.Title, issue.Slug)
+				if issue.Detail != "" {
+					printf(": %s", issue.Detail)
+				}
+				printf("\n")
+			}
+			printf("检查了 %d 篇文章, 发现 %d 个问题\n", len(posts), len(issues))
+		}
+
+		if len(issues) > 0 {
+			return fmt.Errorf("%d 个问题", len(issues))
+		}
+		return nil
+	},
+}
+
+// fetchCheckPosts 抓取所有已发布文章的检查所需字段。
+func fetchCheckPosts() ([]checkPost, error) {
+	api, err := activeGhostAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("filter", "status:published")
+	query.Set("formats", "html")
+
+	raw, err := api.FetchAllPages("/posts/", query, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var posts []checkPost
+	for _, page := range raw {
+		var resp struct {
+			Posts []checkPost `json:"posts"`
+		}
+		if err := json.Unmarshal(page, &resp); err != nil {
+			return nil, err
+		}
+		posts = append(posts, resp.Posts...)
+	}
+	return posts, nil
+}
+
+var hrefRe = regexp.MustCompile(`href="([^"]+)"`)
+
+// runContentChecks 对文章列表执行全部检查并返回问题列表。
+// extraSlugs (如页面别名) 也算有效的链接目标。
+func runContentChecks(siteURL string, posts []checkPost, extraSlugs []string) []checkIssue {
+	slugs := make(map[string]bool, len(posts)+len(extraSlugs))
+	for _, p := range posts {
+		slugs[p.Slug] = true
+	}
+	for _, slug := range extraSlugs {
+		slugs[slug] = true
+	}
+
+	var issues []checkIssue
+	for _, p := range posts {
+		if p.FeatureImage == "" {
+			issues = append(issues, checkIssue{Slug: p.Slug, Title: p.Title, Type: "missing_feature_image"})
+		}
+		if p.MetaDescription == "" {
+			issues = append(issues, checkIssue{Slug: p.Slug, Title: p.Title, Type: "missing_meta_description"})
+		}
+		for _, target := range internalLinkSlugs(siteURL, p.HTML) {
+			if !slugs[target] {
+				issues = append(issues, checkIssue{
+					Slug:   p.Slug,
+					Title:  p.Title,
+					Type:   "broken_internal_link",
+					Detail: "/" + target + "/",
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// internalLinkSlugs 从 HTML 中提取指向本站文章的链接别名。
+func internalLinkSlugs(siteURL, html string) []string {
+	base := strings.TrimRight(siteURL, "/")
+	var targets []string
+
+	for _, m := range hrefRe.FindAllStringSubmatch(html, -1) {
+		href := m[1]
+		var path string
+		switch {
+		case strings.HasPrefix(href, base+"/"):
+			path = strings.TrimPrefix(href, base+"/")
+		case strings.HasPrefix(href, "/") && !strings.HasPrefix(href, "//"):
+			path = strings.TrimPrefix(href, "/")
+		default:
+			continue
+		}
+
+		path = strings.SplitN(path, "#", 2)[0]
+		path = strings.SplitN(path, "?", 2)[0]
+		// 多段路径 (tag/..., author/...) 和根路径不按文章别名检查
+		path = strings.Trim(path, "/")
+		if path == "" || strings.Contains(path, "/") {
+			continue
+		}
+		targets = append(targets, path)
+	}
+	return targets
+}
+
+func init() {
+	checkCmd.Flags().Bool("json", false, "以JSON格式输出")
+
+	rootCmd.AddCommand(checkCmd)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunContentChecks(t *testing.T) {
+	posts := []checkPost{
+		{
+			Slug: "good", Title: "Good",
+			HTML:            `<a href="https://blog.example.com/other/">ok</a>`,
+			FeatureImage:    "https://blog.example.com/content/images/a.png",
+			MetaDescription: "desc",
+		},
+		{
+			Slug: "other", Title: "Other",
+			HTML:            `<a href="/missing-post/">broken</a> <a href="/about/">page</a>`,
+			FeatureImage:    "x.png",
+			MetaDescription: "desc",
+		},
+		{Slug: "bare", Title: "Bare", HTML: ""},
+	}
+
+	issues := runContentChecks("https://blog.example.com", posts, []string{"about"})
+
+	var types []string
+	for _, i := range issues {
+		types = append(types, i.Slug+":"+i.Type)
+	}
+	assert.ElementsMatch(t, []string{
+		"other:broken_internal_link",
+		"bare:missing_feature_image",
+		"bare:missing_meta_description",
+	}, types)
+}
+
+func TestInternalLinkSlugs(t *testing.T) {
+	html := `<a href="https://blog.example.com/post-a/">a</a>` +
+		`<a href="/post-b/#section">b</a>` +
+		`<a href="/tag/news/">tag</a>` +
+		`<a href="https://elsewhere.com/post-c/">external</a>` +
+		`<a href="//cdn.example.com/x">protocol-relative</a>`
+
+	slugs := internalLinkSlugs("https://blog.example.com/", html)
+	assert.Equal(t, []string{"post-a", "post-b"}, slugs)
+}
+
+func TestCheckCmdReportsIssues(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "check")
+
+	// 模拟数据没有特色图片和 SEO 描述, 应报告问题并返回非零
+	require.Error(t, err)
+	assert.Contains(t, stdout, "missing_feature_image")
+	assert.Contains(t, stdout, "检查了 2 篇文章")
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/visionik/libecto"
+)
+
+// uploadLocalImages 把 Markdown 内容中引用的本地图片 (./img/x.png)
+// 通过图片 API 上传, 并把引用改写为上传后的 URL。相对路径相对于
+// baseDir 解析; 同一文件只上传一次。远程 URL 原样保留。
+func uploadLocalImages(client *libecto.Client, content []byte, baseDir string) ([]byte, error) {
+	uploaded := map[string]string{} // 本地路径 -> 上传后 URL
+	var uploadErr error
+
+	rewritten := mdImageRe.ReplaceAllStringFunc(string(content), func(m string) string {
+		if uploadErr != nil {
+			return m
+		}
+		src := mdImageRe.FindStringSubmatch(m)[1]
+		if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+			return m
+		}
+
+		path := src
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		url, ok := uploaded[path]
+		if !ok {
+			if _, err := os.Stat(path); err != nil {
+				uploadErr = fmt.Errorf("图片文件不存在: %s", path)
+				return m
+			}
+			resp, err := client.UploadImage(path)
+			if err != nil {
+				uploadErr = fmt.Errorf("上传图片 %s: %w", path, err)
+				return m
+			}
+			if len(resp.Images) == 0 {
+				uploadErr = fmt.Errorf("图片 API 未返回 URL")
+				return m
+			}
+			url = resp.Images[0].URL
+			uploaded[path] = url
+			printf("已上传图片: %s -> %s\n", src, url)
+		}
+		return strings.Replace(m, src, url, 1)
+	})
+
+	if uploadErr != nil {
+		return nil, uploadErr
+	}
+	return []byte(rewritten), nil
+}
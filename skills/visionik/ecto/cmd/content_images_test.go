@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/ecto/internal/config"
+)
+
+func TestUploadLocalImages(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "img"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "img", "x.png"), []byte("png"), 0644))
+
+	client, err := config.GetActiveClient("")
+	require.NoError(t, err)
+
+	md := "![one](./img/x.png)\n\n![again](img/x.png)\n\n![remote](https://example.com/y.png)\n"
+	rewritten, err := uploadLocalImages(client, []byte(md), dir)
+	require.NoError(t, err)
+
+	out := string(rewritten)
+	assert.Contains(t, out, "![one](https://test.ghost.io/images/uploaded.png)")
+	assert.Contains(t, out, "![again](https://test.ghost.io/images/uploaded.png)")
+	assert.Contains(t, out, "![remote](https://example.com/y.png)")
+}
+
+func TestUploadLocalImages_Missing(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	client, err := config.GetActiveClient("")
+	require.NoError(t, err)
+
+	_, err = uploadLocalImages(client, []byte("![x](./nope.png)"), t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "图片文件不存在")
+}
+
+func TestPostCreateUploadsLocalImages(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x.png"), []byte("png"), 0644))
+	mdFile := filepath.Join(dir, "post.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("![pic](./x.png)\n"), 0644))
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "create", "--title", "Pics", "--markdown-file", mdFile)
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已上传图片")
+	assert.Contains(t, stdout, "已创建文章")
+}
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/ecto/internal/ghostapi"
+	"github.com/visionik/ecto/internal/htmlmd"
+	"github.com/visionik/libecto"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "导出全部内容为 Markdown 文件",
+	Long: `把所有文章和页面导出为带 front matter 的 Markdown 文件
+(posts/ 与 pages/ 子目录), 并把正文引用的图片下载到 images/。
+导出结果可直接被 ecto import 重新发布, 适合备份和迁移。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out, _ := cmd.Flags().GetString("out")
+		status, _ := cmd.Flags().GetString("status")
+		skipImages, _ := cmd.Flags().GetBool("skip-images")
+
+		posts, err := fetchAllPosts(status, 100)
+		if err != nil {
+			return err
+		}
+		pages, err := fetchAllSitePages(status, 100)
+		if err != nil {
+			return err
+		}
+
+		ex := &exporter{outDir: out, skipImages: skipImages}
+		for _, dir := range []string{"posts", "pages"} {
+			if err := os.MkdirAll(filepath.Join(out, dir), 0755); err != nil {
+				return err
+			}
+		}
+
+		for _, p := range posts {
+			fm := &frontMatter{
+				Title:        p.Title,
+				Slug:         p.Slug,
+				Status:       p.Status,
+				FeatureImage: p.FeatureImage,
+				Excerpt:      p.Excerpt,
+				PublishedAt:  p.PublishedAt,
+			}
+			for _, t := range p.Tags {
+				fm.Tags = append(fm.Tags, t.Name)
+			}
+			if err := ex.writeResource("posts", fm, p.HTML); err != nil {
+				return err
+			}
+		}
+		for _, p := range pages {
+			fm := &frontMatter{
+				Title:       p.Title,
+				Slug:        p.Slug,
+				Status:      p.Status,
+				PublishedAt: p.PublishedAt,
+			}
+			if err := ex.writeResource("pages", fm, p.HTML); err != nil {
+				return err
+			}
+		}
+
+		printf("已导出 %d 篇文章, %d 个页面, %d 张图片到 %s\n",
+			len(posts), len(pages), ex.imagesSaved, out)
+		return nil
+	},
+}
+
+// fetchAllSitePages 按页抓取站点的所有页面 (对应 fetchAllPosts)。
+func fetchAllSitePages(status string, pageSize int) ([]libecto.Page, error) {
+	site, err := config.GetActiveSite(siteName)
+	if err != nil {
+		return nil, err
+	}
+	api, err := ghostapi.NewClient(site.URL, site.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if status != "" && status != "all" {
+		query.Set("filter", "status:"+status)
+	}
+	query.Set("formats", "html")
+
+	raw, err := api.FetchAllPages("/pages/", query, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []libecto.Page
+	for _, page := range raw {
+		var resp struct {
+			Pages []libecto.Page `json:"pages"`
+		}
+		if err := json.Unmarshal(page, &resp); err != nil {
+			return nil, err
+		}
+		pages = append(pages, resp.Pages...)
+	}
+	return pages, nil
+}
+
+// exporter 保存导出状态: 输出目录和已下载的图片。
+type exporter struct {
+	outDir      string
+	skipImages  bool
+	imagesSaved int
+	seenImages  map[string]string // 远程 URL -> 相对路径
+}
+
+var mdImageRe = regexp.MustCompile(`!\[[^\]]*\]\(([^)]+)\)`)
+
+// writeResource 把单个文章/页面写成 <dir>/<slug>.md。
+func (ex *exporter) writeResource(dir string, fm *frontMatter, html string) error {
+	md := ""
+	if html != "" {
+		md = htmlmd.FromHTML(html)
+	}
+
+	if !ex.skipImages {
+		md = mdImageRe.ReplaceAllStringFunc(md, func(m string) string {
+			sub := mdImageRe.FindStringSubmatch(m)
+			local, err := ex.downloadImage(sub[1])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "下载图片失败 %s: %v\n", sub[1], err)
+				return m
+			}
+			return strings.Replace(m, sub[1], local, 1)
+		})
+		if fm.FeatureImage != "" {
+			if local, err := ex.downloadImage(fm.FeatureImage); err == nil {
+				fm.FeatureImage = local
+			} else {
+				fmt.Fprintf(os.Stderr, "下载图片失败 %s: %v\n", fm.FeatureImage, err)
+			}
+		}
+	}
+
+	name := fm.Slug
+	if name == "" {
+		name = fm.Title
+	}
+	path := filepath.Join(ex.outDir, dir, name+".md")
+	return os.WriteFile(path, []byte(marshalFrontMatter(fm)+md), 0644)
+}
+
+// downloadImage 把远程图片存到 images/ 并返回 Markdown 中使用的
+// 相对路径; 同一 URL 只下载一次。
+func (ex *exporter) downloadImage(src string) (string, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return src, nil
+	}
+	if local, ok := ex.seenImages[src]; ok {
+		return local, nil
+	}
+
+	resp, err := http.Get(src)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Join(ex.outDir, "images"), 0755); err != nil {
+		return "", err
+	}
+	name := filepath.Base(strings.SplitN(src, "?", 2)[0])
+	if err := os.WriteFile(filepath.Join(ex.outDir, "images", name), data, 0644); err != nil {
+		return "", err
+	}
+
+	local := "../images/" + name
+	if ex.seenImages == nil {
+		ex.seenImages = map[string]string{}
+	}
+	ex.seenImages[src] = local
+	ex.imagesSaved++
+	return local, nil
+}
+
+// marshalFrontMatter 把元数据渲染为 splitFrontMatter 能解析的
+// YAML front matter 块。
+func marshalFrontMatter(fm *frontMatter) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	writeField := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "%s: %q\n", key, strings.ReplaceAll(value, "\n", " "))
+		}
+	}
+	writeField("title", fm.Title)
+	writeField("slug", fm.Slug)
+	writeField("status", fm.Status)
+	if len(fm.Tags) > 0 {
+		fmt.Fprintf(&b, "tags: [%s]\n", strings.Join(fm.Tags, ", "))
+	}
+	writeField("feature_image", fm.FeatureImage)
+	writeField("excerpt", fm.Excerpt)
+	writeField("published_at", fm.PublishedAt)
+	b.WriteString("---\n\n")
+	return b.String()
+}
+
+func init() {
+	exportCmd.Flags().String("out", "", "输出目录 (必需)")
+	exportCmd.Flags().String("status", "all", "按状态过滤 (draft|published|all)")
+	exportCmd.Flags().Bool("skip-images", false, "不下载正文引用的图片")
+	exportCmd.MarkFlagRequired("out")
+
+	rootCmd.AddCommand(exportCmd)
+}
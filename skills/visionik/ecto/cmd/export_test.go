@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCmd(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	out := t.TempDir()
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "export", "--out", out)
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已导出 2 篇文章, 2 个页面")
+
+	data, err := os.ReadFile(filepath.Join(out, "posts", "post-one.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `title: "Post One"`)
+	assert.Contains(t, string(data), `slug: "post-one"`)
+
+	_, err = os.Stat(filepath.Join(out, "pages", "page-two.md"))
+	assert.NoError(t, err)
+}
+
+func TestMarshalFrontMatterRoundTrip(t *testing.T) {
+	fm := &frontMatter{
+		Title:       "My Post",
+		Slug:        "my-post",
+		Status:      "published",
+		Tags:        []string{"blog", "golang"},
+		Excerpt:     "short",
+		PublishedAt: "2025-01-25T10:00:00Z",
+	}
+
+	parsed, body, err := splitFrontMatter([]byte(marshalFrontMatter(fm) + "# Hello\n"))
+	require.NoError(t, err)
+	assert.Equal(t, fm, parsed)
+	assert.Equal(t, "# Hello\n", string(body))
+}
+
+func TestExporterDownloadsImages(t *testing.T) {
+	server, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	out := t.TempDir()
+	ex := &exporter{outDir: out}
+	require.NoError(t, os.MkdirAll(filepath.Join(out, "posts"), 0755))
+
+	fm := &frontMatter{Title: "Pic", Slug: "pic"}
+	html := `<p><img src="` + server.URL + `/content/images/a.png" alt="pic"/></p>`
+	require.NoError(t, ex.writeResource("posts", fm, html))
+
+	data, err := os.ReadFile(filepath.Join(out, "posts", "pic.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "![pic](../images/a.png)")
+	assert.Equal(t, 1, ex.imagesSaved)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/ecto/internal/ghostapi"
+	"github.com/visionik/libecto"
+)
+
+// resolveFeatureImage 解析 --feature-image 的值。URL 原样返回;
+// 本地文件路径先通过图片 API 上传, 返回上传后的 URL。
+func resolveFeatureImage(client *libecto.Client, value string) (string, error) {
+	if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return value, nil
+	}
+
+	if _, err := os.Stat(value); err != nil {
+		return "", fmt.Errorf("特色图片既不是 URL 也不是可读的本地文件: %s", value)
+	}
+
+	resp, err := client.UploadImage(value)
+	if err != nil {
+		return "", fmt.Errorf("上传特色图片: %w", err)
+	}
+	if len(resp.Images) == 0 {
+		return "", fmt.Errorf("图片 API 未返回 URL")
+	}
+
+	printf("已上传特色图片: %s\n", resp.Images[0].URL)
+	return resp.Images[0].URL, nil
+}
+
+// setFeatureImageMeta 通过 Admin API 设置 feature_image_alt 和
+// feature_image_caption (libecto 的 Post 结构不包含这两个字段)。
+func setFeatureImageMeta(postID, updatedAt, alt, caption string) error {
+	site, err := config.GetActiveSite(siteName)
+	if err != nil {
+		return err
+	}
+	api, err := ghostapi.NewClient(site.URL, site.APIKey)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]interface{}{
+		"updated_at": updatedAt,
+	}
+	if alt != "" {
+		fields["feature_image_alt"] = alt
+	}
+	if caption != "" {
+		fields["feature_image_caption"] = caption
+	}
+
+	body := map[string]interface{}{
+		"posts": []map[string]interface{}{fields},
+	}
+	_, err = api.Do("PUT", "/posts/"+postID+"/", nil, body)
+	return err
+}
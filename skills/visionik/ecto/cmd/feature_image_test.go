@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveFeatureImageURL(t *testing.T) {
+	// URL 原样返回, 不会触发上传
+	url, err := resolveFeatureImage(nil, "https://example.com/img.png")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/img.png", url)
+
+	url, err = resolveFeatureImage(nil, "http://example.com/img.png")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://example.com/img.png", url)
+}
+
+func TestResolveFeatureImageMissingFile(t *testing.T) {
+	_, err := resolveFeatureImage(nil, "/no/such/file.png")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "既不是 URL")
+}
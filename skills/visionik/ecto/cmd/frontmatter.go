@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// frontMatter 是 Markdown 文件头部 YAML front matter 支持的字段。
+// 只解析本工具用到的简单子集 (key: value 与标签列表), 不引入
+// 完整的 YAML 依赖。
+type frontMatter struct {
+	Title        string
+	Slug         string
+	Status       string
+	FeatureImage string
+	Excerpt      string
+	PublishedAt  string
+	Tags         []string
+}
+
+// splitFrontMatter 从 Markdown 内容中分离 front matter。内容不以
+// "---" 开头时原样返回; 缺少结束分隔符或遇到未知字段时报错,
+// 避免静默丢弃作者的元数据。
+func splitFrontMatter(content []byte) (*frontMatter, []byte, error) {
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if !strings.HasPrefix(text, "---\n") {
+		return nil, content, nil
+	}
+
+	rest := text[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return nil, nil, fmt.Errorf("front matter 缺少结束的 ---")
+	}
+	header := rest[:end]
+	body := strings.TrimPrefix(rest[end+len("\n---"):], "\n")
+	body = strings.TrimPrefix(body, "\n")
+
+	fm := &frontMatter{}
+	lines := strings.Split(header, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "-") {
+			return nil, nil, fmt.Errorf("无法解析 front matter 第 %d 行: %s", i+1, line)
+		}
+		key = strings.TrimSpace(key)
+		value = unquoteFrontMatter(strings.TrimSpace(value))
+
+		switch key {
+		case "title":
+			fm.Title = value
+		case "slug":
+			fm.Slug = value
+		case "status":
+			fm.Status = value
+		case "feature_image":
+			fm.FeatureImage = value
+		case "excerpt":
+			fm.Excerpt = value
+		case "published_at":
+			fm.PublishedAt = value
+		case "tags":
+			tags, consumed, err := parseFrontMatterTags(value, lines[i+1:])
+			if err != nil {
+				return nil, nil, err
+			}
+			fm.Tags = tags
+			i += consumed
+		default:
+			return nil, nil, fmt.Errorf("不支持的 front matter 字段: %s", key)
+		}
+	}
+
+	return fm, []byte(body), nil
+}
+
+// parseFrontMatterTags 解析标签: 行内列表 [a, b]、逗号分隔字符串,
+// 或后续行的 "- tag" 列表。返回标签和额外消耗的行数。
+func parseFrontMatterTags(value string, following []string) ([]string, int, error) {
+	if value != "" {
+		value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+		var tags []string
+		for _, t := range strings.Split(value, ",") {
+			if t = unquoteFrontMatter(strings.TrimSpace(t)); t != "" {
+				tags = append(tags, t)
+			}
+		}
+		return tags, 0, nil
+	}
+
+	var tags []string
+	consumed := 0
+	for _, line := range following {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "- ") {
+			break
+		}
+		tags = append(tags, unquoteFrontMatter(strings.TrimSpace(trimmed[2:])))
+		consumed++
+	}
+	if len(tags) == 0 {
+		return nil, 0, fmt.Errorf("tags 字段为空")
+	}
+	return tags, consumed, nil
+}
+
+// unquoteFrontMatter 去掉成对的单引号或双引号。
+func unquoteFrontMatter(s string) string {
+	if len(s) >= 2 && (s[0] == '"' && s[len(s)-1] == '"' || s[0] == '\'' && s[len(s)-1] == '\'') {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFrontMatter(t *testing.T) {
+	content := []byte(`---
+title: "My Post"
+slug: my-post
+status: published
+tags: [blog, golang]
+feature_image: https://example.com/cover.jpg
+excerpt: 简短摘要
+published_at: 2025-01-25T10:00:00Z
+---
+
+# Hello
+
+body text
+`)
+
+	fm, body, err := splitFrontMatter(content)
+	require.NoError(t, err)
+	require.NotNil(t, fm)
+
+	assert.Equal(t, "My Post", fm.Title)
+	assert.Equal(t, "my-post", fm.Slug)
+	assert.Equal(t, "published", fm.Status)
+	assert.Equal(t, []string{"blog", "golang"}, fm.Tags)
+	assert.Equal(t, "https://example.com/cover.jpg", fm.FeatureImage)
+	assert.Equal(t, "简短摘要", fm.Excerpt)
+	assert.Equal(t, "2025-01-25T10:00:00Z", fm.PublishedAt)
+	assert.Equal(t, "# Hello\n\nbody text\n", string(body))
+}
+
+func TestSplitFrontMatter_TagList(t *testing.T) {
+	content := []byte("---\ntags:\n  - blog\n  - golang\n---\nbody\n")
+
+	fm, body, err := splitFrontMatter(content)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"blog", "golang"}, fm.Tags)
+	assert.Equal(t, "body\n", string(body))
+}
+
+func TestSplitFrontMatter_None(t *testing.T) {
+	content := []byte("# Just Markdown\n")
+
+	fm, body, err := splitFrontMatter(content)
+	require.NoError(t, err)
+	assert.Nil(t, fm)
+	assert.Equal(t, content, body)
+}
+
+func TestSplitFrontMatter_Errors(t *testing.T) {
+	_, _, err := splitFrontMatter([]byte("---\ntitle: x\n"))
+	assert.ErrorContains(t, err, "缺少结束的 ---")
+
+	_, _, err = splitFrontMatter([]byte("---\nauthor: x\n---\nbody\n"))
+	assert.ErrorContains(t, err, "不支持的 front matter 字段")
+}
+
+func TestPostCreateFrontMatter(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	mdFile := filepath.Join(t.TempDir(), "post.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("---\ntitle: FM Title\ntags: [blog]\n---\nbody\n"), 0644))
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "create", "--markdown-file", mdFile)
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已创建文章")
+}
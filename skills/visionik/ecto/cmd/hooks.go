@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/libecto"
+)
+
+// hookEvents 是发布流水线支持的钩子事件。
+var hookEvents = []string{
+	"pre-create", "post-create",
+	"pre-publish", "post-publish",
+	"pre-delete", "post-delete",
+}
+
+var hooksCmd = &cobra.Command{
+	Use:   "hooks",
+	Short: "管理发布流水线钩子 (按站点配置的命令)",
+}
+
+var hooksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "列出当前站点的钩子",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		site, err := config.GetActiveSite(siteName)
+		if err != nil {
+			return err
+		}
+
+		if len(site.Hooks) == 0 {
+			println("未配置钩子。使用 'ecto hooks add <事件> <命令>' 添加。")
+			return nil
+		}
+
+		events := make([]string, 0, len(site.Hooks))
+		for event := range site.Hooks {
+			events = append(events, event)
+		}
+		sort.Strings(events)
+
+		for _, event := range events {
+			printf("%s:\n", event)
+			for i, command := range site.Hooks[event] {
+				printf("  [%d] %s\n", i, command)
+			}
+		}
+		return nil
+	},
+}
+
+var hooksAddCmd = &cobra.Command{
+	Use:   "add <事件> <命令>",
+	Short: "为事件添加钩子命令",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		event := args[0]
+		if !isHookEvent(event) {
+			return fmt.Errorf("未知事件 %q (支持: %s)", event, strings.Join(hookEvents, ", "))
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+		name, site, err := activeConfigSite(cfg)
+		if err != nil {
+			return err
+		}
+
+		if site.Hooks == nil {
+			site.Hooks = make(map[string][]string)
+		}
+		site.Hooks[event] = append(site.Hooks[event], args[1])
+		cfg.Sites[name] = site
+		if err := cfg.Save(); err != nil {
+			return err
+		}
+
+		printf("已为 %s 添加 %s 钩子\n", name, event)
+		return nil
+	},
+}
+
+var hooksRemoveCmd = &cobra.Command{
+	Use:   "remove <事件> [索引]",
+	Short: "移除事件的钩子 (不带索引则全部移除)",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		event := args[0]
+
+		cfg, err := config.Load()
+		if err != nil {
+			return err
+		}
+		name, site, err := activeConfigSite(cfg)
+		if err != nil {
+			return err
+		}
+
+		commands := site.Hooks[event]
+		if len(commands) == 0 {
+			return fmt.Errorf("事件 %q 没有配置钩子", event)
+		}
+
+		if len(args) == 2 {
+			idx, err := strconv.Atoi(args[1])
+			if err != nil || idx < 0 || idx >= len(commands) {
+				return fmt.Errorf("无效索引 %q (0-%d)", args[1], len(commands)-1)
+			}
+			site.Hooks[event] = append(commands[:idx], commands[idx+1:]...)
+		} else {
+			delete(site.Hooks, event)
+		}
+		if len(site.Hooks[event]) == 0 {
+			delete(site.Hooks, event)
+		}
+
+		cfg.Sites[name] = site
+		if err := cfg.Save(); err != nil {
+			return err
+		}
+
+		printf("已移除 %s 的 %s 钩子\n", name, event)
+		return nil
+	},
+}
+
+// isHookEvent 报告 event 是否是支持的钩子事件。
+func isHookEvent(event string) bool {
+	for _, e := range hookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// activeConfigSite 从配置中解析当前站点 (钩子只能配置在保存的站点上,
+// 不适用于纯环境变量模式)。
+func activeConfigSite(cfg *config.Config) (string, config.Site, error) {
+	name := siteName
+	if name == "" {
+		name = os.Getenv("GHOST_SITE")
+	}
+	if name == "" {
+		name = cfg.DefaultSite
+	}
+	if name == "" {
+		return "", config.Site{}, fmt.Errorf("未指定站点且未设置默认站点")
+	}
+	site, ok := cfg.Sites[name]
+	if !ok {
+		return "", config.Site{}, fmt.Errorf("站点 %q 未找到", name)
+	}
+	return name, site, nil
+}
+
+// hasHooks 报告当前站点是否为 event 配置了钩子。
+func hasHooks(event string) bool {
+	site, err := config.GetActiveSite(siteName)
+	return err == nil && len(site.Hooks[event]) > 0
+}
+
+// runHooks 依次运行 event 配置的钩子命令, 把 post 的 JSON 写到每个命令的
+// 标准输入。pre-* 钩子失败会中止操作; post-* 钩子失败只打印警告。
+func runHooks(event string, post *libecto.Post) error {
+	site, err := config.GetActiveSite(siteName)
+	if err != nil || len(site.Hooks[event]) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(post)
+	if err != nil {
+		return fmt.Errorf("序列化钩子负载: %w", err)
+	}
+
+	for _, command := range site.Hooks[event] {
+		hook := exec.Command("sh", "-c", command)
+		hook.Stdin = bytes.NewReader(payload)
+		// 钩子输出走 stderr, 避免污染 --json 输出
+		hook.Stdout = os.Stderr
+		hook.Stderr = os.Stderr
+		hook.Env = append(os.Environ(),
+			"ECTO_EVENT="+event,
+			"ECTO_SITE="+site.Name,
+			"ECTO_POST_ID="+post.ID,
+			"ECTO_POST_SLUG="+post.Slug,
+		)
+
+		if err := hook.Run(); err != nil {
+			if strings.HasPrefix(event, "pre-") {
+				return fmt.Errorf("%s 钩子失败: %s: %w", event, command, err)
+			}
+			fmt.Fprintf(os.Stderr, "警告: %s 钩子失败: %s: %v\n", event, command, err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	hooksCmd.AddCommand(hooksListCmd)
+	hooksCmd.AddCommand(hooksAddCmd)
+	hooksCmd.AddCommand(hooksRemoveCmd)
+	rootCmd.AddCommand(hooksCmd)
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/libecto"
+)
+
+// setupHooksConfig points the config at a temp dir with one site and
+// clears the env override so hooks resolve from the saved config.
+func setupHooksConfig(t *testing.T) {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	origXDG := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", tmpDir)
+	origURL := os.Getenv("GHOST_URL")
+	origKey := os.Getenv("GHOST_ADMIN_KEY")
+	os.Unsetenv("GHOST_URL")
+	os.Unsetenv("GHOST_ADMIN_KEY")
+	t.Cleanup(func() {
+		os.Setenv("XDG_CONFIG_HOME", origXDG)
+		os.Setenv("GHOST_URL", origURL)
+		os.Setenv("GHOST_ADMIN_KEY", origKey)
+	})
+
+	cfg := &config.Config{Sites: make(map[string]config.Site)}
+	require.NoError(t, cfg.AddSite("test", "https://test.ghost.io", "id:abcd1234"))
+}
+
+func TestHooksAddListRemove(t *testing.T) {
+	setupHooksConfig(t)
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "hooks", "add", "post-publish", "echo published")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "post-publish")
+
+	cmd = newTestRootCmd()
+	stdout, _, err = executeCommand(cmd, "hooks", "list")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "post-publish:")
+	assert.Contains(t, stdout, "echo published")
+
+	cmd = newTestRootCmd()
+	_, _, err = executeCommand(cmd, "hooks", "remove", "post-publish")
+	require.NoError(t, err)
+
+	cmd = newTestRootCmd()
+	stdout, _, err = executeCommand(cmd, "hooks", "list")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "未配置钩子")
+}
+
+func TestHooksAddRejectsUnknownEvent(t *testing.T) {
+	setupHooksConfig(t)
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "hooks", "add", "pre-frobnicate", "echo hi")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "未知事件")
+}
+
+func TestRunHooksPassesPostJSON(t *testing.T) {
+	setupHooksConfig(t)
+
+	outFile := filepath.Join(t.TempDir(), "hook-out.json")
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	site := cfg.Sites["test"]
+	site.Hooks = map[string][]string{
+		"post-publish": {"cat > " + outFile},
+	}
+	cfg.Sites["test"] = site
+	require.NoError(t, cfg.Save())
+
+	err = runHooks("post-publish", &libecto.Post{ID: "post-1", Slug: "hello"})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"hello"`)
+}
+
+func TestRunHooksPreFailureAborts(t *testing.T) {
+	setupHooksConfig(t)
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	site := cfg.Sites["test"]
+	site.Hooks = map[string][]string{
+		"pre-delete":  {"exit 1"},
+		"post-delete": {"exit 1"},
+	}
+	cfg.Sites["test"] = site
+	require.NoError(t, cfg.Save())
+
+	err = runHooks("pre-delete", &libecto.Post{ID: "post-1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pre-delete")
+
+	// post-* 钩子失败只警告, 不返回错误
+	err = runHooks("post-delete", &libecto.Post{ID: "post-1"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/libecto"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <dir>",
+	Short: "批量导入目录中的 Markdown 文件",
+	Long: `遍历目录中匹配 --glob 的 Markdown 文件, 按别名创建或更新文章。
+别名取 front matter 的 slug, 缺省时用文件名 (不含扩展名)。
+适合静态仓库到 Ghost 的发布流水线。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := config.GetActiveClient(siteName)
+		if err != nil {
+			return err
+		}
+
+		glob, _ := cmd.Flags().GetString("glob")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		files, err := filepath.Glob(filepath.Join(args[0], glob))
+		if err != nil {
+			return fmt.Errorf("无效的 glob: %w", err)
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("%s 中没有匹配 %s 的文件", args[0], glob)
+		}
+		sort.Strings(files)
+
+		var created, updated, skipped int
+		for _, file := range files {
+			action, err := importFile(client, file, dryRun)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "跳过 %s: %v\n", file, err)
+				skipped++
+				continue
+			}
+			if !wantJSON(cmd) {
+				printf("%s %s\n", action, file)
+			}
+			switch action {
+			case "已创建", "将创建":
+				created++
+			case "已更新", "将更新":
+				updated++
+			}
+		}
+
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"created": created, "updated": updated, "skipped": skipped, "dry_run": dryRun})
+		}
+		printf("\n创建 %d, 更新 %d, 跳过 %d\n", created, updated, skipped)
+		if dryRun {
+			println("(dry-run, 未做任何修改)")
+		}
+		return nil
+	},
+}
+
+// importFile 创建或更新单个文件对应的文章, 返回执行的动作。
+func importFile(client *libecto.Client, file string, dryRun bool) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	fm, body, err := splitFrontMatter(content)
+	if err != nil {
+		return "", err
+	}
+	if fm == nil {
+		fm = &frontMatter{}
+	}
+
+	slug := fm.Slug
+	if slug == "" {
+		slug = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	}
+	title := fm.Title
+	if title == "" {
+		title = slug
+	}
+
+	post := &libecto.Post{
+		Title:       title,
+		Slug:        slug,
+		Status:      fm.Status,
+		Excerpt:     fm.Excerpt,
+		PublishedAt: fm.PublishedAt,
+	}
+	for _, name := range fm.Tags {
+		post.Tags = append(post.Tags, libecto.Tag{Name: name})
+	}
+	if fm.FeatureImage != "" {
+		imgURL, err := resolveFeatureImage(client, fm.FeatureImage)
+		if err != nil {
+			return "", err
+		}
+		post.FeatureImage = imgURL
+	}
+	if len(body) > 0 {
+		post.HTML = libecto.MarkdownToHTML(body)
+	}
+
+	// 按别名匹配已有文章决定创建还是更新
+	existing, err := client.GetPost(slug)
+	if err == nil {
+		if dryRun {
+			return "将更新", nil
+		}
+		post.UpdatedAt = existing.UpdatedAt // 用于冲突检测
+		if _, err := client.UpdatePost(existing.ID, post); err != nil {
+			return "", err
+		}
+		return "已更新", nil
+	}
+
+	if post.Status == "" {
+		post.Status = "draft"
+	}
+	if dryRun {
+		return "将创建", nil
+	}
+	if _, err := client.CreatePost(post); err != nil {
+		return "", err
+	}
+	return "已创建", nil
+}
+
+func init() {
+	importCmd.Flags().String("glob", "*.md", "文件匹配模式")
+	importCmd.Flags().Bool("dry-run", false, "只报告将执行的动作, 不做修改")
+
+	rootCmd.AddCommand(importCmd)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeImportFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+}
+
+func TestImportCmd(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	dir := t.TempDir()
+	writeImportFile(t, dir, "one.md", "---\ntitle: One\nslug: post-one\n---\nbody\n")
+	writeImportFile(t, dir, "two.md", "# Two\n\nbody\n")
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "import", dir, "--glob", "*.md")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已更新")
+	assert.Contains(t, stdout, "创建 0, 更新 2, 跳过 0")
+}
+
+func TestImportCmdDryRun(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	dir := t.TempDir()
+	writeImportFile(t, dir, "one.md", "---\ntitle: One\n---\nbody\n")
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "import", dir, "--dry-run")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "将更新")
+	assert.Contains(t, stdout, "(dry-run, 未做任何修改)")
+}
+
+func TestImportCmdSkipsBadFrontMatter(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	dir := t.TempDir()
+	writeImportFile(t, dir, "bad.md", "---\nauthor: x\n---\nbody\n")
+	writeImportFile(t, dir, "good.md", "body\n")
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "import", dir, "--glob", "*.md")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "创建 0, 更新 1, 跳过 1")
+}
+
+func TestImportCmdNoFiles(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "import", t.TempDir())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "没有匹配")
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// readStdin 读取标准输入的全部内容。
+func readStdin() ([]byte, error) {
+	return io.ReadAll(os.Stdin)
+}
+
+var paragraphSplitRe = regexp.MustCompile(`\n{2,}`)
+
+// plaintextToHTML 把纯文本转成 HTML: 按空行分段, 段内换行转 <br>,
+// 特殊字符原样转义 (不解释任何标记)。
+func plaintextToHTML(text string) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if text == "" {
+		return ""
+	}
+
+	var parts []string
+	for _, para := range paragraphSplitRe.Split(text, -1) {
+		para = html.EscapeString(para)
+		para = strings.ReplaceAll(para, "\n", "<br>")
+		parts = append(parts, "<p>"+para+"</p>")
+	}
+	return strings.Join(parts, "\n")
+}
+
+// editorTemplate 是 --editor 打开时预填的 front matter 模板。
+const editorTemplate = `---
+title: 
+tags: []
+status: draft
+---
+
+`
+
+// openEditor 用 $EDITOR 打开预填模板的临时文件, 返回保存后的内容。
+// 模板未修改时视为取消。
+func openEditor() ([]byte, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	f, err := os.CreateTemp("", "ecto-*.md")
+	if err != nil {
+		return nil, err
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	if _, err := f.WriteString(editorTemplate); err != nil {
+		f.Close()
+		return nil, err
+	}
+	f.Close()
+
+	// $EDITOR 可以带参数 (如 "code --wait")
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("编辑器退出: %w", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if string(content) == editorTemplate {
+		return nil, fmt.Errorf("模板未修改, 已取消")
+	}
+	return content, nil
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaintextToHTML(t *testing.T) {
+	html := plaintextToHTML("first para\nsecond line\n\nnext <para> & stuff\n")
+
+	assert.Equal(t, "<p>first para<br>second line</p>\n<p>next &lt;para&gt; &amp; stuff</p>", html)
+	assert.Empty(t, plaintextToHTML("  \n"))
+}
+
+// stdinFrom 在测试期间把 os.Stdin 换成给定内容。
+func stdinFrom(t *testing.T, content string) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString(content)
+	require.NoError(t, err)
+	w.Close()
+
+	orig := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() { os.Stdin = orig })
+}
+
+func TestPostCreateStdinHTML(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	stdinFrom(t, "<p>raw html</p>")
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "create", "--title", "HTML Post", "--stdin-format", "html")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已创建文章")
+}
+
+func TestPostCreateStdinInvalidFormat(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	stdinFrom(t, "x")
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "post", "create", "--title", "X", "--stdin-format", "docx")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "无效的 --stdin-format")
+}
+
+func TestPostCreateEditor(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	// 用脚本模拟编辑器: 覆写模板为完整文章
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	require.NoError(t, os.WriteFile(script,
+		[]byte("#!/bin/sh\nprintf -- '---\\ntitle: From Editor\\n---\\nbody\\n' > \"$1\"\n"), 0755))
+
+	origEditor := os.Getenv("EDITOR")
+	os.Setenv("EDITOR", script)
+	defer os.Setenv("EDITOR", origEditor)
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "create", "--editor")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已创建文章")
+}
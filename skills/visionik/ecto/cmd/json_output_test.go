@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalJSONOnMutation(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+	defer func() { jsonOutput = false }()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "delete", "test-post", "--force", "--json")
+
+	require.NoError(t, err)
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &result))
+	assert.Equal(t, "deleted", result["action"])
+	assert.Equal(t, "post", result["resource"])
+	assert.Equal(t, "post-123", result["id"])
+}
+
+func TestGlobalJSONOnPublish(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	defer func() { jsonOutput = false }()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "publish", "test-post", "--json")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"action": "published"`)
+}
+
+func TestExitCodeClassification(t *testing.T) {
+	tests := []struct {
+		msg  string
+		code int
+	}{
+		{"--title 是必需的", 2},
+		{"无效的可见性: x (支持 public|members|paid)", 2},
+		{"401 Unauthorized", 3},
+		{"资源未找到: 404", 4},
+		{"connection refused", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, exitCode(errString(tt.msg)), tt.msg)
+	}
+}
+
+// errString 是测试用的简单 error 实现。
+type errString string
+
+func (e errString) Error() string { return string(e) }
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/visionik/ecto/internal/lexical"
+)
+
+// lexicalFromContent 把 Markdown 内容按 --format 转换: lexical 返回
+// Lexical JSON 文档, html (默认) 返回空串让调用方走 HTML 路径。
+func lexicalFromContent(format string, content []byte) (string, error) {
+	switch format {
+	case "lexical":
+		return lexical.FromMarkdown(string(content))
+	case "", "html":
+		return "", nil
+	}
+	return "", fmt.Errorf("无效的格式: %s (支持 html|lexical)", format)
+}
+
+// setLexicalBody 通过 Admin API 写入 lexical 正文 (libecto 的
+// Post/Page 结构只支持 HTML 源)。resource 是 "posts" 或 "pages"。
+func setLexicalBody(resource, id, updatedAt, doc string) error {
+	api, err := activeGhostAPI()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		resource: []map[string]interface{}{{
+			"updated_at": updatedAt,
+			"lexical":    doc,
+		}},
+	}
+	_, err = api.Do("PUT", "/"+resource+"/"+id+"/", nil, body)
+	return err
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostCreateLexical(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	mdFile := filepath.Join(t.TempDir(), "post.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("# Hello\n\nworld\n"), 0644))
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "create",
+		"--title", "New Post", "--markdown-file", mdFile, "--format", "lexical")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已创建文章")
+}
+
+func TestPostCreateInvalidFormat(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	mdFile := filepath.Join(t.TempDir(), "post.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("hi"), 0644))
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "post", "create",
+		"--title", "New Post", "--markdown-file", mdFile, "--format", "bogus")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "无效的格式")
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// registerMetaFlags 在 create/edit 命令上注册 SEO 和元数据标志。
+func registerMetaFlags(cmd *cobra.Command) {
+	cmd.Flags().String("excerpt", "", "自定义摘要")
+	cmd.Flags().String("meta-title", "", "SEO 标题")
+	cmd.Flags().String("meta-description", "", "SEO 描述")
+	cmd.Flags().String("og-image", "", "Open Graph 分享图 URL")
+	cmd.Flags().String("canonical-url", "", "规范链接")
+	cmd.Flags().Bool("featured", false, "设为精选")
+	cmd.Flags().String("visibility", "", "可见性 (public|members|paid)")
+}
+
+// collectMetaFields 从标志收集要写入的 SEO/元数据字段。这些字段
+// 不在 libecto 的结构中, 与 feature_image_alt 一样通过 Admin API
+// 单独 PUT。
+func collectMetaFields(cmd *cobra.Command) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+
+	stringFlags := map[string]string{
+		"excerpt":          "custom_excerpt",
+		"meta-title":       "meta_title",
+		"meta-description": "meta_description",
+		"og-image":         "og_image",
+		"canonical-url":    "canonical_url",
+	}
+	for flag, field := range stringFlags {
+		if cmd.Flags().Changed(flag) {
+			value, _ := cmd.Flags().GetString(flag)
+			fields[field] = value
+		}
+	}
+
+	if cmd.Flags().Changed("featured") {
+		featured, _ := cmd.Flags().GetBool("featured")
+		fields["featured"] = featured
+	}
+	if cmd.Flags().Changed("visibility") {
+		visibility, _ := cmd.Flags().GetString("visibility")
+		switch visibility {
+		case "public", "members", "paid":
+			fields["visibility"] = visibility
+		default:
+			return nil, fmt.Errorf("无效的可见性: %s (支持 public|members|paid)", visibility)
+		}
+	}
+
+	return fields, nil
+}
+
+// collectAuthors 把 --author 的值转成 Admin API 的 authors 列表。
+// 含 @ 的按邮箱匹配, 否则按别名; 第一个作者为主作者。
+func collectAuthors(cmd *cobra.Command) []map[string]string {
+	values, _ := cmd.Flags().GetStringArray("author")
+	var authors []map[string]string
+	for _, v := range values {
+		if strings.Contains(v, "@") {
+			authors = append(authors, map[string]string{"email": v})
+		} else {
+			authors = append(authors, map[string]string{"slug": v})
+		}
+	}
+	return authors
+}
+
+// setMetaFields 通过 Admin API 更新单个文章/页面的元数据字段。
+func setMetaFields(resource, id, updatedAt string, fields map[string]interface{}) error {
+	api, err := activeGhostAPI()
+	if err != nil {
+		return err
+	}
+
+	fields["updated_at"] = updatedAt
+	body := map[string]interface{}{
+		resource: []map[string]interface{}{fields},
+	}
+	_, err = api.Do("PUT", "/"+resource+"/"+id+"/", nil, body)
+	return err
+}
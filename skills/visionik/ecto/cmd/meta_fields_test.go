@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostCreateMetaFields(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	mdFile := filepath.Join(t.TempDir(), "post.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("body\n"), 0644))
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "create",
+		"--title", "SEO Post", "--markdown-file", mdFile,
+		"--meta-title", "SEO Title", "--meta-description", "desc",
+		"--canonical-url", "https://example.com/original",
+		"--featured", "--visibility", "members")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已创建文章")
+}
+
+func TestPostEditInvalidVisibility(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "post", "edit", "post-123", "--visibility", "secret")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "无效的可见性")
+}
+
+func TestPageEditMetaFields(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "page", "edit", "page-123", "--excerpt", "摘要")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已更新页面")
+}
+
+func TestPostEditAuthors(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "edit", "post-123",
+		"--author", "jane-doe", "--author", "sam@example.com")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已更新文章")
+}
+
+func TestCollectAuthors(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringArray("author", nil, "")
+	require.NoError(t, cmd.Flags().Set("author", "jane-doe"))
+	require.NoError(t, cmd.Flags().Set("author", "sam@example.com"))
+
+	authors := collectAuthors(cmd)
+	require.Len(t, authors, 2)
+	assert.Equal(t, map[string]string{"slug": "jane-doe"}, authors[0])
+	assert.Equal(t, map[string]string{"email": "sam@example.com"}, authors[1])
+}
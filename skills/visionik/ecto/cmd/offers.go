@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/ghostapi"
+)
+
+var offersCmd = &cobra.Command{
+	Use:   "offers",
+	Short: "管理促销优惠",
+}
+
+var offersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "列出促销优惠",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+
+		asJSON, _ := cmd.Flags().GetBool("json")
+
+		offers, err := api.ListOffers()
+		if err != nil {
+			return err
+		}
+
+		if asJSON {
+			return outputJSON(map[string]interface{}{"offers": offers})
+		}
+
+		if len(offers) == 0 {
+			println("未找到促销优惠")
+			return nil
+		}
+
+		for _, o := range offers {
+			printf("%s - %s (%s) %s [%s] 已兑换 %d 次\n",
+				o.ID, o.Name, o.Code, formatOfferAmount(o), o.Status, o.RedemptionCount)
+		}
+		return nil
+	},
+}
+
+var offersCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "创建促销优惠",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		code, _ := cmd.Flags().GetString("code")
+		tierID, _ := cmd.Flags().GetString("tier")
+		if name == "" || code == "" || tierID == "" {
+			return fmt.Errorf("--name、--code 和 --tier 是必需的")
+		}
+
+		offerType, _ := cmd.Flags().GetString("type")
+		amount, _ := cmd.Flags().GetInt("amount")
+		duration, _ := cmd.Flags().GetString("duration")
+		months, _ := cmd.Flags().GetInt("months")
+		cadence, _ := cmd.Flags().GetString("cadence")
+		displayTitle, _ := cmd.Flags().GetString("display-title")
+
+		offer := &ghostapi.Offer{
+			Name:             name,
+			Code:             code,
+			Type:             offerType,
+			Amount:           amount,
+			Duration:         duration,
+			DurationInMonths: months,
+			Cadence:          cadence,
+			DisplayTitle:     displayTitle,
+		}
+		offer.Tier = &struct {
+			ID   string `json:"id"`
+			Name string `json:"name,omitempty"`
+		}{ID: tierID}
+
+		created, err := api.CreateOffer(offer)
+		if err != nil {
+			return err
+		}
+
+		printf("已创建促销优惠: %s (%s) %s\n", created.ID, created.Code, formatOfferAmount(*created))
+		return nil
+	},
+}
+
+// formatOfferAmount 格式化优惠折扣额度。
+func formatOfferAmount(o ghostapi.Offer) string {
+	switch o.Type {
+	case "percent":
+		return fmt.Sprintf("-%d%%", o.Amount)
+	case "fixed":
+		return fmt.Sprintf("-%.2f %s", float64(o.Amount)/100, o.Currency)
+	case "trial":
+		return fmt.Sprintf("免费试用 %d 天", o.Amount)
+	}
+	return ""
+}
+
+func init() {
+	offersListCmd.Flags().Bool("json", false, "以JSON格式输出")
+
+	offersCreateCmd.Flags().String("name", "", "优惠名称 (必需)")
+	offersCreateCmd.Flags().String("code", "", "兑换代码 (必需, 出现在 URL 中)")
+	offersCreateCmd.Flags().String("tier", "", "适用的订阅等级 ID (必需)")
+	offersCreateCmd.Flags().String("type", "percent", "折扣类型 (percent|fixed|trial)")
+	offersCreateCmd.Flags().Int("amount", 0, "折扣额度 (percent: 百分比; fixed: 最小货币单位; trial: 天数)")
+	offersCreateCmd.Flags().String("duration", "once", "折扣周期 (once|forever|repeating|trial)")
+	offersCreateCmd.Flags().Int("months", 0, "repeating 周期的月数")
+	offersCreateCmd.Flags().String("cadence", "month", "计费周期 (month|year)")
+	offersCreateCmd.Flags().String("display-title", "", "门户中展示的标题")
+
+	offersCmd.AddCommand(offersListCmd)
+	offersCmd.AddCommand(offersCreateCmd)
+
+	rootCmd.AddCommand(offersCmd)
+}
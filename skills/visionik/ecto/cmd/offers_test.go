@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOffersCmd(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut []string
+		wantErr bool
+	}{
+		{
+			name:    "list offers",
+			args:    []string{"offers", "list"},
+			wantOut: []string{"offer-1", "black-friday", "-20%", "已兑换 3 次"},
+		},
+		{
+			name:    "list offers with json",
+			args:    []string{"offers", "list", "--json"},
+			wantOut: []string{`"offers"`, `"code"`},
+		},
+		{
+			name: "create offer",
+			args: []string{"offers", "create", "--name", "New Offer", "--code", "new-offer",
+				"--tier", "tier-2", "--amount", "10"},
+			wantOut: []string{"已创建促销优惠", "new-offer-123"},
+		},
+		{
+			name:    "create offer requires tier",
+			args:    []string{"offers", "create", "--name", "x", "--code", "y"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newTestRootCmd()
+			stdout, _, err := executeCommand(cmd, tt.args...)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, stdout, want)
+			}
+		})
+	}
+}
@@ -1,9 +1,9 @@
 package cmd
 
 import (
-	"bufio"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -103,29 +103,79 @@ var pageCreateCmd = &cobra.Command{
 
 		// 读取内容
 		var content []byte
+		var htmlBody string
 		if mdFile != "" {
 			content, err = os.ReadFile(mdFile)
 			if err != nil {
 				return fmt.Errorf("读取markdown文件: %w", err)
 			}
-		} else if stdinFormat == "markdown" {
-			scanner := bufio.NewScanner(os.Stdin)
-			var lines []string
-			for scanner.Scan() {
-				lines = append(lines, scanner.Text())
+		} else if stdinFormat != "" {
+			raw, err := readStdin()
+			if err != nil {
+				return err
+			}
+			switch stdinFormat {
+			case "markdown":
+				content = raw
+			case "html":
+				htmlBody = string(raw)
+			case "text":
+				htmlBody = plaintextToHTML(string(raw))
+			default:
+				return fmt.Errorf("无效的 --stdin-format: %s (支持 markdown|html|text)", stdinFormat)
 			}
-			content = []byte(strings.Join(lines, "\n"))
 		}
 
+		// 上传正文引用的本地图片并改写 URL
 		if len(content) > 0 {
+			baseDir := "."
+			if mdFile != "" {
+				baseDir = filepath.Dir(mdFile)
+			}
+			if content, err = uploadLocalImages(client, content, baseDir); err != nil {
+				return err
+			}
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		lexDoc, err := lexicalFromContent(format, content)
+		if err != nil {
+			return err
+		}
+		if len(content) > 0 && lexDoc == "" {
 			page.HTML = libecto.MarkdownToHTML(content)
 		}
+		if htmlBody != "" {
+			page.HTML = htmlBody
+		}
+
+		metaFields, err := collectMetaFields(cmd)
+		if err != nil {
+			return err
+		}
 
 		created, err := client.CreatePage(page)
 		if err != nil {
 			return err
 		}
 
+		// SEO/元数据字段不在 libecto 的 Page 结构中, 单独写入
+		if len(metaFields) > 0 {
+			if err := setMetaFields("pages", created.ID, created.UpdatedAt, metaFields); err != nil {
+				return fmt.Errorf("设置元数据: %w", err)
+			}
+		}
+
+		// lexical 正文不在 libecto 的 Page 结构中, 单独写入
+		if lexDoc != "" && len(content) > 0 {
+			if err := setLexicalBody("pages", created.ID, created.UpdatedAt, lexDoc); err != nil {
+				return fmt.Errorf("写入 lexical 正文: %w", err)
+			}
+		}
+
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "created", "resource": "page", "id": created.ID, "slug": created.Slug})
+		}
 		printf("已创建页面: %s (%s)\n", created.ID, created.Slug)
 		return nil
 	},
@@ -156,12 +206,28 @@ var pageEditCmd = &cobra.Command{
 		if status, _ := cmd.Flags().GetString("status"); status != "" {
 			update.Status = status
 		}
+		var lexDoc string
 		if mdFile, _ := cmd.Flags().GetString("markdown-file"); mdFile != "" {
 			content, err := os.ReadFile(mdFile)
 			if err != nil {
 				return fmt.Errorf("读取markdown文件: %w", err)
 			}
-			update.HTML = libecto.MarkdownToHTML(content)
+			// 上传正文引用的本地图片并改写 URL
+			if content, err = uploadLocalImages(client, content, filepath.Dir(mdFile)); err != nil {
+				return err
+			}
+			format, _ := cmd.Flags().GetString("format")
+			if lexDoc, err = lexicalFromContent(format, content); err != nil {
+				return err
+			}
+			if lexDoc == "" {
+				update.HTML = libecto.MarkdownToHTML(content)
+			}
+		}
+
+		metaFields, err := collectMetaFields(cmd)
+		if err != nil {
+			return err
 		}
 
 		updated, err := client.UpdatePage(existing.ID, update)
@@ -169,6 +235,21 @@ var pageEditCmd = &cobra.Command{
 			return err
 		}
 
+		if len(metaFields) > 0 {
+			if err := setMetaFields("pages", updated.ID, updated.UpdatedAt, metaFields); err != nil {
+				return fmt.Errorf("设置元数据: %w", err)
+			}
+		}
+
+		if lexDoc != "" {
+			if err := setLexicalBody("pages", updated.ID, updated.UpdatedAt, lexDoc); err != nil {
+				return fmt.Errorf("写入 lexical 正文: %w", err)
+			}
+		}
+
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "updated", "resource": "page", "id": updated.ID})
+		}
 		printf("已更新页面: %s\n", updated.ID)
 		return nil
 	},
@@ -205,6 +286,9 @@ var pageDeleteCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "deleted", "resource": "page", "id": page.ID})
+		}
 		printf("已删除页面: %s\n", page.ID)
 		return nil
 	},
@@ -225,6 +309,9 @@ var pagePublishCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "published", "resource": "page", "id": updated.ID})
+		}
 		printf("已发布页面: %s\n", updated.ID)
 		return nil
 	},
@@ -240,11 +327,15 @@ func init() {
 	pageCreateCmd.Flags().String("title", "", "页面标题 (必需)")
 	pageCreateCmd.Flags().String("status", "draft", "页面状态 (draft|published)")
 	pageCreateCmd.Flags().String("markdown-file", "", "内容的markdown文件路径")
-	pageCreateCmd.Flags().String("stdin-format", "", "从stdin读取内容 (markdown)")
+	pageCreateCmd.Flags().String("stdin-format", "", "从stdin读取内容 (markdown|html|text)")
+	pageCreateCmd.Flags().String("format", "html", "内容写入格式 (html|lexical)")
+	registerMetaFlags(pageCreateCmd)
 
 	pageEditCmd.Flags().String("title", "", "新标题")
 	pageEditCmd.Flags().String("status", "", "新状态")
 	pageEditCmd.Flags().String("markdown-file", "", "新内容的markdown文件路径")
+	pageEditCmd.Flags().String("format", "html", "内容写入格式 (html|lexical)")
+	registerMetaFlags(pageEditCmd)
 
 	pageDeleteCmd.Flags().Bool("force", false, "无需确认直接删除")
 
@@ -1,14 +1,16 @@
 package cmd
 
 import (
-	"bufio"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/ecto/internal/ghostapi"
 	"github.com/visionik/libecto"
 )
 
@@ -24,28 +26,137 @@ var postsCmd = &cobra.Command{
 		status, _ := cmd.Flags().GetString("status")
 		limit, _ := cmd.Flags().GetInt("limit")
 		asJSON, _ := cmd.Flags().GetBool("json")
-
-		resp, err := client.ListPosts(status, limit)
-		if err != nil {
-			return err
+		all, _ := cmd.Flags().GetBool("all")
+		filter, _ := cmd.Flags().GetString("filter")
+		order, _ := cmd.Flags().GetString("order")
+		fields, _ := cmd.Flags().GetString("fields")
+		page, _ := cmd.Flags().GetInt("page")
+
+		var posts []libecto.Post
+		if filter != "" || order != "" || fields != "" || page > 0 {
+			// NQL 查询直接走 Admin API, 保留服务端的过滤/排序/字段选择
+			if all {
+				return fmt.Errorf("--all 不能与 --filter/--order/--fields/--page 同时使用")
+			}
+			raw, queried, err := queryPosts(status, filter, order, fields, page, limit)
+			if err != nil {
+				return err
+			}
+			if asJSON {
+				_, err = output.Write(append(raw, '\n'))
+				return err
+			}
+			posts = queried
+		} else if all {
+			// --all 时按页并发抓取整个站点的文章
+			posts, err = fetchAllPosts(status, limit)
+			if err != nil {
+				return err
+			}
+		} else {
+			resp, err := client.ListPosts(status, limit)
+			if err != nil {
+				return err
+			}
+			posts = resp.Posts
 		}
 
 		if asJSON {
-			return outputJSON(resp)
+			return outputJSON(map[string]interface{}{"posts": posts})
 		}
 
-		if len(resp.Posts) == 0 {
+		if len(posts) == 0 {
 			println("未找到文章")
 			return nil
 		}
 
-		for _, p := range resp.Posts {
+		for _, p := range posts {
 			printf("[%s] %s - %s (%s)\n", p.Status, p.ID, p.Title, p.Slug)
 		}
 		return nil
 	},
 }
 
+// queryPosts 用 Ghost 的 NQL 过滤语法查询文章, 返回原始响应
+// (--json 时原样输出, 保留 --fields 的字段选择) 和解析后的列表。
+func queryPosts(status, filter, order, fields string, page, limit int) ([]byte, []libecto.Post, error) {
+	site, err := config.GetActiveSite(siteName)
+	if err != nil {
+		return nil, nil, err
+	}
+	api, err := ghostapi.NewClient(site.URL, site.APIKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	} else if status != "" && status != "all" {
+		query.Set("filter", "status:"+status)
+	}
+	if order != "" {
+		query.Set("order", order)
+	}
+	if fields != "" {
+		query.Set("fields", fields)
+	} else {
+		query.Set("formats", "html")
+	}
+	if page > 0 {
+		query.Set("page", fmt.Sprintf("%d", page))
+	}
+	query.Set("limit", fmt.Sprintf("%d", limit))
+
+	raw, err := api.Get("/posts/", query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp struct {
+		Posts []libecto.Post `json:"posts"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, nil, err
+	}
+	return raw, resp.Posts, nil
+}
+
+// fetchAllPosts 通过 ghostapi 客户端按页并发抓取所有文章并按页序合并。
+func fetchAllPosts(status string, pageSize int) ([]libecto.Post, error) {
+	site, err := config.GetActiveSite(siteName)
+	if err != nil {
+		return nil, err
+	}
+	api, err := ghostapi.NewClient(site.URL, site.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if status != "" && status != "all" {
+		query.Set("filter", "status:"+status)
+	}
+	query.Set("formats", "html")
+
+	pages, err := api.FetchAllPages("/posts/", query, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var posts []libecto.Post
+	for _, page := range pages {
+		var resp struct {
+			Posts []libecto.Post `json:"posts"`
+		}
+		if err := json.Unmarshal(page, &resp); err != nil {
+			return nil, err
+		}
+		posts = append(posts, resp.Posts...)
+	}
+	return posts, nil
+}
+
 var postCmd = &cobra.Command{
 	Use:   "post <id|slug>",
 	Short: "获取单个文章",
@@ -107,35 +218,96 @@ var postCreateCmd = &cobra.Command{
 		mdFile, _ := cmd.Flags().GetString("markdown-file")
 		stdinFormat, _ := cmd.Flags().GetString("stdin-format")
 		tagsStr, _ := cmd.Flags().GetString("tag")
+		featureImage, _ := cmd.Flags().GetString("feature-image")
 
-		if title == "" {
-			return fmt.Errorf("--title 是必需的")
-		}
+		useEditor, _ := cmd.Flags().GetBool("editor")
 
-		post := &libecto.Post{
-			Title:  title,
-			Status: status,
-		}
+		post := &libecto.Post{}
 
 		// 读取内容
 		var content []byte
-		if mdFile != "" {
+		var htmlBody string
+		if useEditor {
+			content, err = openEditor()
+			if err != nil {
+				return err
+			}
+		} else if mdFile != "" {
 			content, err = os.ReadFile(mdFile)
 			if err != nil {
 				return fmt.Errorf("读取markdown文件: %w", err)
 			}
-		} else if stdinFormat == "markdown" {
-			scanner := bufio.NewScanner(os.Stdin)
-			var lines []string
-			for scanner.Scan() {
-				lines = append(lines, scanner.Text())
+		} else if stdinFormat != "" {
+			raw, err := readStdin()
+			if err != nil {
+				return err
+			}
+			switch stdinFormat {
+			case "markdown":
+				content = raw
+			case "html":
+				htmlBody = string(raw)
+			case "text":
+				htmlBody = plaintextToHTML(string(raw))
+			default:
+				return fmt.Errorf("无效的 --stdin-format: %s (支持 markdown|html|text)", stdinFormat)
+			}
+		}
+
+		// front matter 描述文章元数据, 显式标志优先 (文件和编辑器输入)
+		if useEditor || mdFile != "" {
+			fm, body, err := splitFrontMatter(content)
+			if err != nil {
+				return err
+			}
+			content = body
+			if fm != nil {
+				if title == "" {
+					title = fm.Title
+				}
+				if !cmd.Flags().Changed("status") && fm.Status != "" {
+					status = fm.Status
+				}
+				if tagsStr == "" {
+					tagsStr = strings.Join(fm.Tags, ",")
+				}
+				if featureImage == "" {
+					featureImage = fm.FeatureImage
+				}
+				post.Slug = fm.Slug
+				post.Excerpt = fm.Excerpt
+				post.PublishedAt = fm.PublishedAt
 			}
-			content = []byte(strings.Join(lines, "\n"))
 		}
 
+		// 上传正文引用的本地图片并改写 URL
 		if len(content) > 0 {
+			baseDir := "."
+			if mdFile != "" {
+				baseDir = filepath.Dir(mdFile)
+			}
+			if content, err = uploadLocalImages(client, content, baseDir); err != nil {
+				return err
+			}
+		}
+
+		if title == "" {
+			return fmt.Errorf("--title 是必需的")
+		}
+		post.Title = title
+		post.Status = status
+
+		format, _ := cmd.Flags().GetString("format")
+		lexDoc, err := lexicalFromContent(format, content)
+		if err != nil {
+			return err
+		}
+		if len(content) > 0 && lexDoc == "" {
 			post.HTML = libecto.MarkdownToHTML(content)
 		}
+		if htmlBody != "" {
+			post.HTML = htmlBody
+		}
 
 		// 处理标签
 		if tagsStr != "" {
@@ -145,13 +317,63 @@ var postCreateCmd = &cobra.Command{
 			}
 		}
 
+		// 处理特色图片 (URL 或本地文件)
+		if featureImage != "" {
+			imgURL, err := resolveFeatureImage(client, featureImage)
+			if err != nil {
+				return err
+			}
+			post.FeatureImage = imgURL
+		}
+
+		metaFields, err := collectMetaFields(cmd)
+		if err != nil {
+			return err
+		}
+		if authors := collectAuthors(cmd); len(authors) > 0 {
+			metaFields["authors"] = authors
+		}
+
+		if err := runHooks("pre-create", post); err != nil {
+			return err
+		}
+
 		created, err := client.CreatePost(post)
 		if err != nil {
 			return err
 		}
 
+		// lexical 正文不在 libecto 的 Post 结构中, 单独写入
+		if lexDoc != "" && len(content) > 0 {
+			if err := setLexicalBody("posts", created.ID, created.UpdatedAt, lexDoc); err != nil {
+				return fmt.Errorf("写入 lexical 正文: %w", err)
+			}
+		}
+
+		// alt/caption 不在 libecto 的 Post 结构中, 单独设置
+		alt, _ := cmd.Flags().GetString("feature-image-alt")
+		caption, _ := cmd.Flags().GetString("feature-image-caption")
+		if alt != "" || caption != "" {
+			if err := setFeatureImageMeta(created.ID, created.UpdatedAt, alt, caption); err != nil {
+				return fmt.Errorf("设置特色图片元数据: %w", err)
+			}
+		}
+
+		// SEO/元数据字段不在 libecto 的 Post 结构中, 单独写入
+		if len(metaFields) > 0 {
+			if err := setMetaFields("posts", created.ID, created.UpdatedAt, metaFields); err != nil {
+				return fmt.Errorf("设置元数据: %w", err)
+			}
+		}
+
+		if wantJSON(cmd) {
+			if err := outputJSON(map[string]interface{}{"action": "created", "resource": "post", "id": created.ID, "slug": created.Slug}); err != nil {
+				return err
+			}
+			return runHooks("post-create", created)
+		}
 		printf("已创建文章: %s (%s)\n", created.ID, created.Slug)
-		return nil
+		return runHooks("post-create", created)
 	},
 }
 
@@ -185,15 +407,39 @@ var postEditCmd = &cobra.Command{
 			update.PublishedAt = publishAt
 			update.Status = "scheduled"
 		}
+		var lexDoc string
 		if mdFile, _ := cmd.Flags().GetString("markdown-file"); mdFile != "" {
 			content, err := os.ReadFile(mdFile)
 			if err != nil {
 				return fmt.Errorf("读取markdown文件: %w", err)
 			}
-			update.HTML = libecto.MarkdownToHTML(content)
+			// 上传正文引用的本地图片并改写 URL
+			if content, err = uploadLocalImages(client, content, filepath.Dir(mdFile)); err != nil {
+				return err
+			}
+			format, _ := cmd.Flags().GetString("format")
+			if lexDoc, err = lexicalFromContent(format, content); err != nil {
+				return err
+			}
+			if lexDoc == "" {
+				update.HTML = libecto.MarkdownToHTML(content)
+			}
 		}
+		// 特色图片可以是 URL 或本地文件路径
 		if featureImage, _ := cmd.Flags().GetString("feature-image"); featureImage != "" {
-			update.FeatureImage = featureImage
+			imgURL, err := resolveFeatureImage(client, featureImage)
+			if err != nil {
+				return err
+			}
+			update.FeatureImage = imgURL
+		}
+
+		metaFields, err := collectMetaFields(cmd)
+		if err != nil {
+			return err
+		}
+		if authors := collectAuthors(cmd); len(authors) > 0 {
+			metaFields["authors"] = authors
 		}
 
 		updated, err := client.UpdatePost(existing.ID, update)
@@ -201,6 +447,30 @@ var postEditCmd = &cobra.Command{
 			return err
 		}
 
+		if len(metaFields) > 0 {
+			if err := setMetaFields("posts", updated.ID, updated.UpdatedAt, metaFields); err != nil {
+				return fmt.Errorf("设置元数据: %w", err)
+			}
+		}
+
+		if lexDoc != "" {
+			if err := setLexicalBody("posts", updated.ID, updated.UpdatedAt, lexDoc); err != nil {
+				return fmt.Errorf("写入 lexical 正文: %w", err)
+			}
+		}
+
+		// alt/caption 不在 libecto 的 Post 结构中, 单独设置
+		alt, _ := cmd.Flags().GetString("feature-image-alt")
+		caption, _ := cmd.Flags().GetString("feature-image-caption")
+		if alt != "" || caption != "" {
+			if err := setFeatureImageMeta(updated.ID, updated.UpdatedAt, alt, caption); err != nil {
+				return fmt.Errorf("设置特色图片元数据: %w", err)
+			}
+		}
+
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "updated", "resource": "post", "id": updated.ID})
+		}
 		printf("已更新文章: %s\n", updated.ID)
 		return nil
 	},
@@ -234,12 +504,22 @@ var postDeleteCmd = &cobra.Command{
 			}
 		}
 
+		if err := runHooks("pre-delete", post); err != nil {
+			return err
+		}
+
 		if err := client.DeletePost(post.ID); err != nil {
 			return err
 		}
 
+		if wantJSON(cmd) {
+			if err := outputJSON(map[string]interface{}{"action": "deleted", "resource": "post", "id": post.ID}); err != nil {
+				return err
+			}
+			return runHooks("post-delete", post)
+		}
 		printf("已删除文章: %s\n", post.ID)
-		return nil
+		return runHooks("post-delete", post)
 	},
 }
 
@@ -253,16 +533,89 @@ var postPublishCmd = &cobra.Command{
 			return err
 		}
 
+		newsletter, _ := cmd.Flags().GetString("newsletter")
+		segment, _ := cmd.Flags().GetString("email-segment")
+		switch segment {
+		case "", "none", "all", "free", "paid":
+		default:
+			return fmt.Errorf("无效的 --email-segment: %s (支持 all|free|paid|none)", segment)
+		}
+		if segment != "" && segment != "none" && newsletter == "" {
+			return fmt.Errorf("--email-segment 需要与 --newsletter 一起使用")
+		}
+
+		if hasHooks("pre-publish") {
+			post, err := client.GetPost(args[0])
+			if err != nil {
+				return err
+			}
+			if err := runHooks("pre-publish", post); err != nil {
+				return err
+			}
+		}
+
+		// 指定 newsletter 时带邮件发送参数发布, 否则仅改状态 (不发邮件)
+		if newsletter != "" && segment != "none" {
+			post, err := client.GetPost(args[0])
+			if err != nil {
+				return err
+			}
+			if err := publishWithEmail(post.ID, post.UpdatedAt, newsletter, segment); err != nil {
+				return err
+			}
+			if wantJSON(cmd) {
+				if err := outputJSON(map[string]interface{}{"action": "published", "resource": "post", "id": post.ID, "newsletter": newsletter}); err != nil {
+					return err
+				}
+				return runHooks("post-publish", post)
+			}
+			printf("已发布文章并发送邮件: %s (newsletter: %s)\n", post.ID, newsletter)
+			return runHooks("post-publish", post)
+		}
+
 		updated, err := client.PublishPost(args[0])
 		if err != nil {
 			return err
 		}
 
+		if wantJSON(cmd) {
+			if err := outputJSON(map[string]interface{}{"action": "published", "resource": "post", "id": updated.ID}); err != nil {
+				return err
+			}
+			return runHooks("post-publish", updated)
+		}
 		printf("已发布文章: %s\n", updated.ID)
-		return nil
+		return runHooks("post-publish", updated)
 	},
 }
 
+// publishWithEmail 通过 Admin API 发布文章, 用 ?newsletter 和
+// ?email_segment 控制邮件发送 (libecto 的 PublishPost 不带这两个参数)。
+func publishWithEmail(id, updatedAt, newsletter, segment string) error {
+	api, err := activeGhostAPI()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("newsletter", newsletter)
+	switch segment {
+	case "free":
+		query.Set("email_segment", "status:free")
+	case "paid":
+		query.Set("email_segment", "status:-free")
+	}
+
+	body := map[string]interface{}{
+		"posts": []map[string]interface{}{{
+			"status":     "published",
+			"updated_at": updatedAt,
+		}},
+	}
+	_, err = api.Do("PUT", "/posts/"+id+"/", query, body)
+	return err
+}
+
 var postUnpublishCmd = &cobra.Command{
 	Use:   "unpublish <id|slug>",
 	Short: "取消发布文章（设置为草稿）",
@@ -278,6 +631,9 @@ var postUnpublishCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "unpublished", "resource": "post", "id": updated.ID})
+		}
 		printf("已取消发布文章: %s\n", updated.ID)
 		return nil
 	},
@@ -304,6 +660,9 @@ var postScheduleCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "scheduled", "resource": "post", "id": updated.ID, "published_at": at})
+		}
 		printf("已安排文章 %s 于 %s 发布\n", updated.ID, at)
 		return nil
 	},
@@ -318,28 +677,48 @@ func outputJSON(v interface{}) error {
 
 func init() {
 	postsCmd.Flags().String("status", "", "按状态过滤 (draft|published|scheduled|all)")
-	postsCmd.Flags().Int("limit", 15, "返回的文章数量")
+	postsCmd.Flags().Int("limit", 15, "返回的文章数量（--all 时为每页大小）")
 	postsCmd.Flags().Bool("json", false, "以JSON格式输出")
+	postsCmd.Flags().Bool("all", false, "抓取所有页面（按页并发）")
+	postsCmd.Flags().String("filter", "", "NQL 过滤表达式 (如 tag:news+published_at:>2025-01-01)")
+	postsCmd.Flags().String("order", "", "排序 (如 \"published_at desc\")")
+	postsCmd.Flags().String("fields", "", "逗号分隔的返回字段 (如 title,slug)")
+	postsCmd.Flags().Int("page", 0, "页码 (配合 --limit 分页)")
 
 	postCmd.Flags().Bool("json", false, "以JSON格式输出")
 	postCmd.Flags().Bool("body", false, "包含完整HTML正文")
 
-	postCreateCmd.Flags().String("title", "", "文章标题 (必需)")
+	postCreateCmd.Flags().String("title", "", "文章标题 (必需, 可由 front matter 提供)")
 	postCreateCmd.Flags().String("status", "draft", "文章状态 (draft|published)")
-	postCreateCmd.Flags().String("markdown-file", "", "内容的markdown文件路径")
-	postCreateCmd.Flags().String("stdin-format", "", "从stdin读取内容 (markdown)")
+	postCreateCmd.Flags().String("markdown-file", "", "内容的markdown文件路径 (可含 YAML front matter)")
+	postCreateCmd.Flags().String("stdin-format", "", "从stdin读取内容 (markdown|html|text)")
+	postCreateCmd.Flags().Bool("editor", false, "用 $EDITOR 打开 front matter 模板撰写")
+	postCreateCmd.Flags().String("format", "html", "内容写入格式 (html|lexical)")
 	postCreateCmd.Flags().String("tag", "", "逗号分隔的标签")
+	postCreateCmd.Flags().String("feature-image", "", "特色图片URL或本地文件路径")
+	postCreateCmd.Flags().String("feature-image-alt", "", "特色图片的替代文本")
+	postCreateCmd.Flags().String("feature-image-caption", "", "特色图片的说明文字")
+	registerMetaFlags(postCreateCmd)
+	postCreateCmd.Flags().StringArray("author", nil, "作者 (slug 或邮箱, 可重复; 第一个为主作者)")
 
 	postEditCmd.Flags().String("title", "", "新标题")
 	postEditCmd.Flags().String("status", "", "新状态")
 	postEditCmd.Flags().String("markdown-file", "", "新内容的markdown文件路径")
+	postEditCmd.Flags().String("format", "html", "内容写入格式 (html|lexical)")
 	postEditCmd.Flags().String("publish-at", "", "安排发布时间 (ISO8601)")
-	postEditCmd.Flags().String("feature-image", "", "特色图片URL")
+	postEditCmd.Flags().String("feature-image", "", "特色图片URL或本地文件路径")
+	postEditCmd.Flags().String("feature-image-alt", "", "特色图片的替代文本")
+	postEditCmd.Flags().String("feature-image-caption", "", "特色图片的说明文字")
+	registerMetaFlags(postEditCmd)
+	postEditCmd.Flags().StringArray("author", nil, "作者 (slug 或邮箱, 可重复; 第一个为主作者)")
 
 	postDeleteCmd.Flags().Bool("force", false, "无需确认直接删除")
 
 	postScheduleCmd.Flags().String("at", "", "发布时间 (ISO8601)")
 
+	postPublishCmd.Flags().String("newsletter", "", "通过指定 newsletter (slug) 发送邮件")
+	postPublishCmd.Flags().String("email-segment", "", "邮件接收者 (all|free|paid|none)")
+
 	postCmd.AddCommand(postCreateCmd)
 	postCmd.AddCommand(postEditCmd)
 	postCmd.AddCommand(postDeleteCmd)
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostsFilter(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "posts",
+		"--filter", "tag:news+published_at:>2025-01-01", "--order", "published_at desc")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Post One")
+	assert.Contains(t, stdout, "Post Two")
+}
+
+func TestPostsFilterJSONKeepsRawFields(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "posts", "--fields", "title,slug", "--json")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"post-one"`)
+}
+
+func TestPostsFilterConflictsWithAll(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "posts", "--all", "--filter", "tag:news")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--all 不能与")
+}
+
+func TestPostPublishWithNewsletter(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "publish", "test-post",
+		"--newsletter", "weekly", "--email-segment", "paid")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已发布文章并发送邮件")
+	assert.Contains(t, stdout, "weekly")
+}
+
+func TestPostPublishInvalidSegment(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "post", "publish", "test-post", "--email-segment", "everyone")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "无效的 --email-segment")
+}
+
+func TestPostPublishSegmentRequiresNewsletter(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "post", "publish", "test-post", "--email-segment", "all")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--email-segment 需要与 --newsletter 一起使用")
+}
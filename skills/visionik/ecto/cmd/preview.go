@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+)
+
+var postPreviewCmd = &cobra.Command{
+	Use:   "preview <id|slug>",
+	Short: "打印草稿的预览 URL",
+	Long: `用文章的 uuid 生成 Ghost 预览链接 (/p/<uuid>/), 无需登录即可
+查看草稿 — 适合终端里的编辑审阅或 CI 评论机器人。`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, err := config.GetActiveClient(siteName)
+		if err != nil {
+			return err
+		}
+
+		// 先解析 id/slug, 再单独取 uuid (libecto 的 Post 结构不含它)
+		post, err := client.GetPost(args[0])
+		if err != nil {
+			return err
+		}
+
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+		body, err := api.Get("/posts/"+post.ID+"/", url.Values{"fields": {"uuid"}})
+		if err != nil {
+			return err
+		}
+
+		var resp struct {
+			Posts []struct {
+				UUID string `json:"uuid"`
+			} `json:"posts"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return err
+		}
+		if len(resp.Posts) == 0 || resp.Posts[0].UUID == "" {
+			return fmt.Errorf("文章没有 uuid: %s", args[0])
+		}
+
+		site, err := config.GetActiveSite(siteName)
+		if err != nil {
+			return err
+		}
+		previewURL := fmt.Sprintf("%s/p/%s/", strings.TrimRight(site.URL, "/"), resp.Posts[0].UUID)
+
+		asJSON, _ := cmd.Flags().GetBool("json")
+		if asJSON {
+			return outputJSON(map[string]string{"id": post.ID, "preview_url": previewURL})
+		}
+		println(previewURL)
+
+		if open, _ := cmd.Flags().GetBool("open"); open {
+			if err := openInBrowser(previewURL); err != nil {
+				return fmt.Errorf("打开浏览器: %w", err)
+			}
+		}
+		return nil
+	},
+}
+
+// openInBrowser 用平台默认浏览器打开 URL。
+func openInBrowser(url string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	default:
+		return exec.Command("xdg-open", url).Start()
+	}
+}
+
+func init() {
+	postPreviewCmd.Flags().Bool("json", false, "以JSON格式输出")
+	postPreviewCmd.Flags().Bool("open", false, "在默认浏览器中打开预览")
+
+	postCmd.AddCommand(postPreviewCmd)
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostPreview(t *testing.T) {
+	server, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "preview", "test-post")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, server.URL+"/p/f47ac10b-58cc-4372-a567-0e02b2c3d479/")
+}
+
+func TestPostPreviewJSON(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "post", "preview", "test-post", "--json")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"preview_url"`)
+	assert.Contains(t, stdout, "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+}
@@ -3,11 +3,14 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/ghostapi"
 )
 
 var siteName string
@@ -38,6 +41,20 @@ func println(a ...interface{}) {
 
 var aiHelp bool
 
+var verboseHTTP bool
+
+// jsonOutput 是全局 --json 标志; 带本地 json 标志的命令优先用本地值。
+var jsonOutput bool
+
+// wantJSON 返回命令是否要求 JSON 输出 (本地或全局 --json)。
+func wantJSON(cmd *cobra.Command) bool {
+	if f := cmd.Flags().Lookup("json"); f != nil {
+		v, _ := cmd.Flags().GetBool("json")
+		return v
+	}
+	return jsonOutput
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "ecto",
 	Short: "Ghost.io Admin API 的命令行工具",
@@ -75,6 +92,8 @@ ecto auth add <名称> --url <ghost-url> --key <admin-api-key>
 ecto auth list
 ecto auth default <名称>
 ecto auth remove <名称>
+ecto auth test [名称]  # 验证连接并报告 Ghost 版本/权限范围
+auth add 加 --keyring 时密钥存入系统钥匙串 (macOS security / Linux secret-tool), 配置文件只留占位符
 
 环境变量覆盖:
 - GHOST_URL: Ghost 站点 URL
@@ -85,13 +104,20 @@ ecto auth remove <名称>
 
 ### 文章
 ecto posts [--状态 draft|published|scheduled|all] [--限制 N] [--json]
+ecto posts --filter "tag:news+published_at:>2025-01-01" [--order "published_at desc"] [--fields title,slug] [--page N]
 ecto post <id|slug> [--json] [--body]
-ecto post create --title "标题" [--markdown-file file.md] [--stdin-format markdown] [--tag tag1,tag2] [--状态 draft|published]
+ecto post create --title "标题" [--markdown-file file.md] [--stdin-format markdown|html|text] [--editor] [--format html|lexical] [--tag tag1,tag2] [--状态 draft|published]
+  # markdown 文件可带 YAML front matter (title/slug/tags/status/feature_image/excerpt/published_at); 标志优先
+  # create/edit 还支持 SEO 标志: --excerpt --meta-title --meta-description --og-image --canonical-url --featured --visibility public|members|paid
+  # post create/edit 支持 --author slug|email (可重复, 第一个为主作者)
 ecto post edit <id|slug> [--title "新标题"] [--markdown-file file.md] [--状态 draft|published]
 ecto post delete <id|slug> [--force]
-ecto post publish <id|slug>
+ecto post publish <id|slug> [--newsletter <slug>] [--email-segment all|free|paid|none]
 ecto post unpublish <id|slug>
 ecto post schedule <id|slug> --at "2025-01-25T10:00:00Z"
+ecto post preview <id|slug> [--open] [--json]  # 草稿预览链接
+ecto import ./content/ [--glob "*.md"] [--dry-run]  # 按别名批量创建/更新
+ecto export --out ./backup/ [--status published] [--skip-images]  # 导出为 Markdown + 图片
 
 ### 页面
 ecto pages [--状态 draft|published|all] [--限制 N] [--json]
@@ -114,14 +140,25 @@ ecto image upload <路径> [--json]
 
 ## 站点信息
 ecto site [--json]
+ecto snapshot [--out site.json]  # 设置/标签/等级/newsletter/路由快照
+ecto check [--json]  # 内容体检: 失效站内链接/缺特色图/缺 SEO 描述, 有问题时退出码非零
 ecto settings [--json]
 ecto users [--json]
 ecto user <id|slug> [--json]
 ecto newsletters [--json]
 ecto newsletter <id> [--json]
 
+## 订阅等级与优惠
+ecto tiers list [--json]
+ecto tiers get <id> [--json]
+ecto tiers create --name <名称> [--monthly <分>] [--yearly <分>] [--benefit <权益>...]
+ecto tiers edit <id> [--monthly <分>] [--active=false]
+ecto offers list [--json]
+ecto offers create --name <名称> --code <代码> --tier <等级ID> [--type percent|fixed|trial] [--amount <额度>]
+
 ## Webhooks
-注意: Ghost API 仅支持创建/删除，不支持列出 webhooks。
+注意: Ghost API 不支持列出 webhooks; ecto webhook list 只显示通过 ecto 创建的。
+ecto webhook list [--json]
 ecto webhook create --event <event> --target-url <url> [--name "Hook 名称"]
 ecto webhook delete <id> [--force]
 
@@ -133,8 +170,10 @@ ecto posts --site blog2
 ecto post create --title "测试" --site staging
 
 ## 输出格式
-所有读取命令都支持 --json 以获取机器可读输出:
+全局 --json 标志对所有命令生效, 包括 create/edit/delete 的结果:
 ecto posts --json | jq '.posts[].title'
+ecto post delete my-post --force --json   # {"action":"deleted","resource":"post","id":...}
+出错且带 --json 时, stderr 会输出 {"error": "...", "code": N}。
 
 ## 常见工作流程
 
@@ -167,7 +206,7 @@ done
 }
 
 ## 错误处理
-- 错误时返回非零退出码
+- 稳定退出码: 1=一般错误, 2=参数/校验错误, 3=认证失败, 4=资源未找到
 - 错误消息在可用时包含 API 错误详情
 - 使用 --force 跳过破坏性操作的确认提示
 
@@ -182,11 +221,41 @@ done
 // Execute 运行根命令并在出错时退出。
 // 这是 CLI 的主要入口点。
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+	err := rootCmd.Execute()
+
+	// 命令结束后输出请求汇总 (包括失败的命令)
+	if verboseHTTP {
+		fmt.Fprintln(os.Stderr, ghostapi.RequestSummary())
+	}
+
+	if err != nil {
+		code := exitCode(err)
+		if jsonOutput {
+			detail, _ := json.Marshal(map[string]interface{}{"error": err.Error(), "code": code})
+			fmt.Fprintln(os.Stderr, string(detail))
+		}
+		os.Exit(code)
 	}
 }
 
+// exitCode 把错误归类为稳定的退出码, 方便脚本判断:
+// 2=参数/校验错误, 3=认证失败, 4=资源未找到, 1=其他。
+func exitCode(err error) int {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "401") || strings.Contains(msg, "Unauthorized") ||
+		strings.Contains(msg, "认证") || strings.Contains(msg, "invalid admin key"):
+		return 3
+	case strings.Contains(msg, "404") || strings.Contains(msg, "not found") ||
+		strings.Contains(msg, "NotFound") || strings.Contains(msg, "未找到"):
+		return 4
+	case strings.Contains(msg, "必需") || strings.Contains(msg, "无效") ||
+		strings.Contains(msg, "不能与") || strings.Contains(msg, "需要与"):
+		return 2
+	}
+	return 1
+}
+
 // RootCmd 返回用于测试的根命令。
 func RootCmd() *cobra.Command {
 	return rootCmd
@@ -195,4 +264,11 @@ func RootCmd() *cobra.Command {
 func init() {
 	rootCmd.PersistentFlags().StringVar(&siteName, "site", "", "要使用的站点名称 (默认: 配置的默认值)")
 	rootCmd.PersistentFlags().BoolVar(&aiHelp, "ai-help", false, "显示 LLM/AI 智能体的详细帮助")
+	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "以JSON格式输出 (所有命令)")
+	rootCmd.PersistentFlags().BoolVar(&verboseHTTP, "verbose-http", false, "记录每个 Admin API 请求的方法/路径/状态/耗时 (已脱敏)")
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		if verboseHTTP {
+			ghostapi.SetVerbose(true)
+		}
+	}
 }
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "导出站点配置快照",
+	Long: `把设置、标签、订阅等级、newsletter 和路由抓取到一个 JSON 文件,
+用于环境间 diff 或配置备份。拿不到的部分会警告并跳过。`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out, _ := cmd.Flags().GetString("out")
+
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+		site, err := config.GetActiveSite(siteName)
+		if err != nil {
+			return err
+		}
+
+		snapshot := map[string]interface{}{
+			"site_url":    site.URL,
+			"captured_at": time.Now().UTC().Format(time.RFC3339),
+		}
+
+		sections := []struct {
+			key   string
+			path  string
+			query url.Values
+		}{
+			{"settings", "/settings/", nil},
+			{"tags", "/tags/", url.Values{"limit": {"all"}}},
+			{"tiers", "/tiers/", url.Values{"filter": {"active:true,active:false"}}},
+			{"newsletters", "/newsletters/", nil},
+		}
+		for _, sec := range sections {
+			body, err := api.Get(sec.path, sec.query)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "跳过 %s: %v\n", sec.key, err)
+				continue
+			}
+			var parsed map[string]interface{}
+			if err := json.Unmarshal(body, &parsed); err != nil {
+				fmt.Fprintf(os.Stderr, "跳过 %s: %v\n", sec.key, err)
+				continue
+			}
+			if section, ok := parsed[sec.key]; ok {
+				snapshot[sec.key] = section
+			} else {
+				snapshot[sec.key] = parsed
+			}
+		}
+
+		// 路由是 YAML 而非 JSON, 按原文保存
+		if routes, err := api.Get("/settings/routes/yaml/", nil); err == nil {
+			snapshot["routes_yaml"] = string(routes)
+		} else {
+			fmt.Fprintf(os.Stderr, "跳过 routes: %v\n", err)
+		}
+
+		data, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+
+		if out == "" {
+			_, err = output.Write(data)
+			return err
+		}
+		if err := os.WriteFile(out, data, 0644); err != nil {
+			return err
+		}
+		printf("已保存站点快照: %s\n", out)
+		return nil
+	},
+}
+
+func init() {
+	snapshotCmd.Flags().String("out", "", "输出文件 (缺省输出到 stdout)")
+
+	rootCmd.AddCommand(snapshotCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotCmd(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	out := filepath.Join(t.TempDir(), "site.json")
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "snapshot", "--out", out)
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "已保存站点快照")
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	var snapshot map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &snapshot))
+	assert.Contains(t, snapshot, "settings")
+	assert.Contains(t, snapshot, "tags")
+	assert.Contains(t, snapshot, "tiers")
+	assert.Contains(t, snapshot, "newsletters")
+	assert.Contains(t, snapshot, "captured_at")
+}
+
+func TestSnapshotCmdStdout(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	cmd := newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "snapshot")
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout, `"settings"`)
+}
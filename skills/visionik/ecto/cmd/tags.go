@@ -95,6 +95,9 @@ var tagCreateCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "created", "resource": "tag", "id": created.ID, "slug": created.Slug})
+		}
 		printf("已创建标签: %s (%s)\n", created.ID, created.Slug)
 		return nil
 	},
@@ -129,6 +132,9 @@ var tagEditCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "updated", "resource": "tag", "id": updated.ID})
+		}
 		printf("已更新标签: %s\n", updated.ID)
 		return nil
 	},
@@ -165,6 +171,9 @@ var tagDeleteCmd = &cobra.Command{
 			return err
 		}
 
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "deleted", "resource": "tag", "id": tag.ID})
+		}
 		printf("已删除标签: %s\n", tag.ID)
 		return nil
 	},
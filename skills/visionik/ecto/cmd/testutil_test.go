@@ -56,6 +56,11 @@ func mockGhostServer(t *testing.T) (*httptest.Server, func()) {
 					Slug:  "new-post",
 				}},
 			})
+		// Posts - uuid lookup (preview URL)
+		case isSingleEndpoint("posts") && r.Method == http.MethodGet && r.URL.Query().Get("fields") == "uuid":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"posts": []map[string]string{{"id": "post-123", "uuid": "f47ac10b-58cc-4372-a567-0e02b2c3d479"}},
+			})
 		// Posts - single GET
 		case isSingleEndpoint("posts") && r.Method == http.MethodGet:
 			json.NewEncoder(w).Encode(map[string]interface{}{
@@ -227,6 +232,57 @@ func mockGhostServer(t *testing.T) (*httptest.Server, func()) {
 				}},
 			})
 
+		// Tiers - list
+		case isListEndpoint("tiers") && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"tiers": []map[string]interface{}{
+					{"id": "tier-1", "name": "Free", "slug": "free", "type": "free", "active": true},
+					{"id": "tier-2", "name": "Gold", "slug": "gold", "type": "paid", "active": true,
+						"currency": "usd", "monthly_price": 500, "yearly_price": 5000},
+				},
+			})
+		// Tiers - create
+		case isListEndpoint("tiers") && r.Method == http.MethodPost:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"tiers": []map[string]interface{}{
+					{"id": "new-tier-123", "name": "New Tier", "slug": "new-tier", "type": "paid",
+						"active": true, "currency": "usd", "monthly_price": 700},
+				},
+			})
+		// Tiers - single GET
+		case isSingleEndpoint("tiers") && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"tiers": []map[string]interface{}{
+					{"id": "tier-123", "name": "Test Tier", "slug": "test-tier", "type": "paid",
+						"active": true, "currency": "usd", "monthly_price": 500, "yearly_price": 5000,
+						"benefits":   []string{"Benefit One"},
+						"updated_at": "2024-01-01T00:00:00.000Z"},
+				},
+			})
+		// Tiers - update
+		case isSingleEndpoint("tiers") && r.Method == http.MethodPut:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"tiers": []map[string]interface{}{
+					{"id": "tier-123", "name": "Updated Tier", "slug": "test-tier", "type": "paid", "active": true},
+				},
+			})
+
+		// Offers - list
+		case isListEndpoint("offers") && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"offers": []map[string]interface{}{
+					{"id": "offer-1", "name": "Black Friday", "code": "black-friday", "type": "percent",
+						"amount": 20, "status": "active", "redemption_count": 3},
+				},
+			})
+		// Offers - create
+		case isListEndpoint("offers") && r.Method == http.MethodPost:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"offers": []map[string]interface{}{
+					{"id": "new-offer-123", "name": "New Offer", "code": "new-offer", "type": "percent", "amount": 10},
+				},
+			})
+
 		// Webhooks - create
 		case isListEndpoint("webhooks") && r.Method == http.MethodPost:
 			json.NewEncoder(w).Encode(map[string]interface{}{
@@ -240,6 +296,11 @@ func mockGhostServer(t *testing.T) (*httptest.Server, func()) {
 		case isSingleEndpoint("webhooks") && r.Method == http.MethodDelete:
 			w.WriteHeader(http.StatusNoContent)
 
+		// Static image content (downloaded during export)
+		case strings.HasPrefix(path, "/content/images/") && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("png-bytes"))
+
 		// Images - upload
 		case apiPath == "/images/upload/" && r.Method == http.MethodPost:
 			json.NewEncoder(w).Encode(map[string]interface{}{
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/visionik/ecto/internal/config"
+	"github.com/visionik/ecto/internal/ghostapi"
+)
+
+// activeGhostAPI 为活动站点构造 ghostapi 客户端 (tiers/offers 等
+// libecto 未覆盖的端点用它)。
+func activeGhostAPI() (*ghostapi.Client, error) {
+	site, err := config.GetActiveSite(siteName)
+	if err != nil {
+		return nil, err
+	}
+	return ghostapi.NewClient(site.URL, site.APIKey)
+}
+
+var tiersCmd = &cobra.Command{
+	Use:   "tiers",
+	Short: "管理订阅等级",
+}
+
+var tiersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "列出订阅等级 (含已归档)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+
+		asJSON, _ := cmd.Flags().GetBool("json")
+
+		tiers, err := api.ListTiers()
+		if err != nil {
+			return err
+		}
+
+		if asJSON {
+			return outputJSON(map[string]interface{}{"tiers": tiers})
+		}
+
+		if len(tiers) == 0 {
+			println("未找到订阅等级")
+			return nil
+		}
+
+		for _, t := range tiers {
+			status := "已归档"
+			if t.Active {
+				status = "活动"
+			}
+			printf("%s - %s (%s) [%s/%s] %s\n",
+				t.ID, t.Name, t.Slug, t.Type, status, formatTierPrice(t))
+		}
+		return nil
+	},
+}
+
+var tiersGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "获取单个订阅等级",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+
+		asJSON, _ := cmd.Flags().GetBool("json")
+
+		tier, err := api.GetTier(args[0])
+		if err != nil {
+			return err
+		}
+
+		if asJSON {
+			return outputJSON(map[string]interface{}{"tiers": []*ghostapi.Tier{tier}})
+		}
+
+		printf("ID:          %s\n", tier.ID)
+		printf("名称:        %s\n", tier.Name)
+		printf("别名:        %s\n", tier.Slug)
+		printf("类型:        %s\n", tier.Type)
+		printf("活动:        %v\n", tier.Active)
+		if tier.Description != "" {
+			printf("描述:        %s\n", tier.Description)
+		}
+		if tier.Type == "paid" {
+			printf("价格:        %s\n", formatTierPrice(*tier))
+		}
+		if len(tier.Benefits) > 0 {
+			printf("权益:        %s\n", strings.Join(tier.Benefits, ", "))
+		}
+		return nil
+	},
+}
+
+var tiersCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "创建付费订阅等级",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+
+		name, _ := cmd.Flags().GetString("name")
+		if name == "" {
+			return fmt.Errorf("--name 是必需的")
+		}
+		description, _ := cmd.Flags().GetString("description")
+		monthly, _ := cmd.Flags().GetInt("monthly")
+		yearly, _ := cmd.Flags().GetInt("yearly")
+		currency, _ := cmd.Flags().GetString("currency")
+		welcomeURL, _ := cmd.Flags().GetString("welcome-url")
+		benefits, _ := cmd.Flags().GetStringArray("benefit")
+
+		tier := &ghostapi.Tier{
+			Name:           name,
+			Description:    description,
+			Type:           "paid",
+			Active:         true,
+			Currency:       currency,
+			MonthlyPrice:   monthly,
+			YearlyPrice:    yearly,
+			WelcomePageURL: welcomeURL,
+			Benefits:       benefits,
+		}
+
+		created, err := api.CreateTier(tier)
+		if err != nil {
+			return err
+		}
+
+		printf("已创建订阅等级: %s (%s) %s\n", created.ID, created.Name, formatTierPrice(*created))
+		return nil
+	},
+}
+
+var tiersEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "编辑订阅等级",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		api, err := activeGhostAPI()
+		if err != nil {
+			return err
+		}
+
+		// 更新需要当前版本的 updated_at 以做冲突检测
+		current, err := api.GetTier(args[0])
+		if err != nil {
+			return err
+		}
+
+		fields := map[string]interface{}{
+			"updated_at": current.UpdatedAt,
+		}
+		if cmd.Flags().Changed("name") {
+			name, _ := cmd.Flags().GetString("name")
+			fields["name"] = name
+		}
+		if cmd.Flags().Changed("description") {
+			description, _ := cmd.Flags().GetString("description")
+			fields["description"] = description
+		}
+		if cmd.Flags().Changed("monthly") {
+			monthly, _ := cmd.Flags().GetInt("monthly")
+			fields["monthly_price"] = monthly
+		}
+		if cmd.Flags().Changed("yearly") {
+			yearly, _ := cmd.Flags().GetInt("yearly")
+			fields["yearly_price"] = yearly
+		}
+		if cmd.Flags().Changed("welcome-url") {
+			welcomeURL, _ := cmd.Flags().GetString("welcome-url")
+			fields["welcome_page_url"] = welcomeURL
+		}
+		if cmd.Flags().Changed("benefit") {
+			benefits, _ := cmd.Flags().GetStringArray("benefit")
+			fields["benefits"] = benefits
+		}
+		if cmd.Flags().Changed("active") {
+			active, _ := cmd.Flags().GetBool("active")
+			fields["active"] = active
+		}
+
+		if len(fields) == 1 {
+			return fmt.Errorf("没有要更新的字段")
+		}
+
+		updated, err := api.UpdateTier(args[0], fields)
+		if err != nil {
+			return err
+		}
+
+		printf("已更新订阅等级: %s (%s)\n", updated.ID, updated.Name)
+		return nil
+	},
+}
+
+// formatTierPrice 把以分为单位的价格格式化为可读形式。
+func formatTierPrice(t ghostapi.Tier) string {
+	if t.Type != "paid" || (t.MonthlyPrice == 0 && t.YearlyPrice == 0) {
+		return ""
+	}
+	currency := strings.ToUpper(t.Currency)
+	return fmt.Sprintf("%.2f/月 %.2f/年 %s",
+		float64(t.MonthlyPrice)/100, float64(t.YearlyPrice)/100, currency)
+}
+
+func init() {
+	tiersListCmd.Flags().Bool("json", false, "以JSON格式输出")
+	tiersGetCmd.Flags().Bool("json", false, "以JSON格式输出")
+
+	tiersCreateCmd.Flags().String("name", "", "等级名称 (必需)")
+	tiersCreateCmd.Flags().String("description", "", "等级描述")
+	tiersCreateCmd.Flags().Int("monthly", 0, "月费 (最小货币单位, 如美分)")
+	tiersCreateCmd.Flags().Int("yearly", 0, "年费 (最小货币单位, 如美分)")
+	tiersCreateCmd.Flags().String("currency", "usd", "货币代码")
+	tiersCreateCmd.Flags().String("welcome-url", "", "订阅后的欢迎页面")
+	tiersCreateCmd.Flags().StringArray("benefit", nil, "权益条目 (可重复)")
+
+	tiersEditCmd.Flags().String("name", "", "等级名称")
+	tiersEditCmd.Flags().String("description", "", "等级描述")
+	tiersEditCmd.Flags().Int("monthly", 0, "月费 (最小货币单位)")
+	tiersEditCmd.Flags().Int("yearly", 0, "年费 (最小货币单位)")
+	tiersEditCmd.Flags().String("welcome-url", "", "订阅后的欢迎页面")
+	tiersEditCmd.Flags().StringArray("benefit", nil, "权益条目 (可重复, 整组替换)")
+	tiersEditCmd.Flags().Bool("active", true, "激活或归档等级")
+
+	tiersCmd.AddCommand(tiersListCmd)
+	tiersCmd.AddCommand(tiersGetCmd)
+	tiersCmd.AddCommand(tiersCreateCmd)
+	tiersCmd.AddCommand(tiersEditCmd)
+
+	rootCmd.AddCommand(tiersCmd)
+}
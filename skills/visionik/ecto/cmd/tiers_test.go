@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTiersCmd(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantOut []string
+		wantErr bool
+	}{
+		{
+			name:    "list tiers",
+			args:    []string{"tiers", "list"},
+			wantOut: []string{"tier-1", "Free", "tier-2", "Gold", "5.00/月", "50.00/年"},
+		},
+		{
+			name:    "list tiers with json",
+			args:    []string{"tiers", "list", "--json"},
+			wantOut: []string{`"tiers"`, `"monthly_price"`},
+		},
+		{
+			name:    "get tier",
+			args:    []string{"tiers", "get", "tier-123"},
+			wantOut: []string{"tier-123", "Test Tier", "Benefit One"},
+		},
+		{
+			name:    "create tier",
+			args:    []string{"tiers", "create", "--name", "New Tier", "--monthly", "700"},
+			wantOut: []string{"已创建订阅等级", "new-tier-123"},
+		},
+		{
+			name:    "create tier requires name",
+			args:    []string{"tiers", "create"},
+			wantErr: true,
+		},
+		{
+			name:    "edit tier",
+			args:    []string{"tiers", "edit", "tier-123", "--monthly", "900"},
+			wantOut: []string{"已更新订阅等级", "Updated Tier"},
+		},
+		{
+			name:    "edit tier requires fields",
+			args:    []string{"tiers", "edit", "tier-123"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newTestRootCmd()
+			stdout, _, err := executeCommand(cmd, tt.args...)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, want := range tt.wantOut {
+				assert.Contains(t, stdout, want)
+			}
+		})
+	}
+}
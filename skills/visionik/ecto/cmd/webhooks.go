@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/visionik/ecto/internal/config"
@@ -11,7 +13,45 @@ import (
 
 var webhookCmd = &cobra.Command{
 	Use:   "webhook",
-	Short: "Manage webhooks (create/delete only - Ghost API doesn't support listing)",
+	Short: "Manage webhooks",
+}
+
+var webhookListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List webhooks created through ecto",
+	Long: `The Ghost Admin API cannot list webhooks, so ecto keeps a local
+record of the ones it created. Webhooks created elsewhere won't appear.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		site, err := config.GetActiveSite(siteName)
+		if err != nil {
+			return err
+		}
+
+		asJSON, _ := cmd.Flags().GetBool("json")
+
+		records, err := config.ListWebhooks(site.URL)
+		if err != nil {
+			return err
+		}
+
+		if asJSON {
+			return outputJSON(map[string]interface{}{"webhooks": records})
+		}
+
+		if len(records) == 0 {
+			println("No webhooks recorded (only webhooks created through ecto are tracked)")
+			return nil
+		}
+
+		for _, rec := range records {
+			name := rec.Name
+			if name == "" {
+				name = "-"
+			}
+			printf("%s  %s -> %s  (%s)\n", rec.ID, rec.Event, rec.TargetURL, name)
+		}
+		return nil
+	},
 }
 
 var webhookCreateCmd = &cobra.Command{
@@ -42,6 +82,23 @@ var webhookCreateCmd = &cobra.Command{
 			return err
 		}
 
+		// Record locally so "webhook list" can enumerate it later
+		if site, err := config.GetActiveSite(siteName); err == nil {
+			rec := config.WebhookRecord{
+				ID:        created.ID,
+				Event:     created.Event,
+				TargetURL: created.TargetURL,
+				Name:      name,
+				CreatedAt: time.Now().UTC().Format(time.RFC3339),
+			}
+			if err := config.RecordWebhook(site.URL, rec); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: could not record webhook locally: %v\n", err)
+			}
+		}
+
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "created", "resource": "webhook", "id": created.ID, "event": created.Event, "target_url": created.TargetURL})
+		}
 		printf("Created webhook: %s (%s -> %s)\n", created.ID, created.Event, created.TargetURL)
 		return nil
 	},
@@ -73,6 +130,15 @@ var webhookDeleteCmd = &cobra.Command{
 			return err
 		}
 
+		if site, err := config.GetActiveSite(siteName); err == nil {
+			if err := config.ForgetWebhook(site.URL, args[0]); err != nil {
+				fmt.Fprintf(os.Stderr, "warning: could not remove local webhook record: %v\n", err)
+			}
+		}
+
+		if wantJSON(cmd) {
+			return outputJSON(map[string]interface{}{"action": "deleted", "resource": "webhook", "id": args[0]})
+		}
 		printf("Deleted webhook: %s\n", args[0])
 		return nil
 	},
@@ -85,6 +151,9 @@ func init() {
 
 	webhookDeleteCmd.Flags().Bool("force", false, "Delete without confirmation")
 
+	webhookListCmd.Flags().Bool("json", false, "Output as JSON")
+
+	webhookCmd.AddCommand(webhookListCmd)
 	webhookCmd.AddCommand(webhookCreateCmd)
 	webhookCmd.AddCommand(webhookDeleteCmd)
 
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookListTracksCreated(t *testing.T) {
+	_, cleanup := mockGhostServer(t)
+	defer cleanup()
+
+	origXDG := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	defer os.Setenv("XDG_CONFIG_HOME", origXDG)
+
+	cmd := newTestRootCmd()
+	_, _, err := executeCommand(cmd, "webhook", "create",
+		"--event", "post.published", "--target-url", "https://example.com/hook")
+	require.NoError(t, err)
+
+	cmd = newTestRootCmd()
+	stdout, _, err := executeCommand(cmd, "webhook", "list")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "webhook-123")
+	assert.Contains(t, stdout, "post.published -> https://example.com/hook")
+
+	cmd = newTestRootCmd()
+	_, _, err = executeCommand(cmd, "webhook", "delete", "webhook-123", "--force")
+	require.NoError(t, err)
+
+	cmd = newTestRootCmd()
+	stdout, _, err = executeCommand(cmd, "webhook", "list")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "No webhooks recorded")
+}
@@ -19,6 +19,19 @@ type Site struct {
 	URL string `json:"url"`
 	// APIKey is the Admin API key in "id:secret" format.
 	APIKey string `json:"api_key"`
+	// Hooks maps pipeline events (pre-create, post-publish, ...) to shell
+	// commands run with the post JSON on stdin.
+	Hooks map[string][]string `json:"hooks,omitempty"`
+}
+
+// WebhookRecord remembers a webhook created through ecto. The Admin API
+// cannot list webhooks, so this local record is the only inventory.
+type WebhookRecord struct {
+	ID        string `json:"id"`
+	Event     string `json:"event"`
+	TargetURL string `json:"target_url"`
+	Name      string `json:"name,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
 }
 
 // Config holds all configured sites and the default site name.
@@ -27,6 +40,8 @@ type Config struct {
 	DefaultSite string `json:"default_site"`
 	// Sites maps site names to their configurations.
 	Sites map[string]Site `json:"sites"`
+	// Webhooks tracks webhooks created through ecto, keyed by site URL.
+	Webhooks map[string][]WebhookRecord `json:"webhooks,omitempty"`
 }
 
 // ConfigPath returns the path to the config file.
@@ -148,6 +163,49 @@ func (c *Config) RemoveSiteNoSave(name string) {
 	}
 }
 
+// RecordWebhook appends a webhook record for a site URL and saves.
+func RecordWebhook(siteURL string, rec WebhookRecord) error {
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+	if cfg.Webhooks == nil {
+		cfg.Webhooks = make(map[string][]WebhookRecord)
+	}
+	cfg.Webhooks[siteURL] = append(cfg.Webhooks[siteURL], rec)
+	return cfg.Save()
+}
+
+// ForgetWebhook removes a webhook record by ID and saves. Unknown IDs
+// are a no-op so deleting webhooks created outside ecto still works.
+func ForgetWebhook(siteURL, id string) error {
+	cfg, err := Load()
+	if err != nil {
+		return err
+	}
+	records := cfg.Webhooks[siteURL]
+	kept := records[:0]
+	for _, rec := range records {
+		if rec.ID != id {
+			kept = append(kept, rec)
+		}
+	}
+	if len(kept) == len(records) {
+		return nil
+	}
+	cfg.Webhooks[siteURL] = kept
+	return cfg.Save()
+}
+
+// ListWebhooks returns the webhook records tracked for a site URL.
+func ListWebhooks(siteURL string) ([]WebhookRecord, error) {
+	cfg, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Webhooks[siteURL], nil
+}
+
 // SetDefault sets the default site.
 // It returns an error if the site doesn't exist.
 func (c *Config) SetDefault(name string) error {
@@ -211,10 +269,44 @@ func GetActiveClient(siteName string) (*libecto.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := resolveAPIKey(site); err != nil {
+		return nil, err
+	}
 
 	return libecto.NewClient(site.URL, site.APIKey), nil
 }
 
+// GetActiveSite resolves the active site's URL and API key the same way
+// GetActiveClient does: environment variables first, then config.
+// This is for code that needs raw credentials (e.g. the ghostapi client).
+func GetActiveSite(siteName string) (*Site, error) {
+	envURL := os.Getenv("GHOST_URL")
+	envKey := os.Getenv("GHOST_ADMIN_KEY")
+	envSite := os.Getenv("GHOST_SITE")
+
+	if envURL != "" && envKey != "" {
+		return &Site{URL: envURL, APIKey: envKey}, nil
+	}
+
+	if siteName == "" && envSite != "" {
+		siteName = envSite
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	site, err := cfg.GetSite(siteName)
+	if err != nil {
+		return nil, err
+	}
+	if err := resolveAPIKey(site); err != nil {
+		return nil, err
+	}
+	return site, nil
+}
+
 // GetActiveClientWithConfig returns a client using a pre-loaded config.
 // This is useful for testing to avoid loading from the filesystem.
 func GetActiveClientWithConfig(cfg *Config, siteName string) (*libecto.Client, error) {
@@ -236,6 +328,9 @@ func GetActiveClientWithConfig(cfg *Config, siteName string) (*libecto.Client, e
 	if err != nil {
 		return nil, err
 	}
+	if err := resolveAPIKey(site); err != nil {
+		return nil, err
+	}
 
 	return libecto.NewClient(site.URL, site.APIKey), nil
 }
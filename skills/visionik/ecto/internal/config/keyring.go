@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// KeyringPlaceholder is stored as a site's api_key in config.json when
+// the real key lives in the OS keychain.
+const KeyringPlaceholder = "keyring"
+
+// keyringService is the service name entries are filed under.
+const keyringService = "ecto"
+
+// execCommand builds keychain commands; tests may replace it.
+var execCommand = exec.Command
+
+// KeyringSet stores a site's Admin API key in the OS keychain.
+// It shells out to the platform tool (security on macOS, secret-tool on
+// Linux) so no extra dependencies are needed.
+func KeyringSet(site, key string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		cmd := execCommand("security", "add-generic-password", "-U",
+			"-s", keyringService, "-a", site, "-w", key)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("security add-generic-password: %v: %s", err, out)
+		}
+		return nil
+	case "linux":
+		cmd := execCommand("secret-tool", "store",
+			"--label", keyringService+" "+site,
+			"service", keyringService, "site", site)
+		cmd.Stdin = strings.NewReader(key)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("secret-tool store: %v: %s", err, out)
+		}
+		return nil
+	}
+	return fmt.Errorf("keyring storage is not supported on %s", runtime.GOOS)
+}
+
+// KeyringGet reads a site's Admin API key from the OS keychain.
+func KeyringGet(site string) (string, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = execCommand("security", "find-generic-password",
+			"-s", keyringService, "-a", site, "-w")
+	case "linux":
+		cmd = execCommand("secret-tool", "lookup",
+			"service", keyringService, "site", site)
+	default:
+		return "", fmt.Errorf("keyring storage is not supported on %s", runtime.GOOS)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("no keyring entry for site %q: %w", site, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// resolveAPIKey swaps the keyring placeholder for the real key.
+func resolveAPIKey(site *Site) error {
+	if site.APIKey != KeyringPlaceholder {
+		return nil
+	}
+	key, err := KeyringGet(site.Name)
+	if err != nil {
+		return err
+	}
+	site.APIKey = key
+	return nil
+}
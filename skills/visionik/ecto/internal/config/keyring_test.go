@@ -0,0 +1,44 @@
+package config
+
+import (
+	"os/exec"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyringGet_UsesPlatformTool(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" {
+		t.Skip("keyring not supported on this platform")
+	}
+
+	orig := execCommand
+	defer func() { execCommand = orig }()
+	execCommand = func(name string, args ...string) *exec.Cmd {
+		// Echo a fake secret instead of touching the real keychain
+		return exec.Command("echo", "testid:0123456789abcdef")
+	}
+
+	key, err := KeyringGet("mysite")
+	require.NoError(t, err)
+	assert.Equal(t, "testid:0123456789abcdef", key)
+}
+
+func TestResolveAPIKey(t *testing.T) {
+	orig := execCommand
+	defer func() { execCommand = orig }()
+	execCommand = func(name string, args ...string) *exec.Cmd {
+		return exec.Command("echo", "real:key")
+	}
+
+	site := &Site{Name: "mysite", APIKey: KeyringPlaceholder}
+	require.NoError(t, resolveAPIKey(site))
+	assert.Equal(t, "real:key", site.APIKey)
+
+	// Non-placeholder keys pass through untouched
+	site = &Site{Name: "mysite", APIKey: "plain:key"}
+	require.NoError(t, resolveAPIKey(site))
+	assert.Equal(t, "plain:key", site.APIKey)
+}
@@ -0,0 +1,65 @@
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Offer mirrors the Admin API offer resource. Amount is a percentage
+// for type "percent" and cents for type "fixed".
+type Offer struct {
+	ID               string `json:"id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Code             string `json:"code,omitempty"`
+	DisplayTitle     string `json:"display_title,omitempty"`
+	DisplayDesc      string `json:"display_description,omitempty"`
+	Type             string `json:"type,omitempty"` // "percent", "fixed" or "trial"
+	Amount           int    `json:"amount"`
+	Duration         string `json:"duration,omitempty"` // "once", "forever", "repeating" or "trial"
+	DurationInMonths int    `json:"duration_in_months,omitempty"`
+	Currency         string `json:"currency,omitempty"`
+	Status           string `json:"status,omitempty"`
+	RedemptionCount  int    `json:"redemption_count,omitempty"`
+	Cadence          string `json:"cadence,omitempty"` // "month" or "year"
+	Tier             *struct {
+		ID   string `json:"id"`
+		Name string `json:"name,omitempty"`
+	} `json:"tier,omitempty"`
+}
+
+type offersEnvelope struct {
+	Offers []Offer `json:"offers"`
+}
+
+// ListOffers returns all offers.
+func (c *Client) ListOffers() ([]Offer, error) {
+	body, err := c.Get("/offers/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp offersEnvelope
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Offers, nil
+}
+
+// CreateOffer creates a promotional offer against a tier.
+func (c *Client) CreateOffer(offer *Offer) (*Offer, error) {
+	body, err := c.Do("POST", "/offers/", nil, map[string]interface{}{
+		"offers": []*Offer{offer},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp offersEnvelope
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Offers) == 0 {
+		return nil, fmt.Errorf("empty offer response")
+	}
+	return &resp.Offers[0], nil
+}
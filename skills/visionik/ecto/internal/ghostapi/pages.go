@@ -0,0 +1,80 @@
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// maxConcurrentPageFetches bounds how many list pages are fetched in parallel.
+const maxConcurrentPageFetches = 4
+
+// FetchAllPages fetches every page of a list endpoint and returns the raw
+// page bodies in page order. The first page is fetched synchronously to
+// learn the total page count from meta.pagination; remaining pages are
+// fetched with bounded concurrency over the client's keep-alive connections.
+func (c *Client) FetchAllPages(path string, query url.Values, pageSize int) ([][]byte, error) {
+	if pageSize <= 0 {
+		pageSize = 15
+	}
+
+	pageQuery := func(page int) url.Values {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = v
+		}
+		q.Set("limit", fmt.Sprintf("%d", pageSize))
+		q.Set("page", fmt.Sprintf("%d", page))
+		return q
+	}
+
+	first, err := c.Get(path, pageQuery(1))
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := parseTotalPages(first)
+	if totalPages <= 1 {
+		return [][]byte{first}, nil
+	}
+
+	pages := make([][]byte, totalPages)
+	pages[0] = first
+	errs := make([]error, totalPages)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentPageFetches)
+	for page := 2; page <= totalPages; page++ {
+		wg.Add(1)
+		go func(page int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			pages[page-1], errs[page-1] = c.Get(path, pageQuery(page))
+		}(page)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pages, nil
+}
+
+// parseTotalPages extracts meta.pagination.pages from a list response body.
+// Returns 1 when the meta envelope is missing (single-page responses).
+func parseTotalPages(body []byte) int {
+	var envelope struct {
+		Meta *Meta `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.Meta == nil {
+		return 1
+	}
+	if envelope.Meta.Pagination.Pages < 1 {
+		return 1
+	}
+	return envelope.Meta.Pagination.Pages
+}
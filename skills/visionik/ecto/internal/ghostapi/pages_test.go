@@ -0,0 +1,72 @@
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseTotalPages(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"no meta", `{"posts":[]}`, 1},
+		{"single page", `{"posts":[],"meta":{"pagination":{"pages":1}}}`, 1},
+		{"multi page", `{"posts":[],"meta":{"pagination":{"pages":7}}}`, 7},
+		{"invalid json", `not-json`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseTotalPages([]byte(tt.body)); got != tt.want {
+				t.Errorf("parseTotalPages() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchAllPages(t *testing.T) {
+	const totalPages = 3
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"posts": []map[string]string{{"id": "post-page-" + page}},
+			"meta":  map[string]interface{}{"pagination": map[string]int{"pages": totalPages}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "id:0123456789abcdef")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	pages, err := client.FetchAllPages("/posts/", nil, 15)
+	if err != nil {
+		t.Fatalf("FetchAllPages: %v", err)
+	}
+	if len(pages) != totalPages {
+		t.Fatalf("got %d pages, want %d", len(pages), totalPages)
+	}
+
+	// Pages must come back in page order.
+	for i, body := range pages {
+		var resp struct {
+			Posts []struct {
+				ID string `json:"id"`
+			} `json:"posts"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			t.Fatalf("page %d: %v", i+1, err)
+		}
+		want := fmt.Sprintf("post-page-%d", i+1)
+		if len(resp.Posts) != 1 || resp.Posts[0].ID != want {
+			t.Errorf("page %d: got %+v, want id %s", i+1, resp.Posts, want)
+		}
+	}
+}
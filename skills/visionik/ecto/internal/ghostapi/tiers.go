@@ -0,0 +1,111 @@
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Tier mirrors the Admin API tier resource. Prices are in the smallest
+// currency unit (cents).
+type Tier struct {
+	ID             string   `json:"id,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	Slug           string   `json:"slug,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Type           string   `json:"type,omitempty"` // "free" or "paid"
+	Active         bool     `json:"active"`
+	Visibility     string   `json:"visibility,omitempty"`
+	Currency       string   `json:"currency,omitempty"`
+	MonthlyPrice   int      `json:"monthly_price,omitempty"`
+	YearlyPrice    int      `json:"yearly_price,omitempty"`
+	WelcomePageURL string   `json:"welcome_page_url,omitempty"`
+	Benefits       []string `json:"benefits,omitempty"`
+	UpdatedAt      string   `json:"updated_at,omitempty"`
+}
+
+// tiersQuery asks for the price and benefit fields, which the API omits
+// by default.
+func tiersQuery() url.Values {
+	q := url.Values{}
+	q.Set("include", "monthly_price,yearly_price,benefits")
+	return q
+}
+
+type tiersEnvelope struct {
+	Tiers []Tier `json:"tiers"`
+}
+
+// ListTiers returns all tiers, including archived ones.
+func (c *Client) ListTiers() ([]Tier, error) {
+	q := tiersQuery()
+	q.Set("filter", "active:true,active:false")
+
+	body, err := c.Get("/tiers/", q)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp tiersEnvelope
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tiers, nil
+}
+
+// GetTier fetches a single tier by ID.
+func (c *Client) GetTier(id string) (*Tier, error) {
+	body, err := c.Get("/tiers/"+id+"/", tiersQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	var resp tiersEnvelope
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Tiers) == 0 {
+		return nil, fmt.Errorf("tier not found: %s", id)
+	}
+	return &resp.Tiers[0], nil
+}
+
+// CreateTier creates a paid tier.
+func (c *Client) CreateTier(tier *Tier) (*Tier, error) {
+	body, err := c.Do("POST", "/tiers/", tiersQuery(), map[string]interface{}{
+		"tiers": []*Tier{tier},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp tiersEnvelope
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Tiers) == 0 {
+		return nil, fmt.Errorf("empty tier response")
+	}
+	return &resp.Tiers[0], nil
+}
+
+// UpdateTier applies a partial update. The API requires updated_at from
+// the current revision for collision detection, so fetch the tier first
+// and pass its UpdatedAt through fields.
+func (c *Client) UpdateTier(id string, fields map[string]interface{}) (*Tier, error) {
+	body, err := c.Do("PUT", "/tiers/"+id+"/", tiersQuery(), map[string]interface{}{
+		"tiers": []map[string]interface{}{fields},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp tiersEnvelope
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Tiers) == 0 {
+		return nil, fmt.Errorf("empty tier response")
+	}
+	return &resp.Tiers[0], nil
+}
@@ -0,0 +1,77 @@
+package ghostapi
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// verbose controls per-request logging; counters accumulate regardless
+// so the summary line is available when requested.
+var (
+	verbose  int32
+	reqCount int64
+	reqNS    int64
+	reqErrs  int64
+)
+
+// SetVerbose enables per-request logging to stderr (method, path,
+// status, latency). Credentials never appear: the Authorization header
+// is not logged and URLs are printed without userinfo.
+func SetVerbose(on bool) {
+	if on {
+		atomic.StoreInt32(&verbose, 1)
+	} else {
+		atomic.StoreInt32(&verbose, 0)
+	}
+}
+
+// RequestSummary returns a one-line summary of all Admin API requests
+// made during this invocation.
+func RequestSummary() string {
+	return fmt.Sprintf("ghost: %d requests, %d errors, total %s",
+		atomic.LoadInt64(&reqCount),
+		atomic.LoadInt64(&reqErrs),
+		time.Duration(atomic.LoadInt64(&reqNS)).Round(time.Millisecond))
+}
+
+// traceTransport times every request and optionally logs it.
+type traceTransport struct {
+	base http.RoundTripper
+}
+
+// newTraceTransport returns the instrumented transport used by ghostapi
+// clients.
+func newTraceTransport() http.RoundTripper {
+	return &traceTransport{base: http.DefaultTransport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	atomic.AddInt64(&reqCount, 1)
+	atomic.AddInt64(&reqNS, int64(elapsed))
+
+	if err != nil {
+		atomic.AddInt64(&reqErrs, 1)
+		if atomic.LoadInt32(&verbose) == 1 {
+			fmt.Fprintf(os.Stderr, "ghost: %s %s -> error after %s: %v\n",
+				req.Method, req.URL.Redacted(), elapsed.Round(time.Millisecond), err)
+		}
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		atomic.AddInt64(&reqErrs, 1)
+	}
+	if atomic.LoadInt32(&verbose) == 1 {
+		fmt.Fprintf(os.Stderr, "ghost: %s %s -> %s in %s\n",
+			req.Method, req.URL.Redacted(), resp.Status, elapsed.Round(time.Millisecond))
+	}
+	return resp, nil
+}
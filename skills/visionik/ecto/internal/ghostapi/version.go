@@ -0,0 +1,119 @@
+package ghostapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Site mirrors the fields of the /site/ endpoint that matter for
+// version-dependent behaviour.
+type Site struct {
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Version string `json:"version"`
+}
+
+// Site fetches the /site/ endpoint and caches the result for the lifetime
+// of the client, so repeated version checks cost one request.
+func (c *Client) Site() (*Site, error) {
+	c.siteMu.Lock()
+	defer c.siteMu.Unlock()
+	if c.site != nil {
+		return c.site, nil
+	}
+
+	body, err := c.Get("/site/", nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetch site info: %w", err)
+	}
+
+	var envelope struct {
+		Site Site `json:"site"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("parse site info: %w", err)
+	}
+
+	c.site = &envelope.Site
+	return c.site, nil
+}
+
+// MajorVersion extracts the major version number from a Ghost version
+// string like "5.82.0" or "6.0". Returns 0 when the version is unknown.
+func MajorVersion(version string) int {
+	version = strings.TrimPrefix(version, "v")
+	head, _, _ := strings.Cut(version, ".")
+	major, err := strconv.Atoi(head)
+	if err != nil || major < 0 {
+		return 0
+	}
+	return major
+}
+
+// AdaptPostPayload adjusts a post (or page) payload for the given major
+// Ghost version, so payloads written against one version do not fail
+// cryptically on another:
+//
+//   - Ghost 6.x dropped mobiledoc. A payload carrying both formats keeps
+//     only lexical; a mobiledoc-only payload is rejected with a clear
+//     error since the formats cannot be converted client-side.
+//   - Ghost 4.x and earlier predate lexical and tier visibility; lexical
+//     content is rejected and tier fields are stripped.
+//
+// An unknown major version (0) leaves the payload untouched.
+func AdaptPostPayload(major int, post map[string]interface{}) error {
+	if major == 0 {
+		return nil
+	}
+
+	hasMobiledoc := hasPayloadField(post, "mobiledoc")
+	hasLexical := hasPayloadField(post, "lexical")
+
+	if major >= 6 {
+		if hasMobiledoc && !hasLexical {
+			return fmt.Errorf("this site runs Ghost %d.x, which no longer supports mobiledoc content; supply lexical instead", major)
+		}
+		if hasMobiledoc {
+			delete(post, "mobiledoc")
+		}
+		return nil
+	}
+
+	if major < 5 {
+		if hasLexical && !hasMobiledoc {
+			return fmt.Errorf("this site runs Ghost %d.x, which predates lexical content; supply mobiledoc instead", major)
+		}
+		if hasLexical {
+			delete(post, "lexical")
+		}
+		delete(post, "tiers")
+		if vis, ok := post["visibility"].(string); ok && vis == "tiers" {
+			return fmt.Errorf("tier-based visibility requires Ghost 5.x or later (site runs %d.x)", major)
+		}
+	}
+	return nil
+}
+
+// AdaptPost detects the site's Ghost version and adapts the payload for
+// it via AdaptPostPayload.
+func (c *Client) AdaptPost(post map[string]interface{}) error {
+	site, err := c.Site()
+	if err != nil {
+		return err
+	}
+	return AdaptPostPayload(MajorVersion(site.Version), post)
+}
+
+// hasPayloadField reports whether a payload field is present and non-empty.
+func hasPayloadField(post map[string]interface{}, key string) bool {
+	v, ok := post[key]
+	if !ok || v == nil {
+		return false
+	}
+	if s, ok := v.(string); ok {
+		return s != ""
+	}
+	return true
+}
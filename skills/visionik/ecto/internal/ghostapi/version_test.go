@@ -0,0 +1,126 @@
+package ghostapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMajorVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    int
+	}{
+		{"5.82.0", 5},
+		{"6.0", 6},
+		{"v5.1.0", 5},
+		{"4.48.9", 4},
+		{"", 0},
+		{"next", 0},
+	}
+
+	for _, tt := range tests {
+		if got := MajorVersion(tt.version); got != tt.want {
+			t.Errorf("MajorVersion(%q) = %d, want %d", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestAdaptPostPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		major   int
+		post    map[string]interface{}
+		wantErr bool
+		absent  []string
+	}{
+		{
+			name:  "6.x drops mobiledoc when lexical present",
+			major: 6,
+			post: map[string]interface{}{
+				"mobiledoc": `{"version":"0.3.1"}`,
+				"lexical":   `{"root":{}}`,
+			},
+			absent: []string{"mobiledoc"},
+		},
+		{
+			name:    "6.x rejects mobiledoc-only payload",
+			major:   6,
+			post:    map[string]interface{}{"mobiledoc": `{"version":"0.3.1"}`},
+			wantErr: true,
+		},
+		{
+			name:  "5.x passes through unchanged",
+			major: 5,
+			post: map[string]interface{}{
+				"mobiledoc": `{"version":"0.3.1"}`,
+				"tiers":     []interface{}{},
+			},
+		},
+		{
+			name:    "4.x rejects lexical-only payload",
+			major:   4,
+			post:    map[string]interface{}{"lexical": `{"root":{}}`},
+			wantErr: true,
+		},
+		{
+			name:   "4.x strips tiers",
+			major:  4,
+			post:   map[string]interface{}{"title": "T", "tiers": []interface{}{}},
+			absent: []string{"tiers"},
+		},
+		{
+			name:    "4.x rejects tier visibility",
+			major:   4,
+			post:    map[string]interface{}{"visibility": "tiers"},
+			wantErr: true,
+		},
+		{
+			name:  "unknown version leaves payload alone",
+			major: 0,
+			post:  map[string]interface{}{"mobiledoc": `{"version":"0.3.1"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AdaptPostPayload(tt.major, tt.post)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AdaptPostPayload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, key := range tt.absent {
+				if _, ok := tt.post[key]; ok {
+					t.Errorf("field %q should have been removed", key)
+				}
+			}
+		})
+	}
+}
+
+func TestSiteCaching(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"site":{"title":"Test Blog","version":"6.2.0"}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "id:0123456789abcdef")
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		site, err := client.Site()
+		if err != nil {
+			t.Fatalf("Site: %v", err)
+		}
+		if site.Version != "6.2.0" {
+			t.Errorf("Version = %q, want %q", site.Version, "6.2.0")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("site endpoint hit %d times, want 1 (cached)", calls)
+	}
+}
@@ -0,0 +1,80 @@
+// Package htmlmd converts Ghost post HTML back into Markdown for export.
+// The converter is regex-based and targets the HTML Ghost itself renders,
+// not arbitrary documents; unrecognised markup is stripped to plain text.
+package htmlmd
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingRe    = regexp.MustCompile(`(?is)<h([1-6])[^>]*>(.*?)</h[1-6]>`)
+	boldRe       = regexp.MustCompile(`(?is)<(?:b|strong)[^>]*>(.*?)</(?:b|strong)>`)
+	italicRe     = regexp.MustCompile(`(?is)<(?:i|em)[^>]*>(.*?)</(?:i|em)>`)
+	preRe        = regexp.MustCompile(`(?is)<pre[^>]*><code([^>]*)>(.*?)</code></pre>`)
+	langAttrRe   = regexp.MustCompile(`class="language-([^"]*)"`)
+	codeRe       = regexp.MustCompile(`(?is)<code[^>]*>(.*?)</code>`)
+	linkRe       = regexp.MustCompile(`(?is)<a[^>]*href="([^"]*)"[^>]*>(.*?)</a>`)
+	imgRe        = regexp.MustCompile(`(?is)<img[^>]*>`)
+	srcAttrRe    = regexp.MustCompile(`src="([^"]*)"`)
+	altAttrRe    = regexp.MustCompile(`alt="([^"]*)"`)
+	listItemRe   = regexp.MustCompile(`(?is)<li[^>]*>(.*?)</li>`)
+	blockquoteRe = regexp.MustCompile(`(?is)<blockquote[^>]*>(.*?)</blockquote>`)
+	paraCloseRe  = regexp.MustCompile(`(?i)</(?:p|div|ul|ol|figure)>`)
+	brRe         = regexp.MustCompile(`(?i)<br\s*/?>`)
+	hrRe         = regexp.MustCompile(`(?i)<hr\s*/?>`)
+	tagRe        = regexp.MustCompile(`(?s)<[^>]+>`)
+	blankRe      = regexp.MustCompile(`\n{3,}`)
+)
+
+// FromHTML converts rendered post HTML into Markdown.
+func FromHTML(htmlSrc string) string {
+	s := htmlSrc
+
+	s = preRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := preRe.FindStringSubmatch(m)
+		lang := ""
+		if attr := langAttrRe.FindStringSubmatch(sub[1]); attr != nil {
+			lang = attr[1]
+		}
+		code := strings.TrimRight(html.UnescapeString(sub[2]), "\n")
+		return "\n```" + lang + "\n" + code + "\n```\n"
+	})
+
+	s = headingRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := headingRe.FindStringSubmatch(m)
+		return "\n" + strings.Repeat("#", int(sub[1][0]-'0')) + " " + sub[2] + "\n"
+	})
+
+	s = imgRe.ReplaceAllStringFunc(s, func(m string) string {
+		src := srcAttrRe.FindStringSubmatch(m)
+		if src == nil {
+			return ""
+		}
+		alt := ""
+		if sub := altAttrRe.FindStringSubmatch(m); sub != nil {
+			alt = sub[1]
+		}
+		return "\n![" + alt + "](" + src[1] + ")\n"
+	})
+	s = linkRe.ReplaceAllString(s, "[$2]($1)")
+	s = boldRe.ReplaceAllString(s, "**$1**")
+	s = italicRe.ReplaceAllString(s, "*$1*")
+	s = codeRe.ReplaceAllString(s, "`$1`")
+	s = listItemRe.ReplaceAllString(s, "- $1\n")
+	s = blockquoteRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := blockquoteRe.FindStringSubmatch(m)
+		inner := strings.TrimSpace(tagRe.ReplaceAllString(sub[1], ""))
+		return "\n> " + inner + "\n"
+	})
+	s = brRe.ReplaceAllString(s, "\n")
+	s = hrRe.ReplaceAllString(s, "\n---\n")
+	s = paraCloseRe.ReplaceAllString(s, "\n\n")
+	s = tagRe.ReplaceAllString(s, "")
+
+	s = html.UnescapeString(s)
+	s = blankRe.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s) + "\n"
+}
@@ -0,0 +1,30 @@
+package htmlmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHTML(t *testing.T) {
+	md := FromHTML(`<h2>Title</h2><p>Some <strong>bold</strong> and <em>italic</em> and <code>code</code>.</p>` +
+		`<p><a href="https://example.com">link</a></p>` +
+		`<ul><li>one</li><li>two</li></ul>` +
+		`<figure><img src="https://example.com/a.png" alt="pic"/></figure>` +
+		`<blockquote><p>quoted</p></blockquote>` +
+		`<pre><code class="language-go">fmt.Println(&quot;hi&quot;)</code></pre>`)
+
+	assert.Contains(t, md, "## Title")
+	assert.Contains(t, md, "**bold**")
+	assert.Contains(t, md, "*italic*")
+	assert.Contains(t, md, "`code`")
+	assert.Contains(t, md, "[link](https://example.com)")
+	assert.Contains(t, md, "- one\n- two")
+	assert.Contains(t, md, "![pic](https://example.com/a.png)")
+	assert.Contains(t, md, "> quoted")
+	assert.Contains(t, md, "```go\nfmt.Println(\"hi\")\n```")
+}
+
+func TestFromHTML_Entities(t *testing.T) {
+	assert.Equal(t, "a & b\n", FromHTML("<p>a &amp; b</p>"))
+}
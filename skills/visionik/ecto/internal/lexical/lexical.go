@@ -0,0 +1,261 @@
+// Package lexical converts a Markdown subset into Ghost's Lexical
+// document format. Writing Lexical natively avoids the lossy HTML
+// round-trip Ghost performs on html-sourced posts: headings, lists,
+// code blocks, images and embeds become first-class editor nodes
+// instead of HTML cards.
+package lexical
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// node is a Lexical document node. The format is schemaless JSON, so a
+// plain map keeps us honest about what Ghost actually accepts.
+type node = map[string]interface{}
+
+// Text format bitmask values used by Lexical.
+const (
+	formatBold   = 1
+	formatItalic = 2
+	formatCode   = 16
+)
+
+// FromMarkdown renders a Markdown document as a Lexical JSON string
+// suitable for the "lexical" field of a post or page.
+func FromMarkdown(md string) (string, error) {
+	children := parseBlocks(strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n"))
+
+	doc := node{
+		"root": node{
+			"children":  children,
+			"direction": nil,
+			"format":    "",
+			"indent":    0,
+			"type":      "root",
+			"version":   1,
+		},
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("marshal lexical document: %w", err)
+	}
+	return string(data), nil
+}
+
+var (
+	headingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	bulletRe   = regexp.MustCompile(`^[-*]\s+(.*)$`)
+	numberedRe = regexp.MustCompile(`^\d+\.\s+(.*)$`)
+	imageRe    = regexp.MustCompile(`^!\[([^\]]*)\]\(([^)]+)\)$`)
+	embedRe    = regexp.MustCompile(`^https?://\S+$`)
+	fenceRe    = regexp.MustCompile("^```(\\w*)\\s*$")
+)
+
+// parseBlocks walks lines and emits one Lexical node per block.
+func parseBlocks(lines []string) []node {
+	children := []node{}
+	var paragraph []string
+
+	flushParagraph := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		children = append(children, paragraphNode(strings.Join(paragraph, " ")))
+		paragraph = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			flushParagraph()
+
+		case fenceRe.MatchString(trimmed):
+			flushParagraph()
+			language := fenceRe.FindStringSubmatch(trimmed)[1]
+			var code []string
+			for i++; i < len(lines) && !fenceRe.MatchString(strings.TrimSpace(lines[i])); i++ {
+				code = append(code, lines[i])
+			}
+			children = append(children, node{
+				"type":     "codeblock",
+				"version":  1,
+				"code":     strings.Join(code, "\n"),
+				"language": language,
+			})
+
+		case headingRe.MatchString(trimmed):
+			flushParagraph()
+			sub := headingRe.FindStringSubmatch(trimmed)
+			children = append(children, elementNode("heading", parseInline(sub[2]), node{
+				"tag": fmt.Sprintf("h%d", len(sub[1])),
+			}))
+
+		case imageRe.MatchString(trimmed):
+			flushParagraph()
+			sub := imageRe.FindStringSubmatch(trimmed)
+			children = append(children, node{
+				"type":    "image",
+				"version": 1,
+				"src":     sub[2],
+				"alt":     sub[1],
+			})
+
+		case embedRe.MatchString(trimmed):
+			flushParagraph()
+			children = append(children, node{
+				"type":    "embed",
+				"version": 1,
+				"url":     trimmed,
+			})
+
+		case bulletRe.MatchString(trimmed), numberedRe.MatchString(trimmed):
+			flushParagraph()
+			var items []node
+			listType, tag := "bullet", "ul"
+			if numberedRe.MatchString(trimmed) {
+				listType, tag = "number", "ol"
+			}
+			re := bulletRe
+			if listType == "number" {
+				re = numberedRe
+			}
+			for ; i < len(lines) && re.MatchString(strings.TrimSpace(lines[i])); i++ {
+				item := re.FindStringSubmatch(strings.TrimSpace(lines[i]))[1]
+				items = append(items, elementNode("listitem", parseInline(item), node{
+					"value": len(items) + 1,
+				}))
+			}
+			i--
+			children = append(children, elementNode("list", items, node{
+				"listType": listType,
+				"tag":      tag,
+				"start":    1,
+			}))
+
+		case strings.HasPrefix(trimmed, "> "):
+			flushParagraph()
+			var quote []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "> "); i++ {
+				quote = append(quote, strings.TrimPrefix(strings.TrimSpace(lines[i]), "> "))
+			}
+			i--
+			children = append(children, elementNode("quote", parseInline(strings.Join(quote, " ")), nil))
+
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flushParagraph()
+
+	return children
+}
+
+// paragraphNode builds a paragraph from raw inline Markdown.
+func paragraphNode(text string) node {
+	return elementNode("paragraph", parseInline(text), nil)
+}
+
+// elementNode builds an element node with the common Lexical fields,
+// merged with any type-specific extras.
+func elementNode(nodeType string, children []node, extra node) node {
+	n := node{
+		"children":  children,
+		"direction": nil,
+		"format":    "",
+		"indent":    0,
+		"type":      nodeType,
+		"version":   1,
+	}
+	for k, v := range extra {
+		n[k] = v
+	}
+	return n
+}
+
+var (
+	inlineCodeRe   = regexp.MustCompile("`([^`]+)`")
+	inlineBoldRe   = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	inlineItalicRe = regexp.MustCompile(`\*([^*]+)\*`)
+	inlineLinkRe   = regexp.MustCompile(`\[([^\]]+)\]\(([^)]+)\)`)
+)
+
+// inlinePattern pairs a regex with the node it produces.
+type inlinePattern struct {
+	re    *regexp.Regexp
+	build func(sub []string) node
+}
+
+var inlinePatterns = []inlinePattern{
+	{inlineCodeRe, func(sub []string) node { return textNode(sub[1], formatCode) }},
+	{inlineLinkRe, func(sub []string) node {
+		return elementNode("link", []node{textNode(sub[1], 0)}, node{"url": sub[2], "rel": nil, "target": nil, "title": nil})
+	}},
+	{inlineBoldRe, func(sub []string) node { return textNode(sub[1], formatBold) }},
+	{inlineItalicRe, func(sub []string) node { return textNode(sub[1], formatItalic) }},
+}
+
+// parseInline splits a text run into styled text and link nodes. The
+// earliest match wins at each step; markup does not nest.
+func parseInline(text string) []node {
+	if text == "" {
+		return []node{}
+	}
+
+	for _, p := range inlinePatterns {
+		loc := p.re.FindStringSubmatchIndex(text)
+		if loc == nil {
+			continue
+		}
+		// Make sure no other pattern matches earlier
+		earliest := true
+		for _, q := range inlinePatterns {
+			if other := q.re.FindStringIndex(text); other != nil && other[0] < loc[0] {
+				earliest = false
+				break
+			}
+		}
+		if !earliest {
+			continue
+		}
+
+		var nodes []node
+		nodes = append(nodes, parseInline(text[:loc[0]])...)
+		nodes = append(nodes, p.build(subMatches(text, loc)))
+		nodes = append(nodes, parseInline(text[loc[1]:])...)
+		return nodes
+	}
+
+	return []node{textNode(text, 0)}
+}
+
+// subMatches extracts submatch strings from FindStringSubmatchIndex
+// output.
+func subMatches(text string, loc []int) []string {
+	sub := make([]string, len(loc)/2)
+	for i := range sub {
+		if loc[2*i] >= 0 {
+			sub[i] = text[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return sub
+}
+
+// textNode builds a Lexical text node with the given format bitmask.
+func textNode(text string, format int) node {
+	return node{
+		"detail":  0,
+		"format":  format,
+		"mode":    "normal",
+		"style":   "",
+		"text":    text,
+		"type":    "text",
+		"version": 1,
+	}
+}
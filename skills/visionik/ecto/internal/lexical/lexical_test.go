@@ -0,0 +1,104 @@
+package lexical
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseDoc unmarshals a generated document and returns root.children.
+func parseDoc(t *testing.T, doc string) []map[string]interface{} {
+	t.Helper()
+	var parsed struct {
+		Root struct {
+			Children []map[string]interface{} `json:"children"`
+		} `json:"root"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(doc), &parsed))
+	return parsed.Root.Children
+}
+
+func TestFromMarkdown_Blocks(t *testing.T) {
+	md := "## Heading\n\nFirst paragraph.\n\n- one\n- two\n\n1. first\n2. second\n\n" +
+		"```go\nfmt.Println(\"hi\")\n```\n\n![alt text](https://example.com/a.png)\n\n" +
+		"https://www.youtube.com/watch?v=x\n\n> quoted words\n"
+
+	doc, err := FromMarkdown(md)
+	require.NoError(t, err)
+
+	children := parseDoc(t, doc)
+	require.Len(t, children, 8)
+
+	assert.Equal(t, "heading", children[0]["type"])
+	assert.Equal(t, "h2", children[0]["tag"])
+
+	assert.Equal(t, "paragraph", children[1]["type"])
+
+	assert.Equal(t, "list", children[2]["type"])
+	assert.Equal(t, "bullet", children[2]["listType"])
+	assert.Len(t, children[2]["children"], 2)
+
+	assert.Equal(t, "list", children[3]["type"])
+	assert.Equal(t, "number", children[3]["listType"])
+
+	assert.Equal(t, "codeblock", children[4]["type"])
+	assert.Equal(t, "go", children[4]["language"])
+	assert.Equal(t, `fmt.Println("hi")`, children[4]["code"])
+
+	assert.Equal(t, "image", children[5]["type"])
+	assert.Equal(t, "https://example.com/a.png", children[5]["src"])
+	assert.Equal(t, "alt text", children[5]["alt"])
+
+	assert.Equal(t, "embed", children[6]["type"])
+
+	assert.Equal(t, "quote", children[7]["type"])
+}
+
+func TestFromMarkdown_Quote(t *testing.T) {
+	doc, err := FromMarkdown("> line one\n> line two\n")
+	require.NoError(t, err)
+
+	children := parseDoc(t, doc)
+	require.Len(t, children, 1)
+	assert.Equal(t, "quote", children[0]["type"])
+}
+
+func TestFromMarkdown_Inline(t *testing.T) {
+	doc, err := FromMarkdown("plain **bold** and *italic* and `code` and [link](https://example.com)")
+	require.NoError(t, err)
+
+	children := parseDoc(t, doc)
+	require.Len(t, children, 1)
+
+	nodes := children[0]["children"].([]interface{})
+	var kinds []string
+	for _, raw := range nodes {
+		n := raw.(map[string]interface{})
+		if n["type"] == "link" {
+			assert.Equal(t, "https://example.com", n["url"])
+			kinds = append(kinds, "link")
+			continue
+		}
+		kinds = append(kinds, n["text"].(string))
+		switch n["text"] {
+		case "bold":
+			assert.EqualValues(t, formatBold, n["format"])
+		case "italic":
+			assert.EqualValues(t, formatItalic, n["format"])
+		case "code":
+			assert.EqualValues(t, formatCode, n["format"])
+		}
+	}
+	assert.Contains(t, kinds, "bold")
+	assert.Contains(t, kinds, "italic")
+	assert.Contains(t, kinds, "code")
+	assert.Contains(t, kinds, "link")
+}
+
+func TestFromMarkdown_Empty(t *testing.T) {
+	doc, err := FromMarkdown("")
+	require.NoError(t, err)
+	assert.Empty(t, parseDoc(t, doc))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/alecthomas/kong"
 	"github.com/visionik/mogcli/internal/cli"
+	"github.com/visionik/mogcli/internal/graph"
 )
 
 var version = "dev"
@@ -23,6 +24,8 @@ func main() {
 		}
 	}
 
+	cli.BuildVersion = version
+
 	var root cli.Root
 	ctx := kong.Parse(&root,
 		kong.Name("mog"),
@@ -34,6 +37,12 @@ func main() {
 	)
 
 	err := ctx.Run(&root)
+
+	// 在命令结束后输出请求统计 (包括失败的命令)
+	if root.Stats {
+		fmt.Fprintln(os.Stderr, graph.StatsSummary())
+	}
+
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "错误: %v\n", err)
 		os.Exit(1)
@@ -15,16 +15,27 @@ mog mail search "*" --max 10
 
 --json           JSON 输出（用于脚本）
 --plain          纯文本输出（TSV）
+--timeout 30s    所有请求的总超时; Ctrl-C 随时安全中断
+--dry-run        打印将要发出的写请求 (POST/PATCH/PUT/DELETE) 而不执行
 --verbose, -v    显示完整 ID
 --force          跳过确认
 --no-input       从不提示（CI 模式）
+--stats          命令结束后输出请求统计（次数/字节/限流/耗时）
 --ai-help        此帮助文本
 
 ## 认证
 
 mog auth login --client-id <id>    # 设备代码流程
+mog auth login --client-id <id> --tenant <租户> --profile work   # 每个 profile 独立的租户/令牌
+mog auth list                        # 列出账户配置档案
+mog auth login --app --tenant <id> --client-secret <密钥> --user <邮箱>   # 守护进程的客户端凭据流程
+mog auth login --client-id <id> --scopes User.Read,Mail.Read   # 自定义权限范围; 403 时按提示追加授权
+mog auth login --client-id <id> --cloud usgov   # 国家云 (global|usgov|china); --authority 自定义登录端点
+mog --profile work mail ls           # 任意命令均可用 -p/--profile 切换账户
 mog auth status                     # 检查认证状态
 mog auth logout                     # 清除令牌
+mog auth broker                     # 本地套接字令牌代理 (供脚本取用)
+  --listen unix:///tmp/mog.sock     # GET /token 返回当前访问令牌
 
 所需的 Azure AD 权限（委派）：
 - User.Read, offline_access
@@ -38,10 +49,14 @@ mog auth logout                     # 清除令牌
 ## 邮件
 
 mog mail search <query>              # 搜索消息（* 表示所有）
+  --all                              # 跟随 @odata.nextLink 获取所有页
+  (JSON 输出含 nextLink 游标)
   --max N                            # 最大结果数（默认：25）
   --folder <id>                      # 在特定文件夹中搜索
 
 mog mail get <id>                    # 通过 ID 获取消息
+mog mail get <id> --format markdown # 正文转 Markdown (也支持 text|html; calendar/onenote get 同)
+  --extract urls|dates|tracking-numbers  # 提取正文实体 (JSON)
 
 mog mail send [flags]
   --to <email>                       # 收件人（必需）
@@ -51,9 +66,15 @@ mog mail send [flags]
   --body <text>                      # 正文文本
   --body-file <path>                 # 从文件读取正文（- 表示标准输入）
   --body-html <html>                 # HTML 正文
+  --attach <path>                    # 附件 (可重复; >3MB 自动走 uploadSession)
 
 mog mail folders                     # 列出邮件文件夹
 
+mog mail move <id>... --folder <id>  # 移动消息 (支持多个 ID)
+mog mail archive <id>...             # 存档消息
+mog mail rm <id>...                  # 删除消息
+mog mail mark read|unread|flag|unflag <id>...
+
 mog mail drafts list
 mog mail drafts create [flags]       # 与 send 相同的标志
 mog mail drafts send <draftId>
@@ -62,6 +83,17 @@ mog mail drafts delete <draftId>
 mog mail attachment list <messageId>
 mog mail attachment download <messageId> <attachmentId> --out <path>
 
+mog mail export <id> --out msg.eml    # 导出原始 MIME (- 表示标准输出)
+mog mail import <file.eml>            # 从原始 MIME 创建消息
+  --folder <id>                       # 目标文件夹 (默认: 草稿)
+
+mog mail autoreply get               # 显示外出自动回复设置
+mog mail autoreply set --internal-message <text>
+  --start/--end <datetime>           # 计划模式 (省略则一直启用)
+  --external-message <text>          # 外部发件人回复
+  --external-audience none|contactsOnly|all
+mog mail autoreply disable
+
 ## 日历
 
 mog calendar list                    # 列出事件
@@ -70,7 +102,14 @@ mog calendar list                    # 列出事件
   --max N                            # 最大事件数
   --calendar <id>                    # 特定日历
 
+mog calendar week [--grid]           # 本周事件 (--grid 按小时分块网格)
+mog calendar month [--month YYYY-MM] # 月历网格 (每天标注事件数)
+
 mog calendar get <eventId>
+  --ics [--out ev.ics]               # 导出为 iCalendar
+
+mog calendar attachments list <eventId>
+mog calendar attachments download <eventId> <attachmentId> [--out <path>]
 
 mog calendar create [flags]
   --summary <text>                   # 事件标题（必需）
@@ -84,24 +123,55 @@ mog calendar create [flags]
 
 mog calendar update <eventId> [flags]
 mog calendar delete <eventId>
-mog calendar calendars               # 列出日历
+mog calendar calendars               # 列出日历 (含本地共享列表)
+mog calendar calendars create <name> # 创建日历
+mog calendar calendars delete <id>   # 删除日历 (或移除共享邮箱)
+mog calendar calendars add-shared <email>  # 添加同事的共享日历
+mog calendar groups                  # 列出日历组
+
+mog calendar acl [calendarId]        # 列出日历权限
+mog calendar acl grant <email> --role read   # 授予权限 (freeBusyRead/limitedRead/read/write)
+mog calendar acl revoke <email>      # 撤销权限
+
+mog calendar watch                   # 增量同步变更 (JSONL add/update/delete)
+  --window today..+30d               # 初始同步窗口
+  --follow --interval 30             # 持续轮询
+  --reset                            # 丢弃 deltaLink 重新同步
+
+mog calendar settings get            # 显示时区和工作时间
+mog calendar settings set --timezone <tz> --days monday,friday --start 09:00 --end 18:00
+  # create/update 的时间按邮箱时区解释
 
 mog calendar respond <eventId> <response>
   # response: accept, decline, tentative
   --comment <text>                   # 可选评论
 
+mog calendar cancel <eventId> --comment <text>   # 组织者取消并通知与会者
+mog calendar propose <eventId> --start <datetime> --end <datetime>
+  # 暂定接受并提议新时间
+
 mog calendar freebusy <emails>... --start <datetime> --end <datetime>
+  # 默认渲染时段 × 与会者的忙闲网格并列出共同空闲时段
+
+mog calendar find-times --attendees a@x,b@x
+  --duration 30m                     # 会议时长
+  --window today..+5d                # 搜索窗口
+  --book --summary <text>            # 在排名最高的时段创建事件
 
 别名: mog cal → mog calendar
 
 ## 驱动器 (OneDrive)
 
 mog drive ls [path]                  # 列出文件
-mog drive search <query>             # 搜索文件
-mog drive get <id>                   # 获取文件元数据
+mog drive search <query>             # 搜索文件 (--folder 限定范围)
+mog drive get <id>...                # 获取文件元数据 (多个 ID 合并为单个 $batch)
 
-mog drive download <id> --out <path>
+mog drive download <id|/path> --out <path>
+  # 路径寻址: mog drive download /Documents/report.docx --out report.docx
+mog drive cat <id|/path>             # 输出文件内容到 stdout (可管道)
+  # download -r 可递归下载整个文件夹
 mog drive upload <path>
+  -r                                 # 递归上传整个目录
   --folder <id>                      # 目标文件夹
   --name <name>                      # 上传时重命名
 
@@ -110,8 +180,16 @@ mog drive mkdir <name>
 
 mog drive move <id> <destinationId>
 mog drive rename <id> <newName>
-mog drive copy <id> --name <name>
+mog drive copy <id> --name <name> [--wait]   # --wait 轮询至完成并输出新 ID
 mog drive rm <id>                    # 删除文件
+mog drive sync <local> <remote>      # 增量同步目录 (delta + mtime 比较)
+mog drive versions <id|/path>        # 列出文件版本
+mog drive versions restore <id> <versionId>
+mog drive trash                      # 列出回收站
+mog drive restore <id> [--parent <folderId>] [--name <name>]
+mog drive quota                      # 存储配额 (总量/已用/剩余)
+mog drive usage [--top 20] [--path /Documents] [--depth 3]
+mog drive complete [partial-path]    # 补全远程路径 (供 shell 补全脚本使用)
 
 ## 联系人
 
@@ -121,22 +199,39 @@ mog contacts get <id>
 
 mog contacts create [flags]
   --name <text>                      # 显示名称（必需）
-  --email <email>                    # 电子邮件地址
-  --phone <number>                   # 电话号码
+  --email <email>                    # 电子邮件地址 (可重复)
+  --phone <number>                   # 工作电话
+  --mobile / --home-phone <number>   # 移动/住宅电话
+  --home-address / --business-address "街道, 城市, 省, 邮编, 国家"
   --company <text>                   # 公司名称
   --title <text>                     # 职位
+  --folder <id>                      # 目标联系人文件夹
 
 mog contacts update <id> [flags]     # 与 create 相同的标志
 mog contacts delete <id>
 mog contacts directory <query>       # 搜索组织目录
+mog contacts folders                 # 列出联系人文件夹
+mog contacts folders create <名称>
+mog contacts list --folder <id>      # 列出文件夹中的联系人
+
+mog search <query> [--types message,event,driveItem,person]   # 跨工作负载统一搜索 (Microsoft Search API)
+mog people search <query>            # 按沟通相关性模糊搜索人员
+
+mog me                               # 我的资料
+mog me photo [--out photo.jpg]       # 下载头像
+mog me manager / mog me reports      # 经理 / 直接下属
+mog users get <邮箱|id>              # 用户资料 (含经理和下属)
 
 ## 任务 (Microsoft To-Do)
 
 mog tasks lists                      # 列出任务列表
+mog tasks lists create <名称> / rename <id> <名称> / rm <id>
+mog tasks today                      # 所有列表中今天到期的任务
+mog tasks overdue                    # 所有列表中逾期的任务
 mog tasks list [listId]              # 列出任务
   --all                              # 包含已完成的
 
-mog tasks add <title>
+mog tasks add <title>...             # 多个标题批量创建 (单个 $batch)
   --list <id>                        # 任务列表 ID
   --due <date>                       # 截止日期（YYYY-MM-DD 或 'tomorrow'）
   --notes <text>                     # 任务备注
@@ -150,8 +245,40 @@ mog tasks undo <taskId> --list <id>
 mog tasks delete <taskId> --list <id>
 mog tasks clear [listId]             # 清除已完成的任务
 
+mog tasks checklist <taskId> --list <id>         # 列出子任务
+mog tasks checklist add <taskId> <标题> --list <id>
+mog tasks checklist check|uncheck <taskId> <itemId> --list <id>
+mog tasks checklist rm <taskId> <itemId> --list <id>
+
 别名: mog todo → mog tasks
 
+## Teams
+
+mog teams                            # 列出已加入的团队
+mog teams channels <teamId>          # 列出频道
+mog teams messages <teamId> <channelId>  # 列出频道消息
+mog teams post <teamId> <channelId> <文本> [--html]
+mog teams reply <teamId> <channelId> <messageId> <文本>
+mog teams chats                      # 列出聊天
+mog teams chats messages <chatId>
+mog teams chats send <chatId> <文本>
+
+## 变更通知 (Webhook)
+
+mog subscribe                        # 列出活动订阅
+mog subscribe create /me/messages --url https://example.com/hook   # 订阅邮件变更
+mog subscribe renew <id> / mog subscribe rm <id>
+mog subscribe listen --port 8443 --cert cert.pem --key key.pem     # 本地接收端点, 通知输出为 JSONL
+
+## Planner
+
+mog planner                          # 列出我的计划
+mog planner buckets <planId>
+mog planner tasks [planId] [--bucket <id>]
+mog planner create <planId> <标题> [--bucket <id>] [--assign <邮箱>] [--due YYYY-MM-DD]
+mog planner assign <taskId> <用户ID|邮箱>
+mog planner progress <taskId> <0|50|100>
+
 ## OneNote
 
 mog onenote notebooks                # 列出笔记本
@@ -159,42 +286,73 @@ mog onenote sections <notebookId>    # 列出分区
 mog onenote pages <sectionId>        # 列出页面
 mog onenote get <pageId>             # 获取页面内容
   --html                             # 输出原始 HTML
-mog onenote search <query>           # 搜索（有限）
+mog onenote search <query>           # 搜索页面标题
+  --content                          # 同时搜索页面内容 (较慢)
+  --notebook <id> / --section <id>   # 限定范围
+mog onenote create-page <sectionId> <标题> [内容] [--file <md/html>]
+mog onenote append <pageId> --html <片段>|--markdown-file <文件>
+  --target body|title                # body=追加, title=替换
+mog onenote export <notebookId|sectionId> --out <目录>  # 导出为Markdown树
 
 ## Excel
 
-mog excel list                       # 列出工作簿（通过驱动器搜索）
+mog excel list                       # 列出工作簿（--folder/--shared-with-me 限定范围）
 mog excel metadata <id>              # 列出工作表
 mog excel get <id> [sheet] [range]   # 读取数据
 mog excel update <id> <sheet> <range> <values>...
 mog excel append <id> <table> <values>...
 mog excel create <name>
 mog excel export <id> --out <path>
-mog excel copy <id> <name>
+mog excel copy <id> <name> [--wait]
+mog excel rows <id> <table> [--filter 'Status=Open'] [--max N]
+mog excel delete-row <id> <table> <index>
+mog excel columns <id> <table>       # 列出表格的列
+mog excel charts <id> <sheet>        # 列出图表
+mog excel charts add <id> <sheet> --type line --range A1:C20
+mog excel chart-image <id> <sheet> <chart> --out chart.png
+mog excel refresh-pivots <id> <sheet>
+mog excel names <id>                 # 列出命名区域
+mog excel names get <id> <name>
+mog excel names set <id> <name> <range>      # 如 Sheet1!A1:B10
+mog excel rename-sheet <id> <sheet> <newName>
+mog excel delete-sheet <id> <sheet>
+mog excel protect-sheet <id> <sheet> [--unprotect]
 
 注意：Go 版本中的 Excel 操作有限。使用驱动器命令。
 
 ## Word
 
-mog word list                        # 通过驱动器搜索
+mog word list                        # 通过驱动器搜索 (--folder/--shared-with-me 限定范围)
 mog word export <id> --out <path>
-mog word copy <id> <name>
+mog word copy <id> <name> [--wait]
+mog word append <id> --text <文本>|--markdown-file <文件>
+mog word replace <id> --find <文本> --replace <文本>
 
 注意：大多数操作使用驱动器命令。
 
 ## PowerPoint
 
-mog ppt list                         # 通过驱动器搜索
+mog ppt list                         # 通过驱动器搜索 (--folder/--shared-with-me 限定范围)
 mog ppt export <id> --out <path>
-mog ppt copy <id> <name>
+mog ppt copy <id> <name> [--wait]
+mog ppt read <id>                    # 提取幻灯片标题和正文
 
 注意：大多数操作使用驱动器命令。
 
+## 自更新
+
+mog self-update                      # 更新到最新版本
+  --channel stable|edge              # 发布通道
+  --check                            # 仅检查 (CI 模式; 有更新时退出码 1)
+
 ## 短 ID 系统
 
-Microsoft Graph 使用非常长的 ID。mog 生成 8 字符的短 ID：
+Microsoft Graph 使用非常长的 ID。mog 生成带类型前缀的短 ID
+（如 msg_a1b2c3d4、evt_e5f6a7b8）：
 - 所有命令默认输出短 ID
 - 所有命令接受短 ID 或完整 ID
+- 前缀冲突时自动加长哈希部分, 短 ID 始终唯一
+- mog ids gc [--days 30] 清理长期未使用的映射
 - 使用 --verbose 查看完整 ID
 - 短 ID 缓存在 ~/.config/mog/slugs.json
 
@@ -13,18 +13,37 @@ import (
 // AuthCmd 处理认证命令。
 type AuthCmd struct {
 	Login  AuthLoginCmd  `cmd:"" help:"登录到 Microsoft 365"`
+	List   AuthListCmd   `cmd:"" help:"列出账户配置档案"`
 	Status AuthStatusCmd `cmd:"" help:"显示认证状态"`
 	Logout AuthLogoutCmd `cmd:"" help:"登出并清除令牌"`
+	Broker AuthBrokerCmd `cmd:"" help:"通过本地套接字提供访问令牌"`
 }
 
 // AuthLoginCmd 登录到 Microsoft 365。
 type AuthLoginCmd struct {
 	ClientID string `help:"Azure AD 客户端 ID" required:"" env:"MOG_CLIENT_ID" name:"client-id"`
+	Tenant   string `help:"Azure AD 租户 (默认 common)" env:"MOG_TENANT"`
+	Cloud    string `help:"云环境" default:"global" enum:"global,usgov,china"`
+	Authority string `help:"自定义 authority URL (覆盖云环境的登录端点)"`
+	Scopes   []string `help:"请求的权限范围 (逗号分隔; 默认内置完整集合)"`
 	Storage  string `help:"令牌存储：file 或 keychain" default:"file" enum:"file,keychain"`
+
+	// 客户端凭据 (app-only) 流程, 适合无人值守的守护进程
+	App          bool   `help:"使用客户端凭据流程 (无设备代码提示)"`
+	ClientSecret string `help:"app-only: 客户端密钥" env:"MOG_CLIENT_SECRET" name:"client-secret"`
+	Cert         string `help:"app-only: PEM 证书+私钥文件" type:"existingfile"`
+	User         string `help:"app-only: 默认以该用户执行 /me 请求 (/users/{id} 寻址)"`
 }
 
 // Run 执行认证登录命令。
 func (c *AuthLoginCmd) Run(root *Root) error {
+	if c.App {
+		return c.runAppLogin()
+	}
+	if c.ClientSecret != "" || c.Cert != "" {
+		return fmt.Errorf("--client-secret 和 --cert 仅在 --app 模式下有效")
+	}
+
 	// 设置存储类型
 	if c.Storage == "keychain" {
 		config.SetStorage(config.StorageKeyring)
@@ -32,15 +51,20 @@ func (c *AuthLoginCmd) Run(root *Root) error {
 		config.SetStorage(config.StorageFile)
 	}
 
-	// 保存客户端 ID 和存储偏好
-	cfg := &config.Config{ClientID: c.ClientID, Storage: c.Storage}
+	// 保存客户端 ID、租户、云环境和存储偏好
+	cfg := &config.Config{ClientID: c.ClientID, Storage: c.Storage, Tenant: c.Tenant, Cloud: c.Cloud, Authority: c.Authority}
 	if err := config.Save(cfg); err != nil {
 		return fmt.Errorf("保存配置失败: %w", err)
 	}
 
+	if err := graph.SetCloud(c.Cloud, c.Authority); err != nil {
+		return err
+	}
+	graph.SetAuthTenant(c.Tenant)
+
 	// 请求设备代码
 	fmt.Println("请求设备代码中...")
-	dcResp, err := graph.RequestDeviceCode(c.ClientID)
+	dcResp, err := graph.RequestDeviceCode(c.ClientID, c.Scopes)
 	if err != nil {
 		return fmt.Errorf("请求设备代码失败: %w", err)
 	}
@@ -65,7 +89,62 @@ func (c *AuthLoginCmd) Run(root *Root) error {
 	}
 
 	fmt.Println()
-	fmt.Printf("✓ 登录成功! (存储: %s)\n", c.Storage)
+	if p := config.Profile(); p != "" {
+		fmt.Printf("✓ 登录成功! (profile: %s, 存储: %s)\n", p, c.Storage)
+	} else {
+		fmt.Printf("✓ 登录成功! (存储: %s)\n", c.Storage)
+	}
+	return nil
+}
+
+// runAppLogin 执行客户端凭据 (app-only) 登录。
+func (c *AuthLoginCmd) runAppLogin() error {
+	if c.Tenant == "" {
+		return fmt.Errorf("app-only 流程需要 --tenant (common 端点不支持客户端凭据)")
+	}
+	if (c.ClientSecret == "") == (c.Cert == "") {
+		return fmt.Errorf("需要 --client-secret 或 --cert 其中之一")
+	}
+
+	if c.Storage == "keychain" {
+		config.SetStorage(config.StorageKeyring)
+	} else {
+		config.SetStorage(config.StorageFile)
+	}
+
+	cfg := &config.Config{
+		ClientID:     c.ClientID,
+		Storage:      c.Storage,
+		Tenant:       c.Tenant,
+		Cloud:        c.Cloud,
+		Authority:    c.Authority,
+		Auth:         "app",
+		ClientSecret: c.ClientSecret,
+		CertFile:     c.Cert,
+		User:         c.User,
+	}
+	if err := config.Save(cfg); err != nil {
+		return fmt.Errorf("保存配置失败: %w", err)
+	}
+
+	if err := graph.SetCloud(c.Cloud, c.Authority); err != nil {
+		return err
+	}
+	graph.SetAuthTenant(c.Tenant)
+
+	tokens, err := graph.ClientCredentialsToken(c.ClientID, c.ClientSecret, c.Cert)
+	if err != nil {
+		return fmt.Errorf("获取应用令牌失败: %w", err)
+	}
+
+	if err := config.SaveTokensAuto(tokens); err != nil {
+		return fmt.Errorf("保存令牌失败: %w", err)
+	}
+
+	fmt.Printf("✓ 应用登录成功! (租户: %s)\n", c.Tenant)
+	if c.User != "" {
+		fmt.Printf("  /me 请求将以 /users/%s 执行\n", c.User)
+	}
 	return nil
 }
 
@@ -89,10 +168,32 @@ func (c *AuthStatusCmd) Run(root *Root) error {
 	}
 
 	fmt.Println("状态: 已登录")
+	if p := config.Profile(); p != "" {
+		fmt.Printf("Profile: %s\n", p)
+	}
+	if cfg != nil && cfg.Tenant != "" {
+		fmt.Printf("租户: %s\n", cfg.Tenant)
+	}
+	if cfg != nil && cfg.Cloud != "" && cfg.Cloud != "global" {
+		fmt.Printf("云环境: %s\n", cfg.Cloud)
+	}
+	if cfg != nil && cfg.Authority != "" {
+		fmt.Printf("Authority: %s\n", cfg.Authority)
+	}
+	if cfg != nil && cfg.Auth == "app" {
+		fmt.Println("认证: app-only (客户端凭据)")
+		if cfg.User != "" {
+			fmt.Printf("执行用户: %s\n", cfg.User)
+		}
+	}
 	if cfg != nil && cfg.Storage != "" {
 		fmt.Printf("存储: %s\n", cfg.Storage)
 	}
 
+	if tokens.Scope != "" {
+		fmt.Printf("范围: %s\n", tokens.Scope)
+	}
+
 	if tokens.ExpiresAt > 0 {
 		expiresAt := time.Unix(tokens.ExpiresAt, 0)
 		remaining := time.Until(expiresAt)
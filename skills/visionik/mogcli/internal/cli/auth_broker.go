@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/visionik/mogcli/internal/config"
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// AuthBrokerCmd 通过本地套接字向兄弟工具提供短期访问令牌。
+// 助手脚本只需请求 GET /token 就能拿到当前的 Bearer 令牌直接调用
+// Graph, 无需各自保存刷新令牌。套接字权限为 0600, 仅同一用户可访问。
+type AuthBrokerCmd struct {
+	Listen string `help:"监听地址 (unix:// 套接字路径)" default:"unix:///tmp/mog.sock"`
+}
+
+// brokerToken 是 /token 端点的响应体。
+type brokerToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresAt   int64  `json:"expires_at"`
+}
+
+// Run 执行 auth broker 命令。阻塞直到收到 SIGINT/SIGTERM。
+func (c *AuthBrokerCmd) Run(root *Root) error {
+	path, ok := strings.CutPrefix(c.Listen, "unix://")
+	if !ok || path == "" {
+		return fmt.Errorf("--listen 必须是 unix:// 套接字地址, 例如 unix:///tmp/mog.sock")
+	}
+
+	// 启动前确认已登录, 避免起一个只会返回错误的代理
+	if _, _, err := brokerFreshToken(); err != nil {
+		return err
+	}
+
+	// 清理上次运行遗留的套接字
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("清理旧套接字失败: %w", err)
+	}
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return fmt.Errorf("创建套接字目录失败: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return fmt.Errorf("监听失败: %w", err)
+	}
+	defer listener.Close()
+	defer os.Remove(path)
+
+	// 仅允许当前用户连接
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("设置套接字权限失败: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", handleBrokerToken)
+	server := &http.Server{Handler: mux}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		_ = server.Close()
+	}()
+
+	fmt.Printf("令牌代理监听于 %s (Ctrl-C 停止)\n", c.Listen)
+	if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	fmt.Println("令牌代理已停止")
+	return nil
+}
+
+// handleBrokerToken 处理 GET /token 请求。
+func handleBrokerToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, expiresAt, err := brokerFreshToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(brokerToken{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresAt:   expiresAt,
+	})
+}
+
+// brokerMu 串行化令牌刷新, 避免并发请求重复刷新。
+var brokerMu sync.Mutex
+
+// brokerFreshToken 返回当前的访问令牌, 必要时先刷新并保存。
+func brokerFreshToken() (string, int64, error) {
+	brokerMu.Lock()
+	defer brokerMu.Unlock()
+
+	cfg, _ := config.Load()
+	if cfg != nil && cfg.Storage == "keychain" {
+		config.SetStorage(config.StorageKeyring)
+	} else {
+		config.SetStorage(config.StorageFile)
+	}
+
+	tokens, err := config.LoadTokensAuto()
+	if err != nil {
+		return "", 0, fmt.Errorf("未登录, 请先运行 mog auth login")
+	}
+
+	expiresAt := tokens.GetExpiresAt()
+	// 提前 60 秒刷新, 让拿到令牌的脚本有时间使用
+	if expiresAt > 0 && time.Now().Unix() >= expiresAt-60 {
+		if cfg == nil || cfg.GetClientID() == "" || tokens.RefreshToken == "" {
+			return "", 0, fmt.Errorf("令牌已过期且无法刷新, 请重新登录")
+		}
+		newTokens, err := graph.RefreshToken(cfg.GetClientID(), tokens.RefreshToken)
+		if err != nil {
+			return "", 0, fmt.Errorf("刷新令牌失败: %w", err)
+		}
+		if err := config.SaveTokensAuto(newTokens); err != nil {
+			return "", 0, fmt.Errorf("保存令牌失败: %w", err)
+		}
+		tokens = newTokens
+		expiresAt = tokens.GetExpiresAt()
+	}
+
+	return tokens.AccessToken, expiresAt, nil
+}
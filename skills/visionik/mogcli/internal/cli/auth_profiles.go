@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/visionik/mogcli/internal/config"
+)
+
+// AuthListCmd 列出账户配置档案及其登录状态。
+type AuthListCmd struct{}
+
+// authProfile 表示一个账户配置档案的状态。
+type authProfile struct {
+	Name     string `json:"name"`
+	Active   bool   `json:"active"`
+	LoggedIn bool   `json:"loggedIn"`
+	Tenant   string `json:"tenant,omitempty"`
+}
+
+// Run 执行auth list命令。
+func (c *AuthListCmd) Run(root *Root) error {
+	base, err := config.BaseConfigDir()
+	if err != nil {
+		return err
+	}
+
+	names, err := config.ListProfiles()
+	if err != nil {
+		return err
+	}
+
+	active := config.Profile()
+	profiles := []authProfile{profileInfo(base, "default", active == "")}
+	for _, name := range names {
+		dir := filepath.Join(base, "profiles", name)
+		profiles = append(profiles, profileInfo(dir, name, name == active))
+	}
+
+	if root.JSON {
+		return outputJSON(profiles)
+	}
+
+	for _, p := range profiles {
+		marker := " "
+		if p.Active {
+			marker = "*"
+		}
+		status := "未登录"
+		if p.LoggedIn {
+			status = "已登录"
+		}
+		tenant := p.Tenant
+		if tenant == "" {
+			tenant = "common"
+		}
+		fmt.Printf("%s %-20s %-8s %s\n", marker, p.Name, status, tenant)
+	}
+	return nil
+}
+
+// profileInfo 读取单个配置档案目录的登录状态和租户。
+func profileInfo(dir, name string, active bool) authProfile {
+	p := authProfile{Name: name, Active: active}
+
+	if _, err := os.Stat(filepath.Join(dir, "tokens.json")); err == nil {
+		p.LoggedIn = true
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "settings.json"))
+	if err != nil {
+		return p
+	}
+	var cfg config.Config
+	if json.Unmarshal(data, &cfg) != nil {
+		return p
+	}
+	p.Tenant = cfg.Tenant
+	// keychain 存储的令牌不在文件中, 以配置存在视为已登录
+	if !p.LoggedIn && cfg.Storage == "keychain" {
+		p.LoggedIn = true
+	}
+	return p
+}
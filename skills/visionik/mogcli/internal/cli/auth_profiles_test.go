@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/config"
+)
+
+func TestAuthListCmd_Run(t *testing.T) {
+	cleanup := setupAuthTestConfig(t)
+	defer cleanup()
+
+	// Default profile is logged in
+	require.NoError(t, config.SaveTokens(&config.Tokens{AccessToken: "token"}))
+
+	// Named profile exists but has no tokens
+	home := os.Getenv("HOME")
+	workDir := filepath.Join(home, ".config", "mog", "profiles", "work")
+	require.NoError(t, os.MkdirAll(workDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "settings.json"),
+		[]byte(`{"client_id":"work-client","tenant":"contoso.example"}`), 0600))
+
+	root := &Root{}
+	var err error
+	output := captureOutput(func() { err = (&AuthListCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "* default")
+	assert.Contains(t, output, "已登录")
+	assert.Contains(t, output, "work")
+	assert.Contains(t, output, "未登录")
+	assert.Contains(t, output, "contoso.example")
+}
+
+func TestRootAfterApply_SetsProfile(t *testing.T) {
+	defer config.SetProfile("")
+
+	root := &Root{Profile: "work"}
+	require.NoError(t, root.AfterApply())
+	assert.Equal(t, "work", config.Profile())
+
+	root = &Root{Profile: "bad/name"}
+	assert.Error(t, root.AfterApply())
+}
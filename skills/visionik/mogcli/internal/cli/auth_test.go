@@ -148,3 +148,26 @@ func TestAuthLoginCmd_Fields(t *testing.T) {
 
 // Note: AuthLoginCmd.Run() cannot be fully tested without mocking the device code flow
 // which requires HTTP mocking. The login flow is tested via integration tests.
+
+func TestAuthLoginCmd_AppValidation(t *testing.T) {
+	cleanup := setupAuthTestConfig(t)
+	defer cleanup()
+
+	// --app requires a tenant
+	cmd := &AuthLoginCmd{ClientID: "client-1", App: true, ClientSecret: "secret"}
+	err := cmd.Run(&Root{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--tenant")
+
+	// Exactly one of --client-secret / --cert
+	cmd = &AuthLoginCmd{ClientID: "client-1", App: true, Tenant: "contoso.example"}
+	err = cmd.Run(&Root{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--client-secret")
+
+	// App credentials are meaningless in device code mode
+	cmd = &AuthLoginCmd{ClientID: "client-1", ClientSecret: "secret"}
+	err = cmd.Run(&Root{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--app")
+}
@@ -5,30 +5,43 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/visionik/mogcli/internal/graph"
+	"github.com/visionik/mogcli/internal/output"
 )
 
 // CalendarCmd 处理日历操作命令。
 type CalendarCmd struct {
-	List      CalendarListCmd      `cmd:"" help:"列出事件"`
-	Get       CalendarGetCmd       `cmd:"" help:"获取事件"`
-	Create    CalendarCreateCmd    `cmd:"" help:"创建事件"`
-	Update    CalendarUpdateCmd    `cmd:"" help:"更新事件"`
-	Delete    CalendarDeleteCmd    `cmd:"" help:"删除事件"`
-	Calendars CalendarCalendarsCmd `cmd:"" help:"列出日历"`
-	Respond   CalendarRespondCmd   `cmd:"" help:"回应事件邀请"`
-	FreeBusy  CalendarFreeBusyCmd  `cmd:"" help:"获取空闲/忙碌信息"`
-	ACL       CalendarACLCmd       `cmd:"" help:"列出日历权限"`
+	List      CalendarListCmd        `cmd:"" help:"列出事件"`
+	Week      CalendarWeekCmd        `cmd:"" help:"本周事件 (--grid 网格视图)"`
+	Month     CalendarMonthCmd       `cmd:"" help:"月历网格视图"`
+	Get       CalendarGetCmd         `cmd:"" help:"获取事件"`
+	Create    CalendarCreateCmd      `cmd:"" help:"创建事件"`
+	Update    CalendarUpdateCmd      `cmd:"" help:"更新事件"`
+	Delete    CalendarDeleteCmd      `cmd:"" help:"删除事件"`
+	Calendars CalendarCalendarsCmd   `cmd:"" help:"管理日历 (列出/创建/删除/共享)"`
+	Groups    CalendarGroupsCmd      `cmd:"" help:"列出日历组"`
+	Respond   CalendarRespondCmd     `cmd:"" help:"回应事件邀请"`
+	Cancel    CalendarCancelCmd      `cmd:"" help:"取消事件并通知与会者"`
+	Propose   CalendarProposeCmd     `cmd:"" help:"暂定接受并提议新时间"`
+	Settings  CalendarSettingsCmd    `cmd:"" help:"时区和工作时间设置"`
+	FreeBusy  CalendarFreeBusyCmd    `cmd:"" help:"获取空闲/忙碌信息"`
+	FindTimes CalendarFindTimesCmd   `cmd:"" name:"find-times" help:"寻找所有与会者有空的时段"`
+	Attach    CalendarAttachmentsCmd `cmd:"" name:"attachments" help:"事件附件操作"`
+	Watch     CalendarWatchCmd       `cmd:"" help:"增量同步日历变更 (JSONL 输出)"`
+	ACL       CalendarACLCmd         `cmd:"" help:"日历权限 (列出/授予/撤销)"`
 }
 
 // CalendarListCmd 列出事件。
 type CalendarListCmd struct {
-	Calendar string `help:"日历 ID (默认: primary)"`
-	From     string `help:"开始日期 (ISO 格式)" default:""`
-	To       string `help:"结束日期 (ISO 格式)" default:""`
-	Max      int    `help:"最大事件数" default:"25"`
+	Calendar      string `help:"日历 ID (默认: primary)"`
+	CalendarOwner string `help:"以委托人身份操作该用户的日历 (user@domain)" name:"calendar-owner"`
+	From          string `help:"开始日期 (ISO 格式)" default:""`
+	To            string `help:"结束日期 (ISO 格式)" default:""`
+	Max           int    `help:"最大事件数" default:"25"`
 }
 
 // Run 执行日历列表命令。
@@ -69,15 +82,17 @@ func (c *CalendarListCmd) Run(root *Root) error {
 	query.Set("$orderby", "start/dateTime")
 	query.Set("startDateTime", from.Format(time.RFC3339))
 	query.Set("endDateTime", to.Format(time.RFC3339))
+	query = applySelect(query, root, "id,subject,start,end,location,organizer,isAllDay")
 
-	path := "/me/calendarView"
+	base := calendarBasePath(c.CalendarOwner)
+	path := base + "/calendarView"
 	if c.Calendar != "" {
-		path = fmt.Sprintf("/me/calendars/%s/calendarView", graph.ResolveID(c.Calendar))
+		path = fmt.Sprintf("%s/calendars/%s/calendarView", base, graph.ResolveID(c.Calendar))
 	}
 
 	data, err := client.Get(ctx, path, query)
 	if err != nil {
-		return err
+		return wrapCalendarOwnerErr(err, c.CalendarOwner)
 	}
 
 	var resp struct {
@@ -91,6 +106,24 @@ func (c *CalendarListCmd) Run(root *Root) error {
 		return outputJSON(resp.Value)
 	}
 
+	if root.Plain {
+		t := output.NewTable("id", "start", "end", "subject", "location")
+		for _, event := range resp.Value {
+			start, end, location := "", "", ""
+			if event.Start != nil {
+				start = event.Start.DateTime
+			}
+			if event.End != nil {
+				end = event.End.DateTime
+			}
+			if event.Location != nil {
+				location = event.Location.DisplayName
+			}
+			t.Row(graph.FormatTypedID("evt", event.ID), start, end, event.Subject, location)
+		}
+		return t.Render(os.Stdout, true)
+	}
+
 	if len(resp.Value) == 0 {
 		fmt.Println("未找到事件")
 		return nil
@@ -104,7 +137,10 @@ func (c *CalendarListCmd) Run(root *Root) error {
 
 // CalendarGetCmd 获取事件。
 type CalendarGetCmd struct {
-	ID string `arg:"" help:"事件 ID"`
+	ID     string `arg:"" help:"事件 ID"`
+	ICS    bool   `help:"导出为 iCalendar (.ics)"`
+	Out    string `help:"--ics 的输出文件路径 (默认: 标准输出)"`
+	Format string `help:"正文渲染格式" enum:"text,markdown,html" default:"text"`
 }
 
 // Run 执行日历获取命令。
@@ -117,11 +153,32 @@ func (c *CalendarGetCmd) Run(root *Root) error {
 	ctx := context.Background()
 	path := fmt.Sprintf("/me/events/%s", graph.ResolveID(c.ID))
 
-	data, err := client.Get(ctx, path, nil)
+	fields := "id,subject,start,end,location,body,isAllDay,organizer"
+	if c.ICS {
+		fields += ",attendees"
+	}
+	query := applySelect(nil, root, fields)
+	data, err := client.Get(ctx, path, query)
 	if err != nil {
 		return err
 	}
 
+	if c.ICS {
+		ics, err := eventToICS(data)
+		if err != nil {
+			return err
+		}
+		if c.Out != "" {
+			if err := writeFileAtomic(c.Out, []byte(ics), 0644); err != nil {
+				return err
+			}
+			fmt.Printf("✓ 已导出: %s\n", c.Out)
+			return nil
+		}
+		fmt.Print(ics)
+		return nil
+	}
+
 	var event Event
 	if err := json.Unmarshal(data, &event); err != nil {
 		return err
@@ -131,20 +188,21 @@ func (c *CalendarGetCmd) Run(root *Root) error {
 		return outputJSON(event)
 	}
 
-	printEventDetail(event, root.Verbose)
+	printEventDetail(event, root.Verbose, c.Format)
 	return nil
 }
 
 // CalendarCreateCmd 创建事件。
 type CalendarCreateCmd struct {
-	Summary     string   `help:"事件标题/摘要" required:""`
-	From        string   `help:"开始时间 (ISO 格式)" required:""`
-	To          string   `help:"结束时间 (ISO 格式)" required:""`
-	Location    string   `help:"地点"`
-	Description string   `help:"事件描述" name:"description"`
-	Attendees   []string `help:"与会者电子邮件地址"`
-	AllDay      bool     `help:"全天事件" name:"all-day"`
-	Calendar    string   `help:"日历 ID"`
+	Summary       string   `help:"事件标题/摘要" required:""`
+	From          string   `help:"开始时间 (ISO 格式)" required:""`
+	To            string   `help:"结束时间 (ISO 格式)" required:""`
+	Location      string   `help:"地点"`
+	Description   string   `help:"事件描述" name:"description"`
+	Attendees     []string `help:"与会者电子邮件地址"`
+	AllDay        bool     `help:"全天事件" name:"all-day"`
+	Calendar      string   `help:"日历 ID"`
+	CalendarOwner string   `help:"以委托人身份操作该用户的日历 (user@domain)" name:"calendar-owner"`
 }
 
 // Run 执行日历创建命令。
@@ -154,15 +212,17 @@ func (c *CalendarCreateCmd) Run(root *Root) error {
 		return err
 	}
 
+	// 使用邮箱配置的时区, 避免把本地时间当作 UTC
+	tz := mailboxTimeZone(client)
 	event := map[string]interface{}{
 		"subject": c.Summary,
 		"start": map[string]string{
 			"dateTime": c.From,
-			"timeZone": "UTC",
+			"timeZone": tz,
 		},
 		"end": map[string]string{
 			"dateTime": c.To,
-			"timeZone": "UTC",
+			"timeZone": tz,
 		},
 	}
 
@@ -193,14 +253,15 @@ func (c *CalendarCreateCmd) Run(root *Root) error {
 	}
 
 	ctx := context.Background()
-	path := "/me/events"
+	base := calendarBasePath(c.CalendarOwner)
+	path := base + "/events"
 	if c.Calendar != "" {
-		path = fmt.Sprintf("/me/calendars/%s/events", graph.ResolveID(c.Calendar))
+		path = fmt.Sprintf("%s/calendars/%s/events", base, graph.ResolveID(c.Calendar))
 	}
 
 	data, err := client.Post(ctx, path, event)
 	if err != nil {
-		return err
+		return wrapCalendarOwnerErr(err, c.CalendarOwner)
 	}
 
 	var created Event
@@ -212,18 +273,19 @@ func (c *CalendarCreateCmd) Run(root *Root) error {
 		return outputJSON(created)
 	}
 
-	fmt.Printf("✓ 事件创建成功: %s (%s)\n", created.Subject, graph.FormatID(created.ID))
+	fmt.Printf("✓ 事件创建成功: %s (%s)\n", created.Subject, graph.FormatTypedID("evt", created.ID))
 	return nil
 }
 
 // CalendarUpdateCmd 更新事件。
 type CalendarUpdateCmd struct {
-	ID          string `arg:"" help:"事件 ID"`
-	Summary     string `help:"新标题/摘要"`
-	From        string `help:"新开始时间"`
-	To          string `help:"新结束时间"`
-	Location    string `help:"新地点"`
-	Description string `help:"新描述" name:"description"`
+	ID            string `arg:"" help:"事件 ID"`
+	Summary       string `help:"新标题/摘要"`
+	From          string `help:"新开始时间"`
+	To            string `help:"新结束时间"`
+	Location      string `help:"新地点"`
+	Description   string `help:"新描述" name:"description"`
+	CalendarOwner string `help:"以委托人身份操作该用户的日历 (user@domain)" name:"calendar-owner"`
 }
 
 // Run 执行日历更新命令。
@@ -238,11 +300,14 @@ func (c *CalendarUpdateCmd) Run(root *Root) error {
 	if c.Summary != "" {
 		updates["subject"] = c.Summary
 	}
-	if c.From != "" {
-		updates["start"] = map[string]string{"dateTime": c.From, "timeZone": "UTC"}
-	}
-	if c.To != "" {
-		updates["end"] = map[string]string{"dateTime": c.To, "timeZone": "UTC"}
+	if c.From != "" || c.To != "" {
+		tz := mailboxTimeZone(client)
+		if c.From != "" {
+			updates["start"] = map[string]string{"dateTime": c.From, "timeZone": tz}
+		}
+		if c.To != "" {
+			updates["end"] = map[string]string{"dateTime": c.To, "timeZone": tz}
+		}
 	}
 	if c.Location != "" {
 		updates["location"] = map[string]string{"displayName": c.Location}
@@ -256,11 +321,11 @@ func (c *CalendarUpdateCmd) Run(root *Root) error {
 	}
 
 	ctx := context.Background()
-	path := fmt.Sprintf("/me/events/%s", graph.ResolveID(c.ID))
+	path := fmt.Sprintf("%s/events/%s", calendarBasePath(c.CalendarOwner), graph.ResolveID(c.ID))
 
 	_, err = client.Patch(ctx, path, updates)
 	if err != nil {
-		return err
+		return wrapCalendarOwnerErr(err, c.CalendarOwner)
 	}
 
 	fmt.Println("✓ 事件更新成功")
@@ -290,43 +355,6 @@ func (c *CalendarDeleteCmd) Run(root *Root) error {
 	return nil
 }
 
-// CalendarCalendarsCmd 列出日历。
-type CalendarCalendarsCmd struct{}
-
-// Run 执行日历列表命令。
-func (c *CalendarCalendarsCmd) Run(root *Root) error {
-	client, err := root.GetClient()
-	if err != nil {
-		return err
-	}
-
-	ctx := context.Background()
-	data, err := client.Get(ctx, "/me/calendars", nil)
-	if err != nil {
-		return err
-	}
-
-	var resp struct {
-		Value []Calendar `json:"value"`
-	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return err
-	}
-
-	if root.JSON {
-		return outputJSON(resp.Value)
-	}
-
-	for _, cal := range resp.Value {
-		marker := " "
-		if cal.IsDefaultCalendar {
-			marker = "*"
-		}
-		fmt.Printf("%s %-30s %s\n", marker, cal.Name, graph.FormatID(cal.ID))
-	}
-	return nil
-}
-
 // CalendarRespondCmd 回应事件邀请。
 type CalendarRespondCmd struct {
 	ID       string `arg:"" help:"事件 ID"`
@@ -372,6 +400,72 @@ func (c *CalendarRespondCmd) Run(root *Root) error {
 	return nil
 }
 
+// CalendarCancelCmd 取消事件 (组织者) 并给与会者留言。
+type CalendarCancelCmd struct {
+	ID      string `arg:"" help:"事件 ID"`
+	Comment string `help:"发给与会者的取消消息"`
+}
+
+// Run 执行日历取消命令。
+func (c *CalendarCancelCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{}
+	if c.Comment != "" {
+		body["comment"] = c.Comment
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/events/%s/cancel", graph.ResolveID(c.ID))
+
+	if _, err := client.Post(ctx, path, body); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 已取消事件")
+	return nil
+}
+
+// CalendarProposeCmd 暂定接受并提议新时间。
+type CalendarProposeCmd struct {
+	ID      string `arg:"" help:"事件 ID"`
+	Start   string `help:"提议的开始时间 (ISO 格式)" required:""`
+	End     string `help:"提议的结束时间 (ISO 格式)" required:""`
+	Comment string `help:"可选评论"`
+}
+
+// Run 执行日历提议新时间命令。
+func (c *CalendarProposeCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"sendResponse": true,
+		"proposedNewTime": map[string]interface{}{
+			"start": map[string]string{"dateTime": c.Start, "timeZone": "UTC"},
+			"end":   map[string]string{"dateTime": c.End, "timeZone": "UTC"},
+		},
+	}
+	if c.Comment != "" {
+		body["comment"] = c.Comment
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/events/%s/tentativelyAccept", graph.ResolveID(c.ID))
+
+	if _, err := client.Post(ctx, path, body); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已提议新时间: %s - %s\n", c.Start, c.End)
+	return nil
+}
+
 // CalendarFreeBusyCmd 获取空闲/忙碌信息。
 type CalendarFreeBusyCmd struct {
 	Emails []string `arg:"" help:"要检查的电子邮件地址"`
@@ -379,8 +473,8 @@ type CalendarFreeBusyCmd struct {
 	End    string   `help:"结束时间 (ISO 格式)" required:""`
 }
 
-// CalendarACLCmd 列出日历权限。
-type CalendarACLCmd struct {
+// CalendarACLListCmd 列出日历权限。
+type CalendarACLListCmd struct {
 	Calendar string `arg:"" optional:"" help:"日历 ID (默认: primary)"`
 }
 
@@ -415,7 +509,21 @@ func (c *CalendarFreeBusyCmd) Run(root *Root) error {
 		return outputJSON(resp)
 	}
 
-	fmt.Println(string(data))
+	var resp struct {
+		Value []scheduleInfo `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	start := parseFreeBusyStart(c.Start)
+	if start.IsZero() || len(resp.Value) == 0 {
+		// 无法解析时回退到原始输出
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Print(renderFreeBusy(start, 30*time.Minute, resp.Value))
 	return nil
 }
 
@@ -465,12 +573,12 @@ type Calendar struct {
 
 // CalendarPermission 表示日历权限（ACL 条目）。
 type CalendarPermission struct {
-	ID                  string        `json:"id"`
-	Role                string        `json:"role"`
-	AllowedRoles        []string      `json:"allowedRoles"`
-	EmailAddress        *EmailAddress `json:"emailAddress"`
-	IsRemovable         bool          `json:"isRemovable"`
-	IsInsideOrganization bool         `json:"isInsideOrganization"`
+	ID                   string        `json:"id"`
+	Role                 string        `json:"role"`
+	AllowedRoles         []string      `json:"allowedRoles"`
+	EmailAddress         *EmailAddress `json:"emailAddress"`
+	IsRemovable          bool          `json:"isRemovable"`
+	IsInsideOrganization bool          `json:"isInsideOrganization"`
 }
 
 // EmailAddress 表示权限中的电子邮件地址。
@@ -479,20 +587,15 @@ type EmailAddress struct {
 	Address string `json:"address"`
 }
 
-// Run 执行日历权限命令。
-func (c *CalendarACLCmd) Run(root *Root) error {
+// Run 执行日历权限列表命令。
+func (c *CalendarACLListCmd) Run(root *Root) error {
 	client, err := root.GetClient()
 	if err != nil {
 		return err
 	}
 
 	ctx := context.Background()
-	path := "/me/calendar/calendarPermissions"
-	if c.Calendar != "" {
-		path = fmt.Sprintf("/me/calendars/%s/calendarPermissions", graph.ResolveID(c.Calendar))
-	}
-
-	data, err := client.Get(ctx, path, nil)
+	data, err := client.Get(ctx, calendarPermissionsPath(c.Calendar), nil)
 	if err != nil {
 		return err
 	}
@@ -543,6 +646,29 @@ func (c *CalendarACLCmd) Run(root *Root) error {
 	return nil
 }
 
+// calendarBasePath 返回日历操作的 Graph 资源根路径。
+// owner 为空时操作当前登录用户 (/me)，否则以委托人身份操作 /users/{owner}。
+func calendarBasePath(owner string) string {
+	if owner == "" {
+		return "/me"
+	}
+	return fmt.Sprintf("/users/%s", url.PathEscape(owner))
+}
+
+// wrapCalendarOwnerErr 在委托请求因权限不足被拒绝时补充提示信息。
+func wrapCalendarOwnerErr(err error, owner string) error {
+	if err == nil || owner == "" {
+		return err
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "ErrorAccessDenied") ||
+		strings.Contains(msg, "Authorization_RequestDenied") ||
+		strings.Contains(msg, "API error 403") {
+		return fmt.Errorf("无法访问 %s 的日历 (需要委托权限或日历共享): %w", owner, err)
+	}
+	return err
+}
+
 // printEvent 打印事件摘要信息
 func printEvent(event Event, verbose bool) {
 	start := ""
@@ -561,15 +687,15 @@ func printEvent(event Event, verbose bool) {
 	}
 
 	fmt.Printf("%-16s %s%s\n", start, event.Subject, location)
-	fmt.Printf("  ID: %s\n", graph.FormatID(event.ID))
+	fmt.Printf("  ID: %s\n", graph.FormatTypedID("evt", event.ID))
 	if verbose {
 		fmt.Printf("  完整: %s\n", event.ID)
 	}
 }
 
 // printEventDetail 打印事件详细信息
-func printEventDetail(event Event, verbose bool) {
-	fmt.Printf("ID:       %s\n", graph.FormatID(event.ID))
+func printEventDetail(event Event, verbose bool, format string) {
+	fmt.Printf("ID:       %s\n", graph.FormatTypedID("evt", event.ID))
 	if verbose {
 		fmt.Printf("完整 ID:  %s\n", event.ID)
 	}
@@ -590,10 +716,6 @@ func printEventDetail(event Event, verbose bool) {
 			event.Organizer.EmailAddress.Address)
 	}
 	if event.Body != nil && event.Body.Content != "" {
-		content := event.Body.Content
-		if event.Body.ContentType == "html" {
-			content = stripHTML(content)
-		}
-		fmt.Printf("\n%s\n", content)
+		fmt.Printf("\n%s\n", renderBody(event.Body.Content, event.Body.ContentType, format))
 	}
 }
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// CalendarAttachmentsCmd 管理事件附件。
+type CalendarAttachmentsCmd struct {
+	List     CalendarAttachmentsListCmd     `cmd:"" help:"列出事件附件"`
+	Download CalendarAttachmentsDownloadCmd `cmd:"" help:"下载事件附件"`
+}
+
+// CalendarAttachmentsListCmd 列出事件附件。
+type CalendarAttachmentsListCmd struct {
+	EventID string `arg:"" help:"事件 ID"`
+}
+
+// Run 执行事件附件列表命令。
+func (c *CalendarAttachmentsListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/events/%s/attachments", graph.ResolveID(c.EventID))
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []Attachment `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("无附件")
+		return nil
+	}
+	for _, a := range resp.Value {
+		fmt.Printf("%s  %s (%d 字节)\n", graph.FormatID(a.ID), a.Name, a.Size)
+	}
+	return nil
+}
+
+// CalendarAttachmentsDownloadCmd 下载事件附件。
+type CalendarAttachmentsDownloadCmd struct {
+	EventID      string `arg:"" help:"事件 ID"`
+	AttachmentID string `arg:"" help:"附件 ID"`
+	Out          string `help:"输出文件路径 (默认: 附件名)"`
+}
+
+// Run 执行事件附件下载命令。
+func (c *CalendarAttachmentsDownloadCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/events/%s/attachments/%s",
+		graph.ResolveID(c.EventID), graph.ResolveID(c.AttachmentID))
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var att Attachment
+	if err := json.Unmarshal(data, &att); err != nil {
+		return err
+	}
+
+	out := c.Out
+	if out == "" {
+		out = att.Name
+	}
+	if out == "" {
+		return fmt.Errorf("附件没有名称, 请用 --out 指定输出路径")
+	}
+
+	if err := writeFileAtomic(out, att.ContentBytes, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 下载完成: %s\n", out)
+	return nil
+}
+
+// icsEvent 是生成 iCalendar 所需的事件字段 (比 Event 多与会者)。
+type icsEvent struct {
+	Event
+	Attendees []struct {
+		EmailAddress struct {
+			Name    string `json:"name"`
+			Address string `json:"address"`
+		} `json:"emailAddress"`
+	} `json:"attendees"`
+}
+
+// eventToICS 将 Graph 事件 JSON 转换为 iCalendar 文本。
+func eventToICS(data []byte) (string, error) {
+	var ev icsEvent
+	if err := json.Unmarshal(data, &ev); err != nil {
+		return "", err
+	}
+
+	var lines []string
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//mog//Graph Export//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"BEGIN:VEVENT",
+		foldICS("UID:"+ev.ID),
+		"DTSTAMP:"+time.Now().UTC().Format("20060102T150405Z"),
+	)
+
+	start := parseGraphTime(ev.Start)
+	end := parseGraphTime(ev.End)
+	if ev.IsAllDay {
+		lines = append(lines,
+			"DTSTART;VALUE=DATE:"+start.Format("20060102"),
+			"DTEND;VALUE=DATE:"+end.Format("20060102"))
+	} else {
+		lines = append(lines,
+			"DTSTART:"+start.UTC().Format("20060102T150405Z"),
+			"DTEND:"+end.UTC().Format("20060102T150405Z"))
+	}
+
+	lines = append(lines, foldICS("SUMMARY:"+escapeICS(ev.Subject)))
+	if ev.Location != nil && ev.Location.DisplayName != "" {
+		lines = append(lines, foldICS("LOCATION:"+escapeICS(ev.Location.DisplayName)))
+	}
+	if ev.Body != nil && ev.Body.Content != "" {
+		desc := ev.Body.Content
+		if strings.EqualFold(ev.Body.ContentType, "html") {
+			desc = stripHTML(desc)
+		}
+		lines = append(lines, foldICS("DESCRIPTION:"+escapeICS(desc)))
+	}
+	if ev.Organizer != nil && ev.Organizer.EmailAddress.Address != "" {
+		lines = append(lines, foldICS(fmt.Sprintf("ORGANIZER;CN=%s:mailto:%s",
+			escapeICS(ev.Organizer.EmailAddress.Name), ev.Organizer.EmailAddress.Address)))
+	}
+	for _, a := range ev.Attendees {
+		lines = append(lines, foldICS(fmt.Sprintf("ATTENDEE;CN=%s:mailto:%s",
+			escapeICS(a.EmailAddress.Name), a.EmailAddress.Address)))
+	}
+
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n", nil
+}
+
+// escapeICS 按 RFC 5545 转义文本值。
+func escapeICS(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, ";", "\\;")
+	s = strings.ReplaceAll(s, ",", "\\,")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return s
+}
+
+// foldICS 将超过 75 字节的内容行折叠为多个续行。
+func foldICS(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+	var b strings.Builder
+	for len(line) > limit {
+		b.WriteString(line[:limit])
+		b.WriteString("\r\n ")
+		line = line[limit:]
+	}
+	b.WriteString(line)
+	return b.String()
+}
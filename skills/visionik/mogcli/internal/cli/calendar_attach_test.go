@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestCalendarAttachmentsListCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/events/ev-1/attachments", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "att-1", "name": "agenda.pdf", "size": 1234},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() {
+		err = (&CalendarAttachmentsListCmd{EventID: "ev-1"}).Run(root)
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "agenda.pdf")
+	assert.Contains(t, output, "1234 字节")
+}
+
+func TestCalendarAttachmentsDownloadCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/events/ev-1/attachments/att-1", path)
+			return mustJSON(map[string]interface{}{
+				"id": "att-1", "name": "agenda.pdf",
+				"contentBytes": []byte("hello"),
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	out := filepath.Join(t.TempDir(), "agenda.pdf")
+	cmd := &CalendarAttachmentsDownloadCmd{EventID: "ev-1", AttachmentID: "att-1", Out: out}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+}
+
+func TestEventToICS(t *testing.T) {
+	data := mustJSON(map[string]interface{}{
+		"id":      "ev-1",
+		"subject": "Team Sync; Q1, Planning",
+		"start":   map[string]string{"dateTime": "2026-03-02T10:00:00.0000000", "timeZone": "UTC"},
+		"end":     map[string]string{"dateTime": "2026-03-02T11:00:00.0000000", "timeZone": "UTC"},
+		"location": map[string]string{
+			"displayName": "Room 42",
+		},
+		"organizer": map[string]interface{}{
+			"emailAddress": map[string]string{"name": "Alice", "address": "alice@example.com"},
+		},
+		"attendees": []map[string]interface{}{
+			{"emailAddress": map[string]string{"name": "Bob", "address": "bob@example.com"}},
+		},
+	})
+
+	ics, err := eventToICS(data)
+	require.NoError(t, err)
+
+	assert.Contains(t, ics, "BEGIN:VCALENDAR")
+	assert.Contains(t, ics, "BEGIN:VEVENT")
+	assert.Contains(t, ics, "UID:ev-1")
+	assert.Contains(t, ics, `SUMMARY:Team Sync\; Q1\, Planning`)
+	assert.Contains(t, ics, "LOCATION:Room 42")
+	assert.Contains(t, ics, "ORGANIZER;CN=Alice:mailto:alice@example.com")
+	assert.Contains(t, ics, "ATTENDEE;CN=Bob:mailto:bob@example.com")
+	assert.Contains(t, ics, "END:VCALENDAR")
+	// CRLF 行结束
+	assert.Contains(t, ics, "VERSION:2.0\r\n")
+}
+
+func TestEventToICSAllDay(t *testing.T) {
+	data := mustJSON(map[string]interface{}{
+		"id":       "ev-2",
+		"subject":  "Offsite",
+		"isAllDay": true,
+		"start":    map[string]string{"dateTime": "2026-03-02T00:00:00.0000000"},
+		"end":      map[string]string{"dateTime": "2026-03-03T00:00:00.0000000"},
+	})
+
+	ics, err := eventToICS(data)
+	require.NoError(t, err)
+
+	assert.Contains(t, ics, "DTSTART;VALUE=DATE:20260302")
+	assert.Contains(t, ics, "DTEND;VALUE=DATE:20260303")
+}
+
+func TestFoldICS(t *testing.T) {
+	folded := foldICS("DESCRIPTION:" + strings.Repeat("x", 200))
+	for _, line := range strings.Split(folded, "\r\n") {
+		assert.LessOrEqual(t, len(line), 76) // 续行含前导空格
+	}
+	assert.Equal(t, "SUMMARY:short", foldICS("SUMMARY:short"))
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/visionik/mogcli/internal/config"
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// CalendarWatchCmd 使用 calendarView 增量查询输出日历变更。
+// deltaLink 持久化在配置目录中, 每次运行只输出上次之后的变化,
+// 以 JSONL 形式逐行输出 add/update/delete 事件。
+type CalendarWatchCmd struct {
+	Calendar string `help:"日历 ID (默认: primary)"`
+	Window   string `help:"初始同步窗口 (如 today..+30d)" default:"today..+30d"`
+	Follow   bool   `help:"保持运行, 按间隔轮询" short:"f"`
+	Interval int    `help:"--follow 的轮询间隔 (秒)" default:"30"`
+	Reset    bool   `help:"丢弃已保存的 deltaLink, 重新完整同步"`
+}
+
+// calendarDeltaState 是按日历持久化的增量同步状态。
+type calendarDeltaState struct {
+	DeltaLink string          `json:"deltaLink"`
+	Known     map[string]bool `json:"known"`
+}
+
+// calendarChange 是输出的单个变更事件。
+type calendarChange struct {
+	Type  string          `json:"type"`
+	Event json.RawMessage `json:"event"`
+}
+
+// Run 执行日历变更监视命令。
+func (c *CalendarWatchCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	key := c.Calendar
+	if key == "" {
+		key = "primary"
+	}
+
+	states, err := loadCalendarDeltaStates()
+	if err != nil {
+		return err
+	}
+	state := states[key]
+	if state == nil || c.Reset {
+		state = &calendarDeltaState{Known: make(map[string]bool)}
+	}
+
+	for {
+		if err := c.syncOnce(client, state); err != nil {
+			return err
+		}
+
+		states[key] = state
+		if err := saveCalendarDeltaStates(states); err != nil {
+			return err
+		}
+
+		if !c.Follow {
+			return nil
+		}
+		time.Sleep(time.Duration(c.Interval) * time.Second)
+	}
+}
+
+// syncOnce 执行一轮增量同步, 输出变更并更新 state。
+func (c *CalendarWatchCmd) syncOnce(client graph.Client, state *calendarDeltaState) error {
+	ctx := context.Background()
+
+	var path string
+	var query url.Values
+	if state.DeltaLink != "" {
+		var err error
+		path, query, err = splitGraphLink(state.DeltaLink)
+		if err != nil {
+			return err
+		}
+	} else {
+		from, to, err := parseTimeWindow(c.Window)
+		if err != nil {
+			return err
+		}
+		path = "/me/calendarView/delta"
+		if c.Calendar != "" {
+			path = fmt.Sprintf("/me/calendars/%s/calendarView/delta", graph.ResolveID(c.Calendar))
+		}
+		query = url.Values{}
+		query.Set("startDateTime", from.Format(time.RFC3339))
+		query.Set("endDateTime", to.Format(time.RFC3339))
+	}
+
+	out := json.NewEncoder(os.Stdout)
+	for {
+		data, err := client.Get(ctx, path, query)
+		if err != nil {
+			return err
+		}
+
+		var resp struct {
+			Value     []json.RawMessage `json:"value"`
+			NextLink  string            `json:"@odata.nextLink"`
+			DeltaLink string            `json:"@odata.deltaLink"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+
+		for _, raw := range resp.Value {
+			change, err := classifyDeltaItem(raw, state.Known)
+			if err != nil {
+				return err
+			}
+			if err := out.Encode(change); err != nil {
+				return err
+			}
+		}
+
+		if resp.DeltaLink != "" {
+			state.DeltaLink = resp.DeltaLink
+			return nil
+		}
+		if resp.NextLink == "" {
+			return nil
+		}
+		path, query, err = splitGraphLink(resp.NextLink)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// classifyDeltaItem 根据 @removed 标记和已知 ID 集合判断变更类型。
+func classifyDeltaItem(raw json.RawMessage, known map[string]bool) (calendarChange, error) {
+	var probe struct {
+		ID      string          `json:"id"`
+		Removed json.RawMessage `json:"@removed"`
+	}
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return calendarChange{}, err
+	}
+
+	change := calendarChange{Event: raw}
+	switch {
+	case probe.Removed != nil:
+		change.Type = "delete"
+		delete(known, probe.ID)
+	case known[probe.ID]:
+		change.Type = "update"
+	default:
+		change.Type = "add"
+		known[probe.ID] = true
+	}
+	return change, nil
+}
+
+// splitGraphLink 将绝对的 nextLink/deltaLink URL 拆成 Client.Get 可用的
+// 路径和查询参数。
+func splitGraphLink(link string) (string, url.Values, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid delta link: %w", err)
+	}
+	path := u.Path
+	if i := strings.Index(path, "/v1.0/"); i >= 0 {
+		path = path[i+len("/v1.0"):]
+	}
+	return path, u.Query(), nil
+}
+
+// calendarDeltaStatePath 返回增量状态文件的路径。
+func calendarDeltaStatePath() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "calendar_delta.json"), nil
+}
+
+// loadCalendarDeltaStates 加载所有日历的增量状态。
+func loadCalendarDeltaStates() (map[string]*calendarDeltaState, error) {
+	states := make(map[string]*calendarDeltaState)
+
+	path, err := calendarDeltaStatePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, err
+	}
+	for _, s := range states {
+		if s.Known == nil {
+			s.Known = make(map[string]bool)
+		}
+	}
+	return states, nil
+}
+
+// saveCalendarDeltaStates 持久化增量状态。
+func saveCalendarDeltaStates(states map[string]*calendarDeltaState) error {
+	path, err := calendarDeltaStatePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestClassifyDeltaItem(t *testing.T) {
+	known := make(map[string]bool)
+
+	change, err := classifyDeltaItem(json.RawMessage(`{"id":"ev-1","subject":"A"}`), known)
+	require.NoError(t, err)
+	assert.Equal(t, "add", change.Type)
+
+	change, err = classifyDeltaItem(json.RawMessage(`{"id":"ev-1","subject":"B"}`), known)
+	require.NoError(t, err)
+	assert.Equal(t, "update", change.Type)
+
+	change, err = classifyDeltaItem(json.RawMessage(`{"id":"ev-1","@removed":{"reason":"deleted"}}`), known)
+	require.NoError(t, err)
+	assert.Equal(t, "delete", change.Type)
+	assert.False(t, known["ev-1"])
+}
+
+func TestSplitGraphLink(t *testing.T) {
+	path, query, err := splitGraphLink("https://graph.microsoft.com/v1.0/me/calendarView/delta?$deltatoken=abc")
+	require.NoError(t, err)
+	assert.Equal(t, "/me/calendarView/delta", path)
+	assert.Equal(t, "abc", query.Get("$deltatoken"))
+}
+
+func TestCalendarWatchCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var gotPaths []string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPaths = append(gotPaths, path)
+			if query.Get("$deltatoken") == "" {
+				assert.NotEmpty(t, query.Get("startDateTime"))
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "ev-1", "subject": "Standup"},
+					},
+					"@odata.nextLink": "https://graph.microsoft.com/v1.0/me/calendarView/delta?$deltatoken=page2",
+				}), nil
+			}
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "ev-2", "subject": "Review"},
+				},
+				"@odata.deltaLink": "https://graph.microsoft.com/v1.0/me/calendarView/delta?$deltatoken=final",
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarWatchCmd{Window: "today..+30d"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Len(t, gotPaths, 2)
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 2)
+	var change calendarChange
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &change))
+	assert.Equal(t, "add", change.Type)
+
+	// deltaLink 已持久化, 再次运行从增量端点继续
+	states, err := loadCalendarDeltaStates()
+	require.NoError(t, err)
+	require.NotNil(t, states["primary"])
+	assert.Contains(t, states["primary"].DeltaLink, "deltatoken=final")
+	assert.True(t, states["primary"].Known["ev-1"])
+}
@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarFindTimesCmd 调用 findMeetingTimes 寻找所有与会者都有空的时段。
+type CalendarFindTimesCmd struct {
+	Attendees []string `help:"与会者电子邮件 (逗号分隔或重复)" required:""`
+	Duration  string   `help:"会议时长 (如 30m, 1h)" default:"30m"`
+	Window    string   `help:"搜索窗口 (如 today..+5d 或 2026-03-01..2026-03-05)" default:"today..+7d"`
+	Max       int      `help:"最大建议数" default:"10"`
+	Book      bool     `help:"在排名最高的时段创建事件"`
+	Summary   string   `help:"--book 时的事件标题"`
+}
+
+// meetingSuggestion 是 findMeetingTimes 返回的单个建议。
+type meetingSuggestion struct {
+	Confidence       float64 `json:"confidence"`
+	SuggestionReason string  `json:"suggestionReason"`
+	MeetingTimeSlot  struct {
+		Start *Time `json:"start"`
+		End   *Time `json:"end"`
+	} `json:"meetingTimeSlot"`
+}
+
+// Run 执行 find-times 命令。
+func (c *CalendarFindTimesCmd) Run(root *Root) error {
+	if c.Book && c.Summary == "" {
+		return fmt.Errorf("--book 需要 --summary")
+	}
+
+	duration, err := time.ParseDuration(c.Duration)
+	if err != nil {
+		return fmt.Errorf("无效的 --duration: %w", err)
+	}
+
+	from, to, err := parseTimeWindow(c.Window)
+	if err != nil {
+		return err
+	}
+
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	attendees := make([]map[string]interface{}, 0, len(c.Attendees))
+	for _, addr := range splitCommaList(c.Attendees) {
+		attendees = append(attendees, map[string]interface{}{
+			"emailAddress": map[string]string{"address": addr},
+			"type":         "required",
+		})
+	}
+
+	body := map[string]interface{}{
+		"attendees": attendees,
+		"timeConstraint": map[string]interface{}{
+			"activityDomain": "work",
+			"timeSlots": []map[string]interface{}{{
+				"start": map[string]string{
+					"dateTime": from.UTC().Format("2006-01-02T15:04:05"),
+					"timeZone": "UTC",
+				},
+				"end": map[string]string{
+					"dateTime": to.UTC().Format("2006-01-02T15:04:05"),
+					"timeZone": "UTC",
+				},
+			}},
+		},
+		"meetingDuration": isoDuration(duration),
+		"maxCandidates":   c.Max,
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, "/me/findMeetingTimes", body)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Suggestions            []meetingSuggestion `json:"meetingTimeSuggestions"`
+		EmptySuggestionsReason string              `json:"emptySuggestionsReason"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if len(resp.Suggestions) == 0 {
+		if resp.EmptySuggestionsReason != "" {
+			return fmt.Errorf("没有可用时段: %s", resp.EmptySuggestionsReason)
+		}
+		fmt.Println("没有可用时段")
+		return nil
+	}
+
+	if root.JSON && !c.Book {
+		return outputJSON(resp.Suggestions)
+	}
+
+	for i, s := range resp.Suggestions {
+		start := parseGraphTime(s.MeetingTimeSlot.Start)
+		end := parseGraphTime(s.MeetingTimeSlot.End)
+		fmt.Printf("%2d. %s - %s (置信度 %.0f%%)\n",
+			i+1,
+			start.Format("01-02 Mon 15:04"),
+			end.Format("15:04"),
+			s.Confidence*100)
+	}
+
+	if !c.Book {
+		return nil
+	}
+
+	top := resp.Suggestions[0]
+	event := map[string]interface{}{
+		"subject": c.Summary,
+		"start":   top.MeetingTimeSlot.Start,
+		"end":     top.MeetingTimeSlot.End,
+	}
+	eventAttendees := make([]map[string]interface{}, 0, len(attendees))
+	for _, a := range attendees {
+		eventAttendees = append(eventAttendees, map[string]interface{}{
+			"emailAddress": a["emailAddress"],
+			"type":         "required",
+		})
+	}
+	event["attendees"] = eventAttendees
+
+	created, err := client.Post(ctx, "/me/events", event)
+	if err != nil {
+		return err
+	}
+
+	var ev Event
+	if err := json.Unmarshal(created, &ev); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已预订排名第一的时段: %s\n", parseGraphTime(top.MeetingTimeSlot.Start).Format("01-02 15:04"))
+	return nil
+}
+
+// parseTimeWindow 解析 "start..end" 搜索窗口, 两端支持 today、+Nd、
+// YYYY-MM-DD 或 RFC3339。
+func parseTimeWindow(window string) (time.Time, time.Time, error) {
+	parts := strings.SplitN(window, "..", 2)
+	if len(parts) != 2 {
+		return time.Time{}, time.Time{}, fmt.Errorf("无效的 --window %q (格式: start..end)", window)
+	}
+
+	from, err := parseWindowEdge(parts[0])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("无效的 --window: %w", err)
+	}
+	to, err := parseWindowEdge(parts[1])
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("无效的 --window: %w", err)
+	}
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--window 结束必须晚于开始")
+	}
+	return from, to, nil
+}
+
+// parseWindowEdge 解析窗口的一端。
+func parseWindowEdge(s string) (time.Time, error) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	switch {
+	case s == "today":
+		return today, nil
+	case s == "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case strings.HasPrefix(s, "+") && strings.HasSuffix(s, "d"):
+		days, err := strconv.Atoi(s[1 : len(s)-1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("无效的相对日期 %q", s)
+		}
+		return today.AddDate(0, 0, days), nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("无法解析日期 %q", s)
+}
+
+// isoDuration 将 Go 时长转换为 Graph 需要的 ISO8601 格式 (如 PT30M)。
+func isoDuration(d time.Duration) string {
+	minutes := int(d.Minutes())
+	if minutes%60 == 0 {
+		return fmt.Sprintf("PT%dH", minutes/60)
+	}
+	return fmt.Sprintf("PT%dM", minutes)
+}
+
+// splitCommaList 展开逗号分隔的值列表。
+func splitCommaList(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, addr := range strings.Split(v, ",") {
+			addr = strings.TrimSpace(addr)
+			if addr != "" {
+				out = append(out, addr)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestParseTimeWindow(t *testing.T) {
+	from, to, err := parseTimeWindow("today..+5d")
+	require.NoError(t, err)
+	assert.Equal(t, 0, from.Hour())
+	assert.Equal(t, 5*24*time.Hour, to.Sub(from))
+
+	from, to, err = parseTimeWindow("2026-03-01..2026-03-05")
+	require.NoError(t, err)
+	assert.Equal(t, time.March, from.Month())
+	assert.Equal(t, 5, to.Day())
+
+	_, _, err = parseTimeWindow("today")
+	require.Error(t, err)
+
+	_, _, err = parseTimeWindow("+5d..today")
+	require.Error(t, err)
+}
+
+func TestIsoDuration(t *testing.T) {
+	assert.Equal(t, "PT30M", isoDuration(30*time.Minute))
+	assert.Equal(t, "PT1H", isoDuration(time.Hour))
+	assert.Equal(t, "PT90M", isoDuration(90*time.Minute))
+}
+
+func TestSplitCommaList(t *testing.T) {
+	got := splitCommaList([]string{"a@x.com,b@x.com", " c@x.com "})
+	assert.Equal(t, []string{"a@x.com", "b@x.com", "c@x.com"}, got)
+}
+
+func TestCalendarFindTimesCmd_Run(t *testing.T) {
+	var postPaths []string
+	var findBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			postPaths = append(postPaths, path)
+			if path == "/me/findMeetingTimes" {
+				findBody = body
+				return mustJSON(map[string]interface{}{
+					"meetingTimeSuggestions": []map[string]interface{}{
+						{
+							"confidence": 1.0,
+							"meetingTimeSlot": map[string]interface{}{
+								"start": map[string]string{"dateTime": "2026-03-02T10:00:00.0000000", "timeZone": "UTC"},
+								"end":   map[string]string{"dateTime": "2026-03-02T10:30:00.0000000", "timeZone": "UTC"},
+							},
+						},
+					},
+				}), nil
+			}
+			return mustJSON(map[string]string{"id": "ev-1"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarFindTimesCmd{
+		Attendees: []string{"a@x.com,b@x.com"},
+		Duration:  "30m",
+		Window:    "today..+5d",
+		Max:       10,
+	}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/me/findMeetingTimes"}, postPaths)
+	assert.Contains(t, output, "10:00")
+	assert.Contains(t, output, "置信度 100%")
+
+	body := findBody.(map[string]interface{})
+	assert.Equal(t, "PT30M", body["meetingDuration"])
+	assert.Len(t, body["attendees"], 2)
+}
+
+func TestCalendarFindTimesCmd_Book(t *testing.T) {
+	var postPaths []string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			postPaths = append(postPaths, path)
+			if path == "/me/findMeetingTimes" {
+				return mustJSON(map[string]interface{}{
+					"meetingTimeSuggestions": []map[string]interface{}{
+						{
+							"confidence": 0.9,
+							"meetingTimeSlot": map[string]interface{}{
+								"start": map[string]string{"dateTime": "2026-03-02T10:00:00.0000000"},
+								"end":   map[string]string{"dateTime": "2026-03-02T10:30:00.0000000"},
+							},
+						},
+					},
+				}), nil
+			}
+			return mustJSON(map[string]string{"id": "ev-1"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarFindTimesCmd{
+		Attendees: []string{"a@x.com"},
+		Duration:  "30m",
+		Window:    "today..+5d",
+		Max:       10,
+		Book:      true,
+		Summary:   "同步会议",
+	}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/me/findMeetingTimes", "/me/events"}, postPaths)
+	assert.Contains(t, output, "已预订")
+}
+
+func TestCalendarFindTimesCmd_BookRequiresSummary(t *testing.T) {
+	cmd := &CalendarFindTimesCmd{Attendees: []string{"a@x.com"}, Duration: "30m", Window: "today..+1d", Book: true}
+	err := cmd.Run(&Root{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--summary")
+}
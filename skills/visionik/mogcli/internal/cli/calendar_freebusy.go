@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// scheduleInfo 是 getSchedule 返回的单人忙闲数据。
+type scheduleInfo struct {
+	ScheduleID       string `json:"scheduleId"`
+	AvailabilityView string `json:"availabilityView"`
+}
+
+// freeBusySymbols 将 availabilityView 字符映射为网格单元。
+// 0=空闲 1=暂定 2=忙碌 3=外出 4=异地办公
+var freeBusySymbols = map[byte]string{
+	'0': "   ·   ",
+	'1': "  ~~~  ",
+	'2': "  ███  ",
+	'3': "  外出 ",
+	'4': "  异地 ",
+}
+
+// renderFreeBusy 渲染时段 × 与会者的忙闲网格, 并在末尾列出
+// 所有人都空闲的重叠时段。
+func renderFreeBusy(start time.Time, interval time.Duration, schedules []scheduleInfo) string {
+	var b strings.Builder
+
+	// 表头: 截短的邮箱本地部分
+	b.WriteString(fmt.Sprintf("%-13s", "时间"))
+	for _, s := range schedules {
+		b.WriteString(fmt.Sprintf("%-8s", freeBusyLabel(s.ScheduleID)))
+	}
+	b.WriteString("\n")
+
+	slots := 0
+	for _, s := range schedules {
+		if len(s.AvailabilityView) > slots {
+			slots = len(s.AvailabilityView)
+		}
+	}
+
+	lastDay := -1
+	for i := 0; i < slots; i++ {
+		t := start.Add(time.Duration(i) * interval)
+		label := t.Format("15:04")
+		if t.YearDay() != lastDay {
+			label = t.Format("01-02 15:04")
+			lastDay = t.YearDay()
+		}
+		b.WriteString(fmt.Sprintf("%-13s", label))
+		for _, s := range schedules {
+			cell := "   ?   "
+			if i < len(s.AvailabilityView) {
+				if sym, ok := freeBusySymbols[s.AvailabilityView[i]]; ok {
+					cell = sym
+				}
+			}
+			b.WriteString(fmt.Sprintf("%-8s", strings.TrimRight(cell, " ")))
+		}
+		b.WriteString("\n")
+	}
+
+	free := freeOverlaps(start, interval, slots, schedules)
+	if len(free) > 0 {
+		b.WriteString("\n共同空闲:\n")
+		for _, slot := range free {
+			b.WriteString("  " + slot + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// freeOverlaps 返回所有人都空闲的合并时段。
+func freeOverlaps(start time.Time, interval time.Duration, slots int, schedules []scheduleInfo) []string {
+	allFree := func(i int) bool {
+		for _, s := range schedules {
+			if i >= len(s.AvailabilityView) || s.AvailabilityView[i] != '0' {
+				return false
+			}
+		}
+		return len(schedules) > 0
+	}
+
+	var out []string
+	i := 0
+	for i < slots {
+		if !allFree(i) {
+			i++
+			continue
+		}
+		j := i
+		for j < slots && allFree(j) {
+			j++
+		}
+		from := start.Add(time.Duration(i) * interval)
+		to := start.Add(time.Duration(j) * interval)
+		out = append(out, fmt.Sprintf("%s - %s",
+			from.Format("01-02 15:04"), to.Format("15:04")))
+		i = j
+	}
+	return out
+}
+
+// freeBusyLabel 将邮箱缩短为适合表头的标签。
+func freeBusyLabel(email string) string {
+	if at := strings.Index(email, "@"); at > 0 {
+		email = email[:at]
+	}
+	if len(email) > 7 {
+		email = email[:7]
+	}
+	return email
+}
+
+// parseFreeBusyStart 解析 --start 的时间, 支持日期或日期时间。
+func parseFreeBusyStart(s string) time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
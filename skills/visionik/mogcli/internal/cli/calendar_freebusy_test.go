@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestRenderFreeBusy(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	schedules := []scheduleInfo{
+		{ScheduleID: "alice@example.com", AvailabilityView: "0020"},
+		{ScheduleID: "bob@example.com", AvailabilityView: "0200"},
+	}
+
+	out := renderFreeBusy(start, 30*time.Minute, schedules)
+
+	assert.Contains(t, out, "alice")
+	assert.Contains(t, out, "bob")
+	assert.Contains(t, out, "03-02 09:00")
+	assert.Contains(t, out, "███")
+	// 只有第一段和最后一段双方都空闲
+	assert.Contains(t, out, "共同空闲:")
+	assert.Contains(t, out, "03-02 09:00 - 09:30")
+	assert.Contains(t, out, "03-02 10:30 - 11:00")
+}
+
+func TestFreeOverlapsMergesRuns(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	schedules := []scheduleInfo{
+		{ScheduleID: "a@x", AvailabilityView: "0022"},
+		{ScheduleID: "b@x", AvailabilityView: "0002"},
+	}
+
+	got := freeOverlaps(start, 30*time.Minute, 4, schedules)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "03-02 09:00 - 10:00", got[0])
+}
+
+func TestCalendarFreeBusyCmd_RendersGrid(t *testing.T) {
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/calendar/getSchedule", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]string{
+					{"scheduleId": "alice@example.com", "availabilityView": "02"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarFreeBusyCmd{
+		Emails: []string{"alice@example.com"},
+		Start:  "2026-03-02T09:00:00",
+		End:    "2026-03-02T10:00:00",
+	}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "alice")
+	assert.Contains(t, output, "03-02 09:00")
+	assert.Contains(t, output, "███")
+}
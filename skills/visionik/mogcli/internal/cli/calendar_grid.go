@@ -0,0 +1,254 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// CalendarMonthCmd 以月历网格显示每天的事件数量。
+type CalendarMonthCmd struct {
+	Calendar      string `help:"日历 ID (默认: primary)"`
+	CalendarOwner string `help:"以委托人身份操作该用户的日历 (user@domain)" name:"calendar-owner"`
+	Month         string `help:"要显示的月份 (YYYY-MM, 默认当月)"`
+}
+
+// Run 执行月历视图命令。
+func (c *CalendarMonthCmd) Run(root *Root) error {
+	month := time.Now()
+	if c.Month != "" {
+		var err error
+		month, err = time.Parse("2006-01", c.Month)
+		if err != nil {
+			return fmt.Errorf("无效的 --month (应为 YYYY-MM): %w", err)
+		}
+	}
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+	next := first.AddDate(0, 1, 0)
+
+	events, err := fetchCalendarView(root, c.Calendar, c.CalendarOwner, first, next)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[int]int)
+	for _, e := range events {
+		day := parseGraphTime(e.Start)
+		if day.IsZero() {
+			continue
+		}
+		if day.Month() == first.Month() && day.Year() == first.Year() {
+			counts[day.Day()]++
+		}
+	}
+
+	fmt.Print(renderMonthGrid(first, counts, time.Now()))
+	return nil
+}
+
+// CalendarWeekCmd 显示本周事件, --grid 渲染按小时分块的网格。
+type CalendarWeekCmd struct {
+	Calendar      string `help:"日历 ID (默认: primary)"`
+	CalendarOwner string `help:"以委托人身份操作该用户的日历 (user@domain)" name:"calendar-owner"`
+	Grid          bool   `help:"以按小时分块的网格视图显示"`
+}
+
+// Run 执行本周事件命令。
+func (c *CalendarWeekCmd) Run(root *Root) error {
+	if !c.Grid {
+		list := &CalendarListCmd{
+			Calendar:      c.Calendar,
+			CalendarOwner: c.CalendarOwner,
+			From:          time.Now().Format("2006-01-02"),
+			To:            time.Now().AddDate(0, 0, 7).Format("2006-01-02"),
+			Max:           100,
+		}
+		return list.Run(root)
+	}
+
+	start := time.Now()
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 0, 7)
+
+	events, err := fetchCalendarView(root, c.Calendar, c.CalendarOwner, start, end)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(renderWeekGrid(start, events))
+	return nil
+}
+
+// fetchCalendarView 获取时间范围内的 calendarView 事件。
+func fetchCalendarView(root *Root, calendar, owner string, from, to time.Time) ([]Event, error) {
+	client, err := root.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$top", "999")
+	query.Set("$orderby", "start/dateTime")
+	query.Set("startDateTime", from.Format(time.RFC3339))
+	query.Set("endDateTime", to.Format(time.RFC3339))
+	query.Set("$select", "id,subject,start,end,isAllDay")
+
+	base := calendarBasePath(owner)
+	path := base + "/calendarView"
+	if calendar != "" {
+		path = fmt.Sprintf("%s/calendars/%s/calendarView", base, graph.ResolveID(calendar))
+	}
+
+	data, err := client.Get(ctx, path, query)
+	if err != nil {
+		return nil, wrapCalendarOwnerErr(err, owner)
+	}
+
+	var resp struct {
+		Value []Event `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// parseGraphTime 解析 Graph 的 dateTime 字符串为本地时间。
+// 解析失败时返回零值。
+func parseGraphTime(t *Time) time.Time {
+	if t == nil || t.DateTime == "" {
+		return time.Time{}
+	}
+	dt, _, _ := strings.Cut(t.DateTime, ".")
+	parsed, err := time.ParseInLocation("2006-01-02T15:04:05", dt, time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
+
+// renderMonthGrid 渲染月历网格, 每天标注事件数量 (*N),
+// 今天以方括号标出。
+func renderMonthGrid(first time.Time, counts map[int]int, now time.Time) string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("        %s\n", first.Format("2006-01")))
+	for _, h := range []string{"一", "二", "三", "四", "五", "六", "日"} {
+		b.WriteString("    " + h)
+	}
+	b.WriteString("\n")
+
+	// 周一作为每周的第一天
+	offset := (int(first.Weekday()) + 6) % 7
+	for i := 0; i < offset; i++ {
+		b.WriteString("      ")
+	}
+
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+	isThisMonth := now.Year() == first.Year() && now.Month() == first.Month()
+
+	for day := 1; day <= daysInMonth; day++ {
+		num := fmt.Sprintf("%d", day)
+		if isThisMonth && day == now.Day() {
+			num = "[" + num + "]"
+		}
+
+		suffix := "  "
+		if n := counts[day]; n > 9 {
+			suffix = "*+"
+		} else if n > 0 {
+			suffix = fmt.Sprintf("*%d", n)
+		}
+
+		b.WriteString(fmt.Sprintf("%4s%s", num, suffix))
+		if (offset+day)%7 == 0 {
+			b.WriteString("\n")
+		}
+	}
+	if (offset+daysInMonth)%7 != 0 {
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// renderWeekGrid 渲染按小时分块的一周视图。行是小时, 列是
+// 从 start 开始的七天; 忙碌的时段以方块标出, 全天事件单独列出。
+func renderWeekGrid(start time.Time, events []Event) string {
+	end := start.AddDate(0, 0, 7)
+
+	var busy [7][24]int
+	var allDay []string
+	for _, e := range events {
+		if e.IsAllDay {
+			if d := parseGraphTime(e.Start); !d.IsZero() {
+				allDay = append(allDay, fmt.Sprintf("%s %s", d.Format("01/02"), e.Subject))
+			}
+			continue
+		}
+		from := parseGraphTime(e.Start)
+		to := parseGraphTime(e.End)
+		if from.IsZero() || to.IsZero() {
+			continue
+		}
+		for t := from.Truncate(time.Hour); t.Before(to); t = t.Add(time.Hour) {
+			if t.Before(start) || !t.Before(end) {
+				continue
+			}
+			day := int(t.Sub(start).Hours()) / 24
+			busy[day][t.Hour()]++
+		}
+	}
+
+	// 默认显示工作时间, 有事件时扩展范围
+	minHour, maxHour := 8, 18
+	for d := 0; d < 7; d++ {
+		for h := 0; h < 24; h++ {
+			if busy[d][h] > 0 {
+				if h < minHour {
+					minHour = h
+				}
+				if h+1 > maxHour {
+					maxHour = h + 1
+				}
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("      ")
+	for d := 0; d < 7; d++ {
+		b.WriteString(fmt.Sprintf(" %s ", start.AddDate(0, 0, d).Format("01/02")))
+	}
+	b.WriteString("\n")
+
+	for h := minHour; h < maxHour; h++ {
+		b.WriteString(fmt.Sprintf("%02d:00 ", h))
+		for d := 0; d < 7; d++ {
+			switch n := busy[d][h]; {
+			case n == 0:
+				b.WriteString("   ·   ")
+			case n == 1:
+				b.WriteString("  ███  ")
+			default:
+				if n > 9 {
+					n = 9
+				}
+				b.WriteString(fmt.Sprintf("  █%d█  ", n))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(allDay) > 0 {
+		b.WriteString("全天: " + strings.Join(allDay, "; ") + "\n")
+	}
+
+	return b.String()
+}
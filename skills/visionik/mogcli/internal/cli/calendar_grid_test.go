@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestParseGraphTime(t *testing.T) {
+	got := parseGraphTime(&Time{DateTime: "2026-02-17T10:30:00.0000000", TimeZone: "UTC"})
+	assert.Equal(t, 2026, got.Year())
+	assert.Equal(t, 10, got.Hour())
+
+	assert.True(t, parseGraphTime(nil).IsZero())
+	assert.True(t, parseGraphTime(&Time{DateTime: "bogus"}).IsZero())
+}
+
+func TestRenderMonthGrid(t *testing.T) {
+	first := time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local)
+	counts := map[int]int{3: 2, 14: 1}
+	now := time.Date(2026, 2, 14, 12, 0, 0, 0, time.Local)
+
+	out := renderMonthGrid(first, counts, now)
+
+	assert.Contains(t, out, "2026-02")
+	assert.Contains(t, out, "3*2")
+	assert.Contains(t, out, "[14]*1")
+	// 2026-02-01 是周日, 第一行应有六个空位
+	assert.Contains(t, out, "一")
+}
+
+func TestRenderWeekGrid(t *testing.T) {
+	start := time.Date(2026, 2, 16, 0, 0, 0, 0, time.Local)
+	events := []Event{
+		{
+			Subject: "Standup",
+			Start:   &Time{DateTime: "2026-02-16T09:00:00.0000000"},
+			End:     &Time{DateTime: "2026-02-16T10:00:00.0000000"},
+		},
+		{
+			Subject:  "Offsite",
+			IsAllDay: true,
+			Start:    &Time{DateTime: "2026-02-18T00:00:00.0000000"},
+		},
+	}
+
+	out := renderWeekGrid(start, events)
+
+	assert.Contains(t, out, "09:00")
+	assert.Contains(t, out, "███")
+	assert.Contains(t, out, "全天: 02/18 Offsite")
+}
+
+func TestCalendarMonthCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Contains(t, path, "/calendarView")
+			assert.NotEmpty(t, query.Get("startDateTime"))
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id":      "ev-1",
+						"subject": "Review",
+						"start":   map[string]string{"dateTime": "2026-02-05T10:00:00.0000000"},
+						"end":     map[string]string{"dateTime": "2026-02-05T11:00:00.0000000"},
+					},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarMonthCmd{Month: "2026-02"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "2026-02")
+	assert.Contains(t, output, "5*1")
+}
@@ -0,0 +1,356 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/config"
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// CalendarCalendarsCmd 管理日历: 列出、创建、删除以及添加同事的共享日历。
+type CalendarCalendarsCmd struct {
+	List      CalendarCalendarsListCmd      `cmd:"" default:"1" help:"列出日历"`
+	Create    CalendarCalendarsCreateCmd    `cmd:"" help:"创建日历"`
+	Delete    CalendarCalendarsDeleteCmd    `cmd:"" help:"删除日历"`
+	AddShared CalendarCalendarsAddSharedCmd `cmd:"" name:"add-shared" help:"添加同事的共享日历"`
+}
+
+// CalendarCalendarsListCmd 列出日历。
+type CalendarCalendarsListCmd struct {
+	CalendarOwner string `help:"以委托人身份列出该用户的日历 (user@domain)" name:"calendar-owner"`
+}
+
+// Run 执行日历列表命令。
+func (c *CalendarCalendarsListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, calendarBasePath(c.CalendarOwner)+"/calendars", nil)
+	if err != nil {
+		return wrapCalendarOwnerErr(err, c.CalendarOwner)
+	}
+
+	var resp struct {
+		Value []Calendar `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, cal := range resp.Value {
+		marker := " "
+		if cal.IsDefaultCalendar {
+			marker = "*"
+		}
+		fmt.Printf("%s %-30s %s\n", marker, cal.Name, graph.FormatID(cal.ID))
+	}
+
+	if c.CalendarOwner == "" {
+		shared, err := loadSharedCalendars()
+		if err != nil {
+			return err
+		}
+		if len(shared) > 0 {
+			fmt.Println()
+			fmt.Println("共享日历 (通过 --calendar-owner 访问):")
+			for _, email := range shared {
+				fmt.Printf("  %s\n", email)
+			}
+		}
+	}
+	return nil
+}
+
+// CalendarCalendarsCreateCmd 创建日历。
+type CalendarCalendarsCreateCmd struct {
+	Name string `arg:"" help:"日历名称"`
+}
+
+// Run 执行日历创建命令。
+func (c *CalendarCalendarsCreateCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, "/me/calendars", map[string]string{"name": c.Name})
+	if err != nil {
+		return err
+	}
+
+	var cal Calendar
+	if err := json.Unmarshal(data, &cal); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已创建日历: %s (%s)\n", cal.Name, graph.FormatID(cal.ID))
+	return nil
+}
+
+// CalendarCalendarsDeleteCmd 删除日历。参数为之前通过 add-shared
+// 添加的邮箱时, 仅从本地共享列表移除, 不调用 API。
+type CalendarCalendarsDeleteCmd struct {
+	Calendar string `arg:"" help:"日历 ID 或共享日历邮箱"`
+}
+
+// Run 执行日历删除命令。
+func (c *CalendarCalendarsDeleteCmd) Run(root *Root) error {
+	shared, err := loadSharedCalendars()
+	if err != nil {
+		return err
+	}
+	for i, email := range shared {
+		if strings.EqualFold(email, c.Calendar) {
+			shared = append(shared[:i], shared[i+1:]...)
+			if err := saveSharedCalendars(shared); err != nil {
+				return err
+			}
+			fmt.Printf("✓ 已移除共享日历: %s\n", email)
+			return nil
+		}
+	}
+
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/calendars/%s", graph.ResolveID(c.Calendar))
+	if err := client.Delete(ctx, path); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 日历删除成功")
+	return nil
+}
+
+// CalendarCalendarsAddSharedCmd 添加同事的共享日历。先验证对该用户
+// 日历的访问权限, 然后记入本地共享列表, 之后可通过 --calendar-owner
+// 在 list/week/create 等命令中使用。
+type CalendarCalendarsAddSharedCmd struct {
+	Email string `arg:"" help:"同事邮箱 (user@domain)"`
+}
+
+// Run 执行共享日历添加命令。
+func (c *CalendarCalendarsAddSharedCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, calendarBasePath(c.Email)+"/calendar", nil)
+	if err != nil {
+		return wrapCalendarOwnerErr(err, c.Email)
+	}
+
+	var cal Calendar
+	if err := json.Unmarshal(data, &cal); err != nil {
+		return err
+	}
+
+	shared, err := loadSharedCalendars()
+	if err != nil {
+		return err
+	}
+	for _, email := range shared {
+		if strings.EqualFold(email, c.Email) {
+			fmt.Printf("共享日历已存在: %s\n", c.Email)
+			return nil
+		}
+	}
+	shared = append(shared, c.Email)
+	if err := saveSharedCalendars(shared); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已添加共享日历: %s (%s)\n", c.Email, cal.Name)
+	fmt.Printf("  使用 --calendar-owner %s 访问\n", c.Email)
+	return nil
+}
+
+// CalendarGroupsCmd 列出日历组。
+type CalendarGroupsCmd struct{}
+
+// Run 执行日历组列表命令。
+func (c *CalendarGroupsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/calendarGroups", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, group := range resp.Value {
+		fmt.Printf("%-30s %s\n", group.Name, graph.FormatID(group.ID))
+	}
+	return nil
+}
+
+// CalendarACLCmd 管理日历权限: 列出、授予和撤销。
+type CalendarACLCmd struct {
+	List   CalendarACLListCmd   `cmd:"" default:"withargs" help:"列出日历权限"`
+	Grant  CalendarACLGrantCmd  `cmd:"" help:"授予用户日历权限"`
+	Revoke CalendarACLRevokeCmd `cmd:"" help:"撤销用户日历权限"`
+}
+
+// CalendarACLGrantCmd 授予用户日历权限。
+type CalendarACLGrantCmd struct {
+	Email    string `arg:"" help:"用户邮箱"`
+	Role     string `help:"权限角色 (freeBusyRead, limitedRead, read, write)" default:"read"`
+	Calendar string `help:"日历 ID (默认: primary)"`
+}
+
+// Run 执行日历权限授予命令。
+func (c *CalendarACLGrantCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"emailAddress": map[string]string{"address": c.Email},
+		"role":         c.Role,
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, calendarPermissionsPath(c.Calendar), body)
+	if err != nil {
+		return err
+	}
+
+	var perm CalendarPermission
+	if err := json.Unmarshal(data, &perm); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已授予 %s 权限: %s\n", c.Email, perm.Role)
+	return nil
+}
+
+// CalendarACLRevokeCmd 撤销用户日历权限。
+type CalendarACLRevokeCmd struct {
+	Email    string `arg:"" help:"用户邮箱或权限 ID"`
+	Calendar string `help:"日历 ID (默认: primary)"`
+}
+
+// Run 执行日历权限撤销命令。
+func (c *CalendarACLRevokeCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	base := calendarPermissionsPath(c.Calendar)
+
+	// 按邮箱查找权限条目, 找不到时把参数当作权限 ID 使用。
+	permID := c.Email
+	data, err := client.Get(ctx, base, nil)
+	if err == nil {
+		var resp struct {
+			Value []CalendarPermission `json:"value"`
+		}
+		if err := json.Unmarshal(data, &resp); err == nil {
+			for _, perm := range resp.Value {
+				if perm.EmailAddress != nil && strings.EqualFold(perm.EmailAddress.Address, c.Email) {
+					permID = perm.ID
+					break
+				}
+			}
+		}
+	}
+
+	if err := client.Delete(ctx, base+"/"+url.PathEscape(permID)); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已撤销 %s 的权限\n", c.Email)
+	return nil
+}
+
+// calendarPermissionsPath 返回日历权限集合的 Graph 路径。
+func calendarPermissionsPath(calendar string) string {
+	if calendar == "" {
+		return "/me/calendar/calendarPermissions"
+	}
+	return fmt.Sprintf("/me/calendars/%s/calendarPermissions", graph.ResolveID(calendar))
+}
+
+// sharedCalendarsPath 返回本地共享日历列表文件的路径。
+func sharedCalendarsPath() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "shared_calendars.json"), nil
+}
+
+// loadSharedCalendars 加载已添加的共享日历邮箱列表。
+func loadSharedCalendars() ([]string, error) {
+	path, err := sharedCalendarsPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var shared []string
+	if err := json.Unmarshal(data, &shared); err != nil {
+		return nil, err
+	}
+	return shared, nil
+}
+
+// saveSharedCalendars 持久化共享日历邮箱列表。
+func saveSharedCalendars(shared []string) error {
+	path, err := sharedCalendarsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(shared)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestCalendarCalendarsCreateCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return mustJSON(map[string]string{"id": "cal-new", "name": "团队"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&CalendarCalendarsCreateCmd{Name: "团队"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/calendars", gotPath)
+	assert.Equal(t, map[string]string{"name": "团队"}, gotBody)
+	assert.Contains(t, output, "已创建日历")
+}
+
+func TestCalendarCalendarsDeleteCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var gotPath string
+	mock := &testutil.MockClient{
+		DeleteFunc: func(ctx context.Context, path string) error {
+			gotPath = path
+			return nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&CalendarCalendarsDeleteCmd{Calendar: "cal-123"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/calendars/cal-123", gotPath)
+	assert.Contains(t, output, "删除成功")
+}
+
+func TestCalendarCalendarsAddSharedCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var gotPath string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPath = path
+			return mustJSON(map[string]string{"id": "cal-1", "name": "Calendar"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&CalendarCalendarsAddSharedCmd{Email: "alice@example.com"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/users/alice@example.com/calendar", gotPath)
+	assert.Contains(t, output, "已添加共享日历")
+
+	shared, err := loadSharedCalendars()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com"}, shared)
+
+	// 重复添加不产生重复条目
+	captureOutput(func() { err = (&CalendarCalendarsAddSharedCmd{Email: "Alice@example.com"}).Run(root) })
+	require.NoError(t, err)
+	shared, err = loadSharedCalendars()
+	require.NoError(t, err)
+	assert.Len(t, shared, 1)
+}
+
+func TestCalendarCalendarsDeleteCmd_RemovesShared(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	require.NoError(t, saveSharedCalendars([]string{"alice@example.com"}))
+
+	root := &Root{ClientFactory: mockClientFactory(&testutil.MockClient{})}
+
+	var err error
+	output := captureOutput(func() { err = (&CalendarCalendarsDeleteCmd{Calendar: "alice@example.com"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "已移除共享日历")
+
+	shared, err := loadSharedCalendars()
+	require.NoError(t, err)
+	assert.Empty(t, shared)
+}
+
+func TestCalendarGroupsCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/calendarGroups", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]string{
+					{"id": "grp-1", "name": "My Calendars"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&CalendarGroupsCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "My Calendars")
+}
+
+func TestCalendarACLGrantCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return mustJSON(map[string]string{"id": "perm-1", "role": "write"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarACLGrantCmd{Email: "bob@example.com", Role: "write"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/calendar/calendarPermissions", gotPath)
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, "write", body["role"])
+	assert.Equal(t, map[string]string{"address": "bob@example.com"}, body["emailAddress"])
+	assert.Contains(t, output, "已授予")
+}
+
+func TestCalendarACLRevokeCmd_Run(t *testing.T) {
+	var deletedPath string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id":           "perm-9",
+						"role":         "read",
+						"emailAddress": map[string]string{"address": "bob@example.com"},
+					},
+				},
+			}), nil
+		},
+		DeleteFunc: func(ctx context.Context, path string) error {
+			deletedPath = path
+			return nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarACLRevokeCmd{Email: "bob@example.com", Calendar: "cal-123"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/calendars/cal-123/calendarPermissions/perm-9", deletedPath)
+	assert.Contains(t, output, "已撤销")
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// CalendarSettingsCmd 管理邮箱的时区和工作时间设置。
+type CalendarSettingsCmd struct {
+	Get CalendarSettingsGetCmd `cmd:"" help:"显示时区和工作时间"`
+	Set CalendarSettingsSetCmd `cmd:"" help:"更新时区和工作时间"`
+}
+
+// mailboxWorkingHours 对应 mailboxSettings.workingHours。
+type mailboxWorkingHours struct {
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+	StartTime  string   `json:"startTime,omitempty"`
+	EndTime    string   `json:"endTime,omitempty"`
+	TimeZone   *struct {
+		Name string `json:"name"`
+	} `json:"timeZone,omitempty"`
+}
+
+// CalendarSettingsGetCmd 显示时区和工作时间。
+type CalendarSettingsGetCmd struct{}
+
+// Run 执行 settings get 命令。
+func (c *CalendarSettingsGetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$select", "timeZone,workingHours")
+	data, err := client.Get(ctx, "/me/mailboxSettings", query)
+	if err != nil {
+		return err
+	}
+
+	var settings struct {
+		TimeZone     string               `json:"timeZone"`
+		WorkingHours *mailboxWorkingHours `json:"workingHours"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(settings)
+	}
+
+	fmt.Printf("时区: %s\n", settings.TimeZone)
+	if wh := settings.WorkingHours; wh != nil {
+		fmt.Printf("工作日: %s\n", strings.Join(wh.DaysOfWeek, ", "))
+		fmt.Printf("工作时间: %s - %s\n", wh.StartTime, wh.EndTime)
+		if wh.TimeZone != nil {
+			fmt.Printf("工作时间时区: %s\n", wh.TimeZone.Name)
+		}
+	}
+	return nil
+}
+
+// CalendarSettingsSetCmd 更新时区和工作时间。
+type CalendarSettingsSetCmd struct {
+	Timezone string   `help:"邮箱时区 (如 'Pacific Standard Time')"`
+	Days     []string `help:"工作日 (如 monday,tuesday)"`
+	Start    string   `help:"工作开始时间 (HH:MM)"`
+	End      string   `help:"工作结束时间 (HH:MM)"`
+}
+
+// Run 执行 settings set 命令。
+func (c *CalendarSettingsSetCmd) Run(root *Root) error {
+	updates := make(map[string]interface{})
+
+	if c.Timezone != "" {
+		updates["timeZone"] = c.Timezone
+	}
+
+	wh := make(map[string]interface{})
+	if len(c.Days) > 0 {
+		wh["daysOfWeek"] = splitCommaList(c.Days)
+	}
+	if c.Start != "" {
+		wh["startTime"] = c.Start + ":00.0000000"
+	}
+	if c.End != "" {
+		wh["endTime"] = c.End + ":00.0000000"
+	}
+	if len(wh) > 0 {
+		updates["workingHours"] = wh
+	}
+
+	if len(updates) == 0 {
+		return fmt.Errorf("未指定更新内容。使用 --timezone、--days、--start 或 --end")
+	}
+
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if _, err := client.Patch(ctx, "/me/mailboxSettings", updates); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 已更新日历设置")
+	return nil
+}
+
+// mailboxTimeZone 返回用户邮箱配置的时区, 获取失败时回退到 UTC,
+// 用于 create/update 等请求体中的 timeZone 字段。
+func mailboxTimeZone(client graph.Client) string {
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$select", "timeZone")
+	data, err := client.Get(ctx, "/me/mailboxSettings", query)
+	if err != nil {
+		return "UTC"
+	}
+
+	var settings struct {
+		TimeZone string `json:"timeZone"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil || settings.TimeZone == "" {
+		return "UTC"
+	}
+	return settings.TimeZone
+}
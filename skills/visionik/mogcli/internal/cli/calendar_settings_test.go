@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestCalendarSettingsGetCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/mailboxSettings", path)
+			assert.Equal(t, "timeZone,workingHours", query.Get("$select"))
+			return mustJSON(map[string]interface{}{
+				"timeZone": "China Standard Time",
+				"workingHours": map[string]interface{}{
+					"daysOfWeek": []string{"monday", "tuesday"},
+					"startTime":  "09:00:00.0000000",
+					"endTime":    "18:00:00.0000000",
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&CalendarSettingsGetCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "China Standard Time")
+	assert.Contains(t, output, "monday, tuesday")
+}
+
+func TestCalendarSettingsSetCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/mailboxSettings", path)
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarSettingsSetCmd{
+		Timezone: "China Standard Time",
+		Days:     []string{"monday,friday"},
+		Start:    "09:30",
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, "China Standard Time", body["timeZone"])
+	wh := body["workingHours"].(map[string]interface{})
+	assert.Equal(t, []string{"monday", "friday"}, wh["daysOfWeek"])
+	assert.Equal(t, "09:30:00.0000000", wh["startTime"])
+}
+
+func TestCalendarSettingsSetCmd_RequiresFlags(t *testing.T) {
+	err := (&CalendarSettingsSetCmd{}).Run(&Root{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "未指定更新内容")
+}
+
+func TestMailboxTimeZoneFallback(t *testing.T) {
+	mock := &testutil.MockClient{}
+	assert.Equal(t, "UTC", mailboxTimeZone(mock))
+
+	mock = &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]string{"timeZone": "Tokyo Standard Time"}), nil
+		},
+	}
+	assert.Equal(t, "Tokyo Standard Time", mailboxTimeZone(mock))
+}
+
+func TestCalendarCreateUsesMailboxTimeZone(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]string{"timeZone": "China Standard Time"}), nil
+		},
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotBody = body
+			return mustJSON(map[string]string{"id": "ev-1", "subject": "Sync"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarCreateCmd{
+		Summary: "Sync",
+		From:    "2026-03-02T10:00:00",
+		To:      "2026-03-02T11:00:00",
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	start := gotBody.(map[string]interface{})["start"].(map[string]string)
+	assert.Equal(t, "China Standard Time", start["timeZone"])
+}
@@ -426,7 +426,7 @@ func TestCalendarDeleteCmd_Run(t *testing.T) {
 	}
 }
 
-func TestCalendarCalendarsCmd_Run(t *testing.T) {
+func TestCalendarCalendarsListCmd_Run(t *testing.T) {
 	tests := []struct {
 		name      string
 		root      *Root
@@ -481,7 +481,7 @@ func TestCalendarCalendarsCmd_Run(t *testing.T) {
 			}
 			tt.root.ClientFactory = mockClientFactory(mock)
 
-			cmd := &CalendarCalendarsCmd{}
+			cmd := &CalendarCalendarsListCmd{}
 			var output string
 			err := error(nil)
 			output = captureOutput(func() {
@@ -644,10 +644,10 @@ func TestCalendarFreeBusyCmd_Run(t *testing.T) {
 	}
 }
 
-func TestCalendarACLCmd_Run(t *testing.T) {
+func TestCalendarACLListCmd_Run(t *testing.T) {
 	tests := []struct {
 		name      string
-		cmd       *CalendarACLCmd
+		cmd       *CalendarACLListCmd
 		root      *Root
 		mockResp  []byte
 		mockErr   error
@@ -656,7 +656,7 @@ func TestCalendarACLCmd_Run(t *testing.T) {
 	}{
 		{
 			name: "successful list permissions",
-			cmd:  &CalendarACLCmd{},
+			cmd:  &CalendarACLListCmd{},
 			root: &Root{},
 			mockResp: mustJSON(map[string]interface{}{
 				"value": []map[string]interface{}{
@@ -671,20 +671,20 @@ func TestCalendarACLCmd_Run(t *testing.T) {
 					},
 				},
 			}),
-			wantInOut: "Calendar Permissions",
+			wantInOut: "日历权限",
 		},
 		{
 			name: "list permissions with specific calendar",
-			cmd:  &CalendarACLCmd{Calendar: "cal-123"},
+			cmd:  &CalendarACLListCmd{Calendar: "cal-123"},
 			root: &Root{},
 			mockResp: mustJSON(map[string]interface{}{
 				"value": []map[string]interface{}{},
 			}),
-			wantInOut: "No permissions found",
+			wantInOut: "未找到权限",
 		},
 		{
 			name: "JSON output",
-			cmd:  &CalendarACLCmd{},
+			cmd:  &CalendarACLListCmd{},
 			root: &Root{JSON: true},
 			mockResp: mustJSON(map[string]interface{}{
 				"value": []map[string]interface{}{
@@ -695,7 +695,7 @@ func TestCalendarACLCmd_Run(t *testing.T) {
 		},
 		{
 			name:    "API error",
-			cmd:     &CalendarACLCmd{},
+			cmd:     &CalendarACLListCmd{},
 			root:    &Root{},
 			mockErr: errors.New("API error"),
 			wantErr: true,
@@ -845,10 +845,64 @@ func TestPrintEventDetail(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		printEventDetail(event, false)
+		printEventDetail(event, false, "text")
 	})
 
 	assert.Contains(t, output, "Team Meeting")
 	assert.Contains(t, output, "Room A")
 	assert.Contains(t, output, "Organizer")
 }
+
+func TestCalendarCancelCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath, gotBody = path, body
+			return []byte(`{}`), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarCancelCmd{ID: "event-123", Comment: "moving it"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/events/event-123/cancel", gotPath)
+	assert.Equal(t, "moving it", gotBody.(map[string]interface{})["comment"])
+	assert.Contains(t, output, "已取消事件")
+}
+
+func TestCalendarProposeCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath, gotBody = path, body
+			return []byte(`{}`), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &CalendarProposeCmd{
+		ID:    "event-123",
+		Start: "2026-03-02T10:00:00",
+		End:   "2026-03-02T11:00:00",
+	}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/events/event-123/tentativelyAccept", gotPath)
+
+	body := gotBody.(map[string]interface{})
+	proposed := body["proposedNewTime"].(map[string]interface{})
+	start := proposed["start"].(map[string]string)
+	assert.Equal(t, "2026-03-02T10:00:00", start["dateTime"])
+	assert.Contains(t, output, "已提议新时间")
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
 
 	"github.com/visionik/mogcli/internal/graph"
+	"github.com/visionik/mogcli/internal/output"
 )
 
 // ContactsCmd 处理联系人操作命令。
@@ -18,11 +20,13 @@ type ContactsCmd struct {
 	Update    ContactsUpdateCmd    `cmd:"" help:"更新联系人"`
 	Delete    ContactsDeleteCmd    `cmd:"" help:"删除联系人"`
 	Directory ContactsDirectoryCmd `cmd:"" help:"搜索组织目录"`
+	Folders   ContactsFoldersCmd   `cmd:"" help:"联系人文件夹 (列出/创建)"`
 }
 
 // ContactsListCmd 列出联系人。
 type ContactsListCmd struct {
-	Max int `help:"最大结果数" default:"50"`
+	Max    int    `help:"最大结果数" default:"50"`
+	Folder string `help:"联系人文件夹ID"`
 }
 
 // Run 执行联系人列表命令。
@@ -36,8 +40,9 @@ func (c *ContactsListCmd) Run(root *Root) error {
 	query := url.Values{}
 	query.Set("$top", fmt.Sprintf("%d", c.Max))
 	query.Set("$orderby", "displayName")
+	query = applySelect(query, root, "id,displayName,emailAddresses")
 
-	data, err := client.Get(ctx, "/me/contacts", query)
+	data, err := client.Get(ctx, contactsBasePath(c.Folder), query)
 	if err != nil {
 		return err
 	}
@@ -53,12 +58,24 @@ func (c *ContactsListCmd) Run(root *Root) error {
 		return outputJSON(resp.Value)
 	}
 
+	if root.Plain {
+		t := output.NewTable("id", "name", "email")
+		for _, c := range resp.Value {
+			email := ""
+			if len(c.EmailAddresses) > 0 {
+				email = c.EmailAddresses[0].Address
+			}
+			t.Row(graph.FormatTypedID("ct", c.ID), c.DisplayName, email)
+		}
+		return t.Render(os.Stdout, true)
+	}
+
 	for _, c := range resp.Value {
 		email := ""
 		if len(c.EmailAddresses) > 0 {
 			email = c.EmailAddresses[0].Address
 		}
-		fmt.Printf("%-30s %-30s %s\n", c.DisplayName, email, graph.FormatID(c.ID))
+		fmt.Printf("%-30s %-30s %s\n", c.DisplayName, email, graph.FormatTypedID("ct", c.ID))
 	}
 	return nil
 }
@@ -78,6 +95,7 @@ func (c *ContactsSearchCmd) Run(root *Root) error {
 	ctx := context.Background()
 	query := url.Values{}
 	query.Set("$filter", fmt.Sprintf("contains(displayName,'%s') or contains(emailAddresses/any(a:a/address),'%s')", c.Query, c.Query))
+	query = applySelect(query, root, "id,displayName,emailAddresses")
 
 	data, err := client.Get(ctx, "/me/contacts", query)
 	if err != nil {
@@ -100,7 +118,7 @@ func (c *ContactsSearchCmd) Run(root *Root) error {
 		if len(c.EmailAddresses) > 0 {
 			email = c.EmailAddresses[0].Address
 		}
-		fmt.Printf("%-30s %-30s %s\n", c.DisplayName, email, graph.FormatID(c.ID))
+		fmt.Printf("%-30s %-30s %s\n", c.DisplayName, email, graph.FormatTypedID("ct", c.ID))
 	}
 	return nil
 }
@@ -120,7 +138,8 @@ func (c *ContactsGetCmd) Run(root *Root) error {
 	ctx := context.Background()
 	path := fmt.Sprintf("/me/contacts/%s", graph.ResolveID(c.ID))
 
-	data, err := client.Get(ctx, path, nil)
+	query := applySelect(nil, root, "id,displayName,emailAddresses,businessPhones,mobilePhone,companyName,jobTitle")
+	data, err := client.Get(ctx, path, query)
 	if err != nil {
 		return err
 	}
@@ -134,7 +153,7 @@ func (c *ContactsGetCmd) Run(root *Root) error {
 		return outputJSON(contact)
 	}
 
-	fmt.Printf("ID:    %s\n", graph.FormatID(contact.ID))
+	fmt.Printf("ID:    %s\n", graph.FormatTypedID("ct", contact.ID))
 	fmt.Printf("姓名:  %s\n", contact.DisplayName)
 	for _, e := range contact.EmailAddresses {
 		fmt.Printf("邮箱: %s\n", e.Address)
@@ -156,11 +175,16 @@ func (c *ContactsGetCmd) Run(root *Root) error {
 
 // ContactsCreateCmd 创建联系人。
 type ContactsCreateCmd struct {
-	Name    string `help:"显示姓名" required:"" name:"name"`
-	Email   string `help:"电子邮件地址"`
-	Phone   string `help:"电话号码"`
-	Company string `help:"公司名称"`
-	Title   string `help:"职位"`
+	Name            string   `help:"显示姓名" required:"" name:"name"`
+	Email           []string `help:"电子邮件地址 (可重复)"`
+	Phone           string   `help:"工作电话号码"`
+	Mobile          string   `help:"移动电话号码"`
+	HomePhone       string   `help:"住宅电话号码" name:"home-phone"`
+	Company         string   `help:"公司名称"`
+	Title           string   `help:"职位"`
+	HomeAddress     string   `help:"住宅地址 (街道, 城市, 省, 邮编, 国家)" name:"home-address"`
+	BusinessAddress string   `help:"工作地址 (街道, 城市, 省, 邮编, 国家)" name:"business-address"`
+	Folder          string   `help:"联系人文件夹ID"`
 }
 
 // Run 执行联系人创建命令。
@@ -173,27 +197,10 @@ func (c *ContactsCreateCmd) Run(root *Root) error {
 	contact := map[string]interface{}{
 		"displayName": c.Name,
 	}
-
-	if c.Email != "" {
-		contact["emailAddresses"] = []map[string]string{
-			{"address": c.Email},
-		}
-	}
-
-	if c.Phone != "" {
-		contact["businessPhones"] = []string{c.Phone}
-	}
-
-	if c.Company != "" {
-		contact["companyName"] = c.Company
-	}
-
-	if c.Title != "" {
-		contact["jobTitle"] = c.Title
-	}
+	applyContactFields(contact, c.Email, c.Phone, c.Mobile, c.HomePhone, c.Company, c.Title, c.HomeAddress, c.BusinessAddress)
 
 	ctx := context.Background()
-	data, err := client.Post(ctx, "/me/contacts", contact)
+	data, err := client.Post(ctx, contactsBasePath(c.Folder), contact)
 	if err != nil {
 		return err
 	}
@@ -203,18 +210,22 @@ func (c *ContactsCreateCmd) Run(root *Root) error {
 		return err
 	}
 
-	fmt.Printf("✓ 联系人创建成功: %s (%s)\n", created.DisplayName, graph.FormatID(created.ID))
+	fmt.Printf("✓ 联系人创建成功: %s (%s)\n", created.DisplayName, graph.FormatTypedID("ct", created.ID))
 	return nil
 }
 
 // ContactsUpdateCmd 更新联系人。
 type ContactsUpdateCmd struct {
-	ID      string `arg:"" help:"联系人 ID"`
-	Name    string `help:"显示姓名"`
-	Email   string `help:"电子邮件地址"`
-	Phone   string `help:"电话号码"`
-	Company string `help:"公司名称"`
-	Title   string `help:"职位"`
+	ID              string   `arg:"" help:"联系人 ID"`
+	Name            string   `help:"显示姓名"`
+	Email           []string `help:"电子邮件地址 (可重复, 替换现有)"`
+	Phone           string   `help:"工作电话号码"`
+	Mobile          string   `help:"移动电话号码"`
+	HomePhone       string   `help:"住宅电话号码" name:"home-phone"`
+	Company         string   `help:"公司名称"`
+	Title           string   `help:"职位"`
+	HomeAddress     string   `help:"住宅地址 (街道, 城市, 省, 邮编, 国家)" name:"home-address"`
+	BusinessAddress string   `help:"工作地址 (街道, 城市, 省, 邮编, 国家)" name:"business-address"`
 }
 
 // Run 执行联系人更新命令。
@@ -229,18 +240,7 @@ func (c *ContactsUpdateCmd) Run(root *Root) error {
 	if c.Name != "" {
 		updates["displayName"] = c.Name
 	}
-	if c.Email != "" {
-		updates["emailAddresses"] = []map[string]string{{"address": c.Email}}
-	}
-	if c.Phone != "" {
-		updates["businessPhones"] = []string{c.Phone}
-	}
-	if c.Company != "" {
-		updates["companyName"] = c.Company
-	}
-	if c.Title != "" {
-		updates["jobTitle"] = c.Title
-	}
+	applyContactFields(updates, c.Email, c.Phone, c.Mobile, c.HomePhone, c.Company, c.Title, c.HomeAddress, c.BusinessAddress)
 
 	if len(updates) == 0 {
 		return fmt.Errorf("未指定更新内容")
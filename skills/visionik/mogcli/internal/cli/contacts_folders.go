@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// ContactsFoldersCmd 管理联系人文件夹。
+type ContactsFoldersCmd struct {
+	List   ContactsFoldersListCmd   `cmd:"" default:"1" help:"列出联系人文件夹"`
+	Create ContactsFoldersCreateCmd `cmd:"" help:"创建联系人文件夹"`
+}
+
+// ContactFolder 表示联系人文件夹。
+type ContactFolder struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// contactsBasePath 返回联系人集合路径, 可选限定到文件夹。
+func contactsBasePath(folder string) string {
+	if folder == "" {
+		return "/me/contacts"
+	}
+	return fmt.Sprintf("/me/contactFolders/%s/contacts", graph.ResolveID(folder))
+}
+
+// ContactsFoldersListCmd 列出联系人文件夹。
+type ContactsFoldersListCmd struct{}
+
+// Run 执行联系人文件夹列表命令。
+func (c *ContactsFoldersListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/contactFolders", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []ContactFolder `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("未找到联系人文件夹")
+		return nil
+	}
+
+	for _, f := range resp.Value {
+		fmt.Printf("%-30s %s\n", f.DisplayName, graph.FormatID(f.ID))
+	}
+	return nil
+}
+
+// ContactsFoldersCreateCmd 创建联系人文件夹。
+type ContactsFoldersCreateCmd struct {
+	Name string `arg:"" help:"文件夹名称"`
+}
+
+// Run 执行联系人文件夹创建命令。
+func (c *ContactsFoldersCreateCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, "/me/contactFolders", map[string]interface{}{
+		"displayName": c.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	var folder ContactFolder
+	if err := json.Unmarshal(data, &folder); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(folder)
+	}
+
+	fmt.Printf("✓ 文件夹创建成功: %s (%s)\n", folder.DisplayName, graph.FormatID(folder.ID))
+	return nil
+}
+
+// applyContactFields 把共享的联系人字段标志写入请求体, 空值跳过。
+func applyContactFields(body map[string]interface{}, emails []string, phone, mobile, homePhone, company, title, homeAddr, bizAddr string) {
+	if len(emails) > 0 {
+		var addrs []map[string]string
+		for _, e := range emails {
+			addrs = append(addrs, map[string]string{"address": e})
+		}
+		body["emailAddresses"] = addrs
+	}
+	if phone != "" {
+		body["businessPhones"] = []string{phone}
+	}
+	if mobile != "" {
+		body["mobilePhone"] = mobile
+	}
+	if homePhone != "" {
+		body["homePhones"] = []string{homePhone}
+	}
+	if company != "" {
+		body["companyName"] = company
+	}
+	if title != "" {
+		body["jobTitle"] = title
+	}
+	if homeAddr != "" {
+		body["homeAddress"] = parsePostalAddress(homeAddr)
+	}
+	if bizAddr != "" {
+		body["businessAddress"] = parsePostalAddress(bizAddr)
+	}
+}
+
+// parsePostalAddress 把 "街道, 城市, 省, 邮编, 国家" 解析为Graph的
+// physicalAddress对象, 允许省略尾部字段。
+func parsePostalAddress(s string) map[string]string {
+	fields := []string{"street", "city", "state", "postalCode", "countryOrRegion"}
+	addr := map[string]string{}
+	for i, part := range strings.SplitN(s, ",", len(fields)) {
+		if part = strings.TrimSpace(part); part != "" {
+			addr[fields[i]] = part
+		}
+	}
+	return addr
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestContactsFoldersListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/contactFolders", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "folder-1", "displayName": "客户"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&ContactsFoldersListCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "客户")
+}
+
+func TestContactsFoldersCreateCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/contactFolders", path)
+			gotBody = body
+			return mustJSON(map[string]string{"id": "folder-1", "displayName": "客户"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&ContactsFoldersCreateCmd{Name: "客户"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"displayName": "客户"}, gotBody)
+}
+
+func TestContactsBasePath(t *testing.T) {
+	assert.Equal(t, "/me/contacts", contactsBasePath(""))
+	assert.Equal(t, "/me/contactFolders/folder-1/contacts", contactsBasePath("folder-1"))
+}
+
+func TestParsePostalAddress(t *testing.T) {
+	addr := parsePostalAddress("中山路1号, 上海, , 200000, 中国")
+	assert.Equal(t, map[string]string{
+		"street":          "中山路1号",
+		"city":            "上海",
+		"postalCode":      "200000",
+		"countryOrRegion": "中国",
+	}, addr)
+}
+
+func TestContactsCreateCmd_MultiFieldBody(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return mustJSON(map[string]string{"id": "contact-1", "displayName": "张三"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &ContactsCreateCmd{
+		Name:            "张三",
+		Email:           []string{"work@example.com", "home@example.com"},
+		Phone:           "021-1234",
+		Mobile:          "138-0000",
+		HomePhone:       "021-5678",
+		BusinessAddress: "南京路2号, 上海",
+		Folder:          "folder-1",
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/contactFolders/folder-1/contacts", gotPath)
+
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, []map[string]string{
+		{"address": "work@example.com"},
+		{"address": "home@example.com"},
+	}, body["emailAddresses"])
+	assert.Equal(t, []string{"021-1234"}, body["businessPhones"])
+	assert.Equal(t, "138-0000", body["mobilePhone"])
+	assert.Equal(t, []string{"021-5678"}, body["homePhones"])
+	assert.Equal(t, map[string]string{"street": "南京路2号", "city": "上海"}, body["businessAddress"])
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -255,7 +256,7 @@ func TestContactsCreateCmd_Run(t *testing.T) {
 			name: "create with all fields",
 			cmd: &ContactsCreateCmd{
 				Name:    "Full Contact",
-				Email:   "full@example.com",
+				Email:   []string{"full@example.com"},
 				Phone:   "555-1234",
 				Company: "Company Inc",
 				Title:   "Manager",
@@ -322,7 +323,7 @@ func TestContactsUpdateCmd_Run(t *testing.T) {
 			cmd: &ContactsUpdateCmd{
 				ID:      "contact-123",
 				Name:    "Updated",
-				Email:   "new@example.com",
+				Email:   []string{"new@example.com"},
 				Phone:   "555-9999",
 				Company: "New Co",
 				Title:   "Director",
@@ -536,3 +537,28 @@ func TestDirectoryUser_Unmarshal(t *testing.T) {
 	assert.Equal(t, "john.smith@company.com", user.Mail)
 	assert.Equal(t, "Developer", user.JobTitle)
 }
+
+func TestContactsListCmd_Plain(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id": "contact-1", "displayName": "张三",
+						"emailAddresses": []map[string]string{{"address": "zhang@example.com"}},
+					},
+				},
+			}), nil
+		},
+	}
+	root := &Root{Plain: true, ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&ContactsListCmd{Max: 10}).Run(root) })
+
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "id\tname\temail", lines[0])
+	assert.Contains(t, lines[1], "张三\tzhang@example.com")
+}
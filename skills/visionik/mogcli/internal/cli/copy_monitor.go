@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// copyPollInterval 是复制监视 URL 的轮询间隔, 测试中可缩短。
+var copyPollInterval = 2 * time.Second
+
+// runCopy 发起复制请求。wait 为 true 时通过 202 响应的 Location
+// 监视 URL 轮询直到复制完成并返回新项目 ID, 否则只发起请求。
+func runCopy(client graph.Client, path string, body interface{}, wait bool) (string, error) {
+	ctx := context.Background()
+	if !wait {
+		_, err := client.Post(ctx, path, body)
+		return "", err
+	}
+
+	location, err := client.PostForLocation(ctx, path, body)
+	if err != nil {
+		return "", err
+	}
+	if location == "" {
+		return "", fmt.Errorf("复制响应缺少 Location 监视 URL")
+	}
+	return waitForCopy(location)
+}
+
+// waitForCopy 轮询监视 URL 直到复制完成, 返回新项目的 ID。
+func waitForCopy(monitorURL string) (string, error) {
+	for {
+		status, id, err := checkCopyStatus(monitorURL)
+		if err != nil {
+			return "", err
+		}
+		if status == "completed" {
+			return id, nil
+		}
+		if status == "failed" {
+			return "", fmt.Errorf("复制失败")
+		}
+		time.Sleep(copyPollInterval)
+	}
+}
+
+// checkCopyStatus 查询一次监视 URL。完成后监视端点会重定向到新项目,
+// 此时响应是项目本身而不是操作状态。
+func checkCopyStatus(monitorURL string) (string, string, error) {
+	resp, err := http.Get(monitorURL)
+	if err != nil {
+		return "", "", fmt.Errorf("查询复制状态失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+
+	var status struct {
+		ID         string  `json:"id"`
+		Status     string  `json:"status"`
+		ResourceID string  `json:"resourceId"`
+		Percentage float64 `json:"percentageComplete"`
+		Error      *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return "", "", fmt.Errorf("无法解析复制状态: %w", err)
+	}
+
+	if status.Error != nil {
+		return "", "", fmt.Errorf("复制失败: %s", status.Error.Message)
+	}
+	if status.Status == "" && status.ID != "" {
+		return "completed", status.ID, nil
+	}
+	if status.Status == "completed" && status.ResourceID != "" {
+		return "completed", status.ResourceID, nil
+	}
+	return status.Status, status.ResourceID, nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestWaitForCopy(t *testing.T) {
+	oldInterval := copyPollInterval
+	copyPollInterval = time.Millisecond
+	defer func() { copyPollInterval = oldInterval }()
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Write([]byte(`{"status":"inProgress","percentageComplete":50}`))
+			return
+		}
+		w.Write([]byte(`{"status":"completed","resourceId":"item-new"}`))
+	}))
+	defer server.Close()
+
+	id, err := waitForCopy(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "item-new", id)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWaitForCopy_RedirectedToItem(t *testing.T) {
+	// 完成后监视端点重定向到新项目本身
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"item-new","name":"copy.docx"}`))
+	}))
+	defer server.Close()
+
+	id, err := waitForCopy(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "item-new", id)
+}
+
+func TestWaitForCopy_Failed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"failed","error":{"message":"quota exceeded"}}`))
+	}))
+	defer server.Close()
+
+	_, err := waitForCopy(server.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "quota exceeded")
+}
+
+func TestDriveCopyCmd_Wait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"completed","resourceId":"item-new"}`))
+	}))
+	defer server.Close()
+
+	var gotPath string
+	mock := &testutil.MockClient{
+		PostForLocationFunc: func(ctx context.Context, path string, body interface{}) (string, error) {
+			gotPath = path
+			return server.URL, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveCopyCmd{ID: "file-123", Name: "copy.docx", Wait: true}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/file-123/copy", gotPath)
+	assert.Contains(t, output, "复制完成")
+}
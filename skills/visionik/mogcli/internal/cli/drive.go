@@ -7,8 +7,11 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/visionik/mogcli/internal/graph"
+	"github.com/visionik/mogcli/internal/output"
 )
 
 // DriveCmd 处理 OneDrive 操作命令。
@@ -17,12 +20,20 @@ type DriveCmd struct {
 	Search   DriveSearchCmd   `cmd:"" help:"搜索文件"`
 	Get      DriveGetCmd      `cmd:"" help:"获取文件元数据"`
 	Download DriveDownloadCmd `cmd:"" help:"下载文件"`
+	Cat      DriveCatCmd      `cmd:"" help:"输出文件内容到标准输出"`
 	Upload   DriveUploadCmd   `cmd:"" help:"上传文件"`
 	Mkdir    DriveMkdirCmd    `cmd:"" help:"创建文件夹"`
 	Move     DriveMoveCmd     `cmd:"" help:"移动文件"`
 	Copy     DriveCopyCmd     `cmd:"" help:"复制文件"`
 	Rename   DriveRenameCmd   `cmd:"" help:"重命名文件"`
 	Delete   DriveDeleteCmd   `cmd:"" aliases:"rm" help:"删除文件"`
+	Sync     DriveSyncCmd     `cmd:"" help:"同步本地目录和远程文件夹"`
+	Versions DriveVersionsCmd `cmd:"" help:"文件版本 (列出/恢复)"`
+	Trash    DriveTrashCmd    `cmd:"" help:"查看回收站"`
+	Restore  DriveRestoreCmd  `cmd:"" help:"从回收站恢复文件"`
+	Quota    DriveQuotaCmd    `cmd:"" help:"显示存储配额"`
+	Usage    DriveUsageCmd    `cmd:"" help:"报告最大的文件和文件夹"`
+	Complete DriveCompleteCmd `cmd:"" help:"补全远程路径 (供 shell 补全脚本使用)"`
 }
 
 // DriveLsCmd 列出文件。
@@ -48,7 +59,8 @@ func (c *DriveLsCmd) Run(root *Root) error {
 		}
 	}
 
-	data, err := client.Get(ctx, path, nil)
+	query := applySelect(nil, root, "id,name,size,folder,file,lastModifiedDateTime")
+	data, err := client.Get(ctx, path, query)
 	if err != nil {
 		return err
 	}
@@ -64,6 +76,19 @@ func (c *DriveLsCmd) Run(root *Root) error {
 		return outputJSON(resp.Value)
 	}
 
+	if root.Plain {
+		t := output.NewTable("id", "name", "type", "size", "modified")
+		for _, item := range resp.Value {
+			itemType := "file"
+			if item.Folder != nil {
+				itemType = "folder"
+			}
+			t.Row(graph.FormatTypedID("file", item.ID), item.Name, itemType,
+				strconv.FormatInt(item.Size, 10), item.LastModifiedDateTime)
+		}
+		return t.Render(os.Stdout, true)
+	}
+
 	for _, item := range resp.Value {
 		itemType := "📄"
 		if item.Folder != nil {
@@ -73,15 +98,16 @@ func (c *DriveLsCmd) Run(root *Root) error {
 		if item.Size > 0 {
 			size = formatSize(item.Size)
 		}
-		fmt.Printf("%s %-40s %8s  %s\n", itemType, item.Name, size, graph.FormatID(item.ID))
+		fmt.Printf("%s %-40s %8s  %s\n", itemType, item.Name, size, graph.FormatTypedID("file", item.ID))
 	}
 	return nil
 }
 
 // DriveSearchCmd 搜索文件。
 type DriveSearchCmd struct {
-	Query string `arg:"" help:"搜索查询"`
-	Max   int    `help:"最大结果数" default:"25"`
+	Query  string `arg:"" help:"搜索查询"`
+	Max    int    `help:"最大结果数" default:"25"`
+	Folder string `help:"限定到文件夹 (ID 或路径)"`
 }
 
 // Run 执行驱动器搜索命令。
@@ -94,9 +120,9 @@ func (c *DriveSearchCmd) Run(root *Root) error {
 	ctx := context.Background()
 	query := url.Values{}
 	query.Set("$top", fmt.Sprintf("%d", c.Max))
+	query = applySelect(query, root, "id,name,folder,file")
 
-	path := fmt.Sprintf("/me/drive/root/search(q='%s')", url.PathEscape(c.Query))
-	data, err := client.Get(ctx, path, query)
+	data, err := client.Get(ctx, driveSearchPath(c.Folder, c.Query), query)
 	if err != nil {
 		return err
 	}
@@ -117,14 +143,46 @@ func (c *DriveSearchCmd) Run(root *Root) error {
 		if item.Folder != nil {
 			itemType = "📁"
 		}
-		fmt.Printf("%s %s  %s\n", itemType, item.Name, graph.FormatID(item.ID))
+		fmt.Printf("%s %s  %s\n", itemType, item.Name, graph.FormatTypedID("file", item.ID))
 	}
 	return nil
 }
 
+// driveSearchPath 构造驱动器搜索路径, folder 把范围限定到指定
+// 文件夹 (ID、短 ID 或路径); 为空时搜索整个驱动器。
+func driveSearchPath(folder, q string) string {
+	q = url.PathEscape(q)
+	if folder == "" {
+		return fmt.Sprintf("/me/drive/root/search(q='%s')", q)
+	}
+	if resolved := graph.ResolveID(folder); resolved != folder || len(folder) > 20 {
+		return fmt.Sprintf("/me/drive/items/%s/search(q='%s')", resolved, q)
+	}
+	return fmt.Sprintf("/me/drive/root:/%s:/search(q='%s')", folder, q)
+}
+
+// sharedWithMeItems 列出他人共享给我的驱动器条目。
+func sharedWithMeItems(ctx context.Context, client graph.Client, max int) ([]DriveItem, error) {
+	query := url.Values{}
+	query.Set("$top", fmt.Sprintf("%d", max))
+
+	data, err := client.Get(ctx, "/me/drive/sharedWithMe", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Value []DriveItem `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
 // DriveGetCmd 获取文件元数据。
 type DriveGetCmd struct {
-	ID string `arg:"" help:"文件 ID"`
+	IDs []string `arg:"" help:"文件 ID (可指定多个)"`
 }
 
 // Run 执行驱动器获取命令。
@@ -135,23 +193,76 @@ func (c *DriveGetCmd) Run(root *Root) error {
 	}
 
 	ctx := context.Background()
-	path := fmt.Sprintf("/me/drive/items/%s", graph.ResolveID(c.ID))
+	query := applySelect(nil, root, "id,name,size,createdDateTime,lastModifiedDateTime,webUrl,folder,file")
+
+	// 单个 ID 直接请求; 多个 ID 合并为单个 $batch 往返。
+	if len(c.IDs) == 1 {
+		path := fmt.Sprintf("/me/drive/items/%s", graph.ResolveID(c.IDs[0]))
+		data, err := client.Get(ctx, path, query)
+		if err != nil {
+			return err
+		}
 
-	data, err := client.Get(ctx, path, nil)
+		var item DriveItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+
+		if root.JSON {
+			return outputJSON(item)
+		}
+
+		printDriveItemDetails(item)
+		return nil
+	}
+
+	reqs := make([]graph.BatchRequest, len(c.IDs))
+	for i, id := range c.IDs {
+		reqs[i] = graph.BatchRequest{
+			Method: "GET",
+			URL:    fmt.Sprintf("/me/drive/items/%s?%s", graph.ResolveID(id), query.Encode()),
+		}
+	}
+
+	resps, err := graph.Batch(ctx, client, reqs)
 	if err != nil {
 		return err
 	}
 
-	var item DriveItem
-	if err := json.Unmarshal(data, &item); err != nil {
-		return err
+	var items []DriveItem
+	var failed []string
+	for i, resp := range resps {
+		if err := resp.Err(); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", c.IDs[i], err))
+			continue
+		}
+		var item DriveItem
+		if err := json.Unmarshal(resp.Body, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
 	}
 
 	if root.JSON {
-		return outputJSON(item)
+		return outputJSON(items)
 	}
 
-	fmt.Printf("ID:       %s\n", graph.FormatID(item.ID))
+	for i, item := range items {
+		if i > 0 {
+			fmt.Println()
+		}
+		printDriveItemDetails(item)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("获取失败:\n%s", strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+// printDriveItemDetails 输出单个文件的元数据详情。
+func printDriveItemDetails(item DriveItem) {
+	fmt.Printf("ID:       %s\n", graph.FormatTypedID("file", item.ID))
 	fmt.Printf("名称:     %s\n", item.Name)
 	fmt.Printf("大小:     %s\n", formatSize(item.Size))
 	fmt.Printf("创建:     %s\n", item.CreatedDateTime)
@@ -159,13 +270,13 @@ func (c *DriveGetCmd) Run(root *Root) error {
 	if item.WebURL != "" {
 		fmt.Printf("URL:      %s\n", item.WebURL)
 	}
-	return nil
 }
 
 // DriveDownloadCmd 下载文件。
 type DriveDownloadCmd struct {
-	ID  string `arg:"" help:"文件 ID"`
-	Out string `help:"输出路径" required:""`
+	ID        string `arg:"" help:"文件 ID 或路径 (如 /Documents/report.docx)"`
+	Out       string `help:"输出路径" required:""`
+	Recursive bool   `short:"r" help:"递归下载整个文件夹"`
 }
 
 // Run 执行驱动器下载命令。
@@ -175,15 +286,22 @@ func (c *DriveDownloadCmd) Run(root *Root) error {
 		return err
 	}
 
-	ctx := context.Background()
-	path := fmt.Sprintf("/me/drive/items/%s/content", graph.ResolveID(c.ID))
+	if c.Recursive {
+		count, err := downloadTree(client, driveItemRef(c.ID), c.Out)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ 下载完成: %d 个文件\n", count)
+		return nil
+	}
 
-	data, err := client.Get(ctx, path, nil)
+	ctx := context.Background()
+	data, err := client.Get(ctx, driveItemRef(c.ID)+"/content", nil)
 	if err != nil {
 		return err
 	}
 
-	if err := os.WriteFile(c.Out, data, 0644); err != nil {
+	if err := writeFileAtomic(c.Out, data, 0644); err != nil {
 		return err
 	}
 
@@ -191,11 +309,43 @@ func (c *DriveDownloadCmd) Run(root *Root) error {
 	return nil
 }
 
+// DriveCatCmd 输出文件内容到标准输出, 便于通过管道处理。
+type DriveCatCmd struct {
+	ID string `arg:"" help:"文件 ID 或路径 (如 /Documents/report.docx)"`
+}
+
+// Run 执行驱动器 cat 命令。
+func (c *DriveCatCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, driveItemRef(c.ID)+"/content", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+// driveItemRef 返回文件的 Graph 资源路径。以 / 开头的参数按 OneDrive
+// 路径寻址 (root:/path:), 其余按文件 ID 处理。
+func driveItemRef(ref string) string {
+	if strings.HasPrefix(ref, "/") {
+		return fmt.Sprintf("/me/drive/root:%s:", strings.TrimSuffix(ref, "/"))
+	}
+	return fmt.Sprintf("/me/drive/items/%s", graph.ResolveID(ref))
+}
+
 // DriveUploadCmd 上传文件。
 type DriveUploadCmd struct {
-	Path   string `arg:"" help:"本地文件路径"`
-	Folder string `help:"目标文件夹 ID"`
-	Name   string `help:"上传时重命名文件"`
+	Path      string `arg:"" help:"本地文件路径"`
+	Folder    string `help:"目标文件夹 ID"`
+	Name      string `help:"上传时重命名文件"`
+	Recursive bool   `short:"r" help:"递归上传整个目录"`
 }
 
 // Run 执行驱动器上传命令。
@@ -205,6 +355,19 @@ func (c *DriveUploadCmd) Run(root *Root) error {
 		return err
 	}
 
+	if c.Recursive {
+		prefix := c.Name
+		if prefix == "" {
+			prefix = filepath.Base(filepath.Clean(c.Path))
+		}
+		count, err := uploadTree(client, c.Path, prefix)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ 上传完成: %d 个文件\n", count)
+		return nil
+	}
+
 	data, err := os.ReadFile(c.Path)
 	if err != nil {
 		return err
@@ -235,7 +398,7 @@ func (c *DriveUploadCmd) Run(root *Root) error {
 		return err
 	}
 
-	fmt.Printf("✓ 上传完成: %s (%s)\n", item.Name, graph.FormatID(item.ID))
+	fmt.Printf("✓ 上传完成: %s (%s)\n", item.Name, graph.FormatTypedID("file", item.ID))
 	return nil
 }
 
@@ -273,7 +436,7 @@ func (c *DriveMkdirCmd) Run(root *Root) error {
 		return err
 	}
 
-	fmt.Printf("✓ 文件夹创建成功: %s (%s)\n", item.Name, graph.FormatID(item.ID))
+	fmt.Printf("✓ 文件夹创建成功: %s (%s)\n", item.Name, graph.FormatTypedID("file", item.ID))
 	return nil
 }
 
@@ -312,6 +475,7 @@ func (c *DriveMoveCmd) Run(root *Root) error {
 type DriveCopyCmd struct {
 	ID   string `arg:"" help:"文件 ID"`
 	Name string `help:"复制的新名称" required:""`
+	Wait bool   `help:"等待复制完成并输出新文件 ID"`
 }
 
 // Run 执行驱动器复制命令。
@@ -325,14 +489,17 @@ func (c *DriveCopyCmd) Run(root *Root) error {
 		"name": c.Name,
 	}
 
-	ctx := context.Background()
 	path := fmt.Sprintf("/me/drive/items/%s/copy", graph.ResolveID(c.ID))
-
-	_, err = client.Post(ctx, path, body)
+	newID, err := runCopy(client, path, body, c.Wait)
 	if err != nil {
 		return err
 	}
 
+	if c.Wait {
+		fmt.Printf("✓ 复制完成: %s (%s)\n", c.Name, graph.FormatTypedID("file", newID))
+		return nil
+	}
+
 	fmt.Printf("✓ 复制已启动: %s\n", c.Name)
 	return nil
 }
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/visionik/mogcli/internal/config"
+)
+
+const (
+	// driveChildrenCacheFile 是驱动器子项缓存的文件名。
+	driveChildrenCacheFile = "drive_children.json"
+	// driveChildrenCacheTTL 是缓存目录列表的有效期。
+	driveChildrenCacheTTL = 5 * time.Minute
+)
+
+// DriveCompleteCmd 返回与部分路径匹配的子项名称,
+// 供 shell 补全脚本提供远程路径补全。子目录列表会被缓存
+// 以保证补全速度。
+type DriveCompleteCmd struct {
+	Path    string `arg:"" optional:"" help:"部分远程路径, 如 Documents/Rep" default:""`
+	Refresh bool   `help:"忽略缓存, 强制重新获取"`
+}
+
+// driveChildrenCache 按目录路径缓存子项列表。
+type driveChildrenCache struct {
+	Dirs map[string]driveChildrenEntry `json:"dirs"`
+}
+
+// driveChildrenEntry 是单个目录的缓存列表。
+type driveChildrenEntry struct {
+	Fetched  time.Time    `json:"fetched"`
+	Children []driveChild `json:"children"`
+}
+
+// driveChild 是补全所需的最小子项信息。
+type driveChild struct {
+	Name   string `json:"name"`
+	Folder bool   `json:"folder,omitempty"`
+}
+
+// Run 执行驱动器路径补全命令。
+func (c *DriveCompleteCmd) Run(root *Root) error {
+	// 拆分为目录和待补全前缀
+	dir, prefix := "", c.Path
+	if i := strings.LastIndex(c.Path, "/"); i >= 0 {
+		dir, prefix = c.Path[:i], c.Path[i+1:]
+	}
+
+	children, err := c.children(root, dir)
+	if err != nil {
+		return err
+	}
+
+	var matches []string
+	for _, child := range children {
+		if !strings.HasPrefix(strings.ToLower(child.Name), strings.ToLower(prefix)) {
+			continue
+		}
+		full := child.Name
+		if dir != "" {
+			full = dir + "/" + child.Name
+		}
+		if child.Folder {
+			full += "/"
+		}
+		matches = append(matches, full)
+	}
+
+	if root.JSON {
+		return outputJSON(matches)
+	}
+
+	for _, m := range matches {
+		fmt.Println(m)
+	}
+	return nil
+}
+
+// children 返回目录的子项列表, 优先使用未过期的缓存。
+func (c *DriveCompleteCmd) children(root *Root, dir string) ([]driveChild, error) {
+	cache := loadDriveChildrenCache()
+	if !c.Refresh {
+		if entry, ok := cache.Dirs[dir]; ok && time.Since(entry.Fetched) < driveChildrenCacheTTL {
+			return entry.Children, nil
+		}
+	}
+
+	client, err := root.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	path := "/me/drive/root/children"
+	if dir != "" {
+		path = fmt.Sprintf("/me/drive/root:/%s:/children", dir)
+	}
+
+	query := url.Values{}
+	query.Set("$select", "name,folder")
+	query.Set("$top", "999")
+
+	data, err := client.Get(ctx, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Value []DriveItem `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	children := make([]driveChild, 0, len(resp.Value))
+	for _, item := range resp.Value {
+		children = append(children, driveChild{
+			Name:   item.Name,
+			Folder: item.Folder != nil,
+		})
+	}
+
+	cache.Dirs[dir] = driveChildrenEntry{Fetched: time.Now(), Children: children}
+	saveDriveChildrenCache(cache) // 缓存失败不影响补全
+
+	return children, nil
+}
+
+// loadDriveChildrenCache 加载子项缓存, 失败时返回空缓存。
+func loadDriveChildrenCache() *driveChildrenCache {
+	cache := &driveChildrenCache{Dirs: make(map[string]driveChildrenEntry)}
+
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return cache
+	}
+	data, err := os.ReadFile(filepath.Join(dir, driveChildrenCacheFile))
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, cache); err != nil || cache.Dirs == nil {
+		cache.Dirs = make(map[string]driveChildrenEntry)
+	}
+	return cache
+}
+
+// saveDriveChildrenCache 将子项缓存写入磁盘, 错误被忽略。
+func saveDriveChildrenCache(cache *driveChildrenCache) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return
+	}
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(filepath.Join(dir, driveChildrenCacheFile), data, 0600)
+}
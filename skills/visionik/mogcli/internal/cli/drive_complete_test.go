@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestDriveCompleteCmd_Run(t *testing.T) {
+	children := mustJSON(map[string]interface{}{
+		"value": []map[string]interface{}{
+			{"name": "Reports", "folder": map[string]int{"childCount": 2}},
+			{"name": "Report.docx"},
+			{"name": "Notes.txt"},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		cmd       *DriveCompleteCmd
+		wantPath  string
+		wantInOut string
+		notInOut  string
+	}{
+		{
+			name:      "root prefix match",
+			cmd:       &DriveCompleteCmd{Path: "Rep", Refresh: true},
+			wantPath:  "/me/drive/root/children",
+			wantInOut: "Reports/",
+			notInOut:  "Notes.txt",
+		},
+		{
+			name:      "nested path lists parent dir",
+			cmd:       &DriveCompleteCmd{Path: "Documents/Rep", Refresh: true},
+			wantPath:  "/me/drive/root:/Documents:/children",
+			wantInOut: "Documents/Reports/",
+		},
+		{
+			name:      "empty prefix lists everything",
+			cmd:       &DriveCompleteCmd{Refresh: true},
+			wantPath:  "/me/drive/root/children",
+			wantInOut: "Notes.txt",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+
+			var gotPath string
+			mock := &testutil.MockClient{
+				GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+					gotPath = path
+					return children, nil
+				},
+			}
+			root := &Root{ClientFactory: mockClientFactory(mock)}
+
+			var err error
+			output := captureOutput(func() {
+				err = tt.cmd.Run(root)
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPath, gotPath)
+			assert.Contains(t, output, tt.wantInOut)
+			if tt.notInOut != "" {
+				assert.NotContains(t, output, tt.notInOut)
+			}
+		})
+	}
+}
+
+func TestDriveCompleteCmd_UsesCache(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	calls := 0
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			calls++
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{{"name": "File.txt"}},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveCompleteCmd{Path: "Fi"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+	require.NoError(t, err)
+	captureOutput(func() { err = cmd.Run(root) })
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls, "second run should be served from cache")
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestDriveItemRef(t *testing.T) {
+	assert.Equal(t, "/me/drive/root:/Documents/report.docx:", driveItemRef("/Documents/report.docx"))
+	assert.Equal(t, "/me/drive/items/file-123", driveItemRef("file-123"))
+}
+
+func TestDriveDownloadCmd_ByPath(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPath = path
+			return []byte("content"), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveDownloadCmd{ID: "/Documents/report.docx", Out: t.TempDir() + "/report.docx"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/root:/Documents/report.docx:/content", gotPath)
+}
+
+func TestDriveCatCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/file-123/content", path)
+			return []byte("raw bytes"), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&DriveCatCmd{ID: "file-123"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "raw bytes", output)
+}
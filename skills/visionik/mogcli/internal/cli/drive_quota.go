@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// DriveQuotaCmd 显示存储配额。
+type DriveQuotaCmd struct{}
+
+// driveQuota 对应 /me/drive 的 quota 字段。
+type driveQuota struct {
+	Total     int64  `json:"total"`
+	Used      int64  `json:"used"`
+	Remaining int64  `json:"remaining"`
+	Deleted   int64  `json:"deleted"`
+	State     string `json:"state"`
+}
+
+// Run 执行配额命令。
+func (c *DriveQuotaCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$select", "quota")
+	data, err := client.Get(ctx, "/me/drive", query)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Quota driveQuota `json:"quota"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Quota)
+	}
+
+	q := resp.Quota
+	fmt.Printf("总量:     %s\n", formatSize(q.Total))
+	fmt.Printf("已使用:   %s", formatSize(q.Used))
+	if q.Total > 0 {
+		fmt.Printf(" (%.1f%%)", float64(q.Used)/float64(q.Total)*100)
+	}
+	fmt.Println()
+	fmt.Printf("剩余:     %s\n", formatSize(q.Remaining))
+	if q.Deleted > 0 {
+		fmt.Printf("回收站:   %s\n", formatSize(q.Deleted))
+	}
+	if q.State != "" && q.State != "normal" {
+		fmt.Printf("状态:     %s\n", q.State)
+	}
+	return nil
+}
+
+// DriveUsageCmd 报告占用空间最大的文件和文件夹。
+type DriveUsageCmd struct {
+	Top   int    `help:"显示的条目数" default:"20"`
+	Path  string `help:"起始文件夹路径 (默认: 根目录)"`
+	Depth int    `help:"遍历的最大目录深度" default:"3"`
+}
+
+// driveUsageEntry 是用量报告中的一行。
+type driveUsageEntry struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	IsFolder bool   `json:"isFolder"`
+}
+
+// Run 执行用量报告命令。
+func (c *DriveUsageCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ref := "/me/drive/root"
+	if c.Path != "" {
+		ref = driveJoinRef("/me/drive/root", strings.Trim(c.Path, "/"))
+	}
+
+	var entries []driveUsageEntry
+	if err := collectUsage(client, ref, "", c.Depth, &entries); err != nil {
+		return err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Size > entries[j].Size })
+	if len(entries) > c.Top {
+		entries = entries[:c.Top]
+	}
+
+	if root.JSON {
+		return outputJSON(entries)
+	}
+
+	for _, entry := range entries {
+		marker := "📄"
+		if entry.IsFolder {
+			marker = "📁"
+		}
+		fmt.Printf("%8s  %s %s\n", formatSize(entry.Size), marker, entry.Path)
+	}
+	return nil
+}
+
+// collectUsage 遍历目录树收集文件和文件夹的大小。文件夹的 size 字段
+// 由 Graph 聚合, 无需递归求和; 递归只为了列出子项。
+func collectUsage(client graph.Client, ref, prefix string, depth int, entries *[]driveUsageEntry) error {
+	if depth < 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$select", "id,name,size,folder,file")
+	data, err := client.Get(ctx, ref+"/children", query)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []DriveItem `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	for _, item := range resp.Value {
+		itemPath := item.Name
+		if prefix != "" {
+			itemPath = prefix + "/" + item.Name
+		}
+		*entries = append(*entries, driveUsageEntry{
+			Path:     itemPath,
+			Size:     item.Size,
+			IsFolder: item.Folder != nil,
+		})
+		if item.Folder != nil && depth > 0 {
+			childRef := fmt.Sprintf("/me/drive/items/%s", item.ID)
+			if err := collectUsage(client, childRef, itemPath, depth-1, entries); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestDriveQuotaCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive", path)
+			assert.Equal(t, "quota", query.Get("$select"))
+			return mustJSON(map[string]interface{}{
+				"quota": map[string]interface{}{
+					"total":     int64(5368709120),
+					"used":      int64(1073741824),
+					"remaining": int64(4294967296),
+					"state":     "normal",
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&DriveQuotaCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "5.0 GB")
+	assert.Contains(t, output, "1.0 GB")
+	assert.Contains(t, output, "20.0%")
+}
+
+func TestDriveUsageCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch path {
+			case "/me/drive/root/children":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "d-1", "name": "Videos", "size": 3000, "folder": map[string]int{"childCount": 1}},
+						{"id": "f-1", "name": "small.txt", "size": 10},
+					},
+				}), nil
+			case "/me/drive/items/d-1/children":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "f-2", "name": "movie.mp4", "size": 2990},
+					},
+				}), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveUsageCmd{Top: 2, Depth: 3}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "Videos")
+	assert.Contains(t, lines[1], "Videos/movie.mp4")
+	assert.NotContains(t, output, "small.txt")
+}
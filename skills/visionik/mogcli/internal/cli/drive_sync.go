@@ -0,0 +1,435 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/config"
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// DriveSyncCmd 在本地目录和远程文件夹之间同步文件。远程变更通过
+// drive delta API 获取, 本地变更通过与上次同步记录的 mtime/大小比较,
+// 只传输有变化的文件。同步状态持久化在配置目录中。
+type DriveSyncCmd struct {
+	Local  string `arg:"" help:"本地目录"`
+	Remote string `arg:"" help:"远程文件夹路径 (如 /Backup)"`
+}
+
+// driveSyncEntry 记录单个文件上次同步时的状态。
+type driveSyncEntry struct {
+	Size           int64  `json:"size"`
+	ModTime        int64  `json:"modTime"`
+	RemoteModified string `json:"remoteModified"`
+}
+
+// driveSyncState 是按 本地->远程 目录对持久化的同步状态。
+type driveSyncState struct {
+	DeltaLink string                     `json:"deltaLink"`
+	Files     map[string]*driveSyncEntry `json:"files"`
+}
+
+// driveDeltaItem 是 delta 响应中的单个项目。
+type driveDeltaItem struct {
+	ID                   string          `json:"id"`
+	Name                 string          `json:"name"`
+	Size                 int64           `json:"size"`
+	LastModifiedDateTime string          `json:"lastModifiedDateTime"`
+	File                 *FileInfo       `json:"file"`
+	Folder               *FolderInfo     `json:"folder"`
+	Removed              json.RawMessage `json:"@removed"`
+	ParentReference      struct {
+		Path string `json:"path"`
+	} `json:"parentReference"`
+}
+
+// Run 执行驱动器同步命令。
+func (c *DriveSyncCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	localDir, err := filepath.Abs(c.Local)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(localDir, 0755); err != nil {
+		return err
+	}
+	remote := "/" + strings.Trim(c.Remote, "/")
+
+	states, err := loadDriveSyncStates()
+	if err != nil {
+		return err
+	}
+	key := localDir + " -> " + remote
+	state := states[key]
+	if state == nil {
+		state = &driveSyncState{Files: make(map[string]*driveSyncEntry)}
+	}
+
+	downloaded, removed, err := c.syncRemote(client, state, localDir, remote)
+	if err != nil {
+		return err
+	}
+	uploaded, err := c.syncLocal(client, state, localDir, remote)
+	if err != nil {
+		return err
+	}
+
+	states[key] = state
+	if err := saveDriveSyncStates(states); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 同步完成: ↓ %d ↑ %d ✗ %d\n", downloaded, uploaded, removed)
+	return nil
+}
+
+// syncRemote 通过 delta 查询应用远程变更到本地目录。
+func (c *DriveSyncCmd) syncRemote(client graph.Client, state *driveSyncState, localDir, remote string) (int, int, error) {
+	ctx := context.Background()
+
+	var reqPath string
+	var query url.Values
+	if state.DeltaLink != "" {
+		var err error
+		reqPath, query, err = splitGraphLink(state.DeltaLink)
+		if err != nil {
+			return 0, 0, err
+		}
+	} else if remote == "/" {
+		reqPath = "/me/drive/root/delta"
+	} else {
+		reqPath = fmt.Sprintf("/me/drive/root:%s:/delta", remote)
+	}
+
+	downloaded, removed := 0, 0
+	for {
+		data, err := client.Get(ctx, reqPath, query)
+		if err != nil {
+			return downloaded, removed, err
+		}
+
+		var resp struct {
+			Value     []driveDeltaItem `json:"value"`
+			NextLink  string           `json:"@odata.nextLink"`
+			DeltaLink string           `json:"@odata.deltaLink"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return downloaded, removed, err
+		}
+
+		for _, item := range resp.Value {
+			if item.File == nil && item.Removed == nil {
+				continue
+			}
+			rel := remoteSyncRel(item.ParentReference.Path, item.Name, remote)
+			if rel == "" {
+				continue
+			}
+			localPath := filepath.Join(localDir, filepath.FromSlash(rel))
+
+			if item.Removed != nil {
+				if _, err := os.Stat(localPath); err == nil {
+					if err := os.Remove(localPath); err != nil {
+						return downloaded, removed, err
+					}
+					fmt.Printf("✗ %s\n", rel)
+					removed++
+				}
+				delete(state.Files, rel)
+				continue
+			}
+
+			entry := state.Files[rel]
+			if entry != nil && entry.RemoteModified == item.LastModifiedDateTime {
+				continue
+			}
+
+			content, err := client.Get(ctx, fmt.Sprintf("/me/drive/items/%s/content", item.ID), nil)
+			if err != nil {
+				return downloaded, removed, err
+			}
+			if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+				return downloaded, removed, err
+			}
+			if err := os.WriteFile(localPath, content, 0644); err != nil {
+				return downloaded, removed, err
+			}
+			info, err := os.Stat(localPath)
+			if err != nil {
+				return downloaded, removed, err
+			}
+			state.Files[rel] = &driveSyncEntry{
+				Size:           info.Size(),
+				ModTime:        info.ModTime().Unix(),
+				RemoteModified: item.LastModifiedDateTime,
+			}
+			fmt.Printf("↓ %s\n", rel)
+			downloaded++
+		}
+
+		if resp.DeltaLink != "" {
+			state.DeltaLink = resp.DeltaLink
+			return downloaded, removed, nil
+		}
+		if resp.NextLink == "" {
+			return downloaded, removed, nil
+		}
+		reqPath, query, err = splitGraphLink(resp.NextLink)
+		if err != nil {
+			return downloaded, removed, err
+		}
+	}
+}
+
+// syncLocal 把本地有变化的文件上传到远程文件夹。
+func (c *DriveSyncCmd) syncLocal(client graph.Client, state *driveSyncState, localDir, remote string) (int, error) {
+	ctx := context.Background()
+	remotePrefix := strings.TrimPrefix(remote, "/")
+	if err := ensureRemoteFolder(client, "/me/drive/root", remotePrefix); err != nil {
+		return 0, err
+	}
+
+	uploaded := 0
+	err := filepath.WalkDir(localDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(localDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if rel == "." {
+				return nil
+			}
+			return ensureRemoteFolder(client, "/me/drive/root", path.Join(remotePrefix, rel))
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entry := state.Files[rel]
+		if entry != nil && entry.Size == info.Size() && entry.ModTime == info.ModTime().Unix() {
+			return nil
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		ref := driveJoinRef("/me/drive/root", path.Join(remotePrefix, rel))
+		respData, err := client.Put(ctx, ref+"/content", data, "application/octet-stream")
+		if err != nil {
+			return err
+		}
+		var item driveDeltaItem
+		if err := json.Unmarshal(respData, &item); err != nil {
+			return err
+		}
+		state.Files[rel] = &driveSyncEntry{
+			Size:           info.Size(),
+			ModTime:        info.ModTime().Unix(),
+			RemoteModified: item.LastModifiedDateTime,
+		}
+		fmt.Printf("↑ %s\n", rel)
+		uploaded++
+		return nil
+	})
+	return uploaded, err
+}
+
+// remoteSyncRel 根据 delta 项目的父路径和名称计算相对于远程同步根
+// 的路径, 项目不在同步范围内时返回空字符串。
+func remoteSyncRel(parentPath, name, remote string) string {
+	dir := ""
+	if i := strings.Index(parentPath, "root:"); i >= 0 {
+		dir = parentPath[i+len("root:"):]
+	}
+	full := path.Join(dir, name)
+	remote = strings.TrimSuffix(remote, "/")
+	if remote == "" {
+		return strings.TrimPrefix(full, "/")
+	}
+	if !strings.HasPrefix(full, remote+"/") {
+		return ""
+	}
+	return strings.TrimPrefix(full, remote+"/")
+}
+
+// driveJoinRef 把远程基础资源和相对路径拼成 Graph 路径寻址形式。
+func driveJoinRef(base, rel string) string {
+	if rel == "" {
+		return base
+	}
+	return base + ":/" + rel + ":"
+}
+
+// ensureRemoteFolder 逐级确保远程文件夹存在。文件夹已存在时 Graph
+// 返回 nameAlreadyExists, 视为成功。
+func ensureRemoteFolder(client graph.Client, base, rel string) error {
+	if rel == "" || rel == "." {
+		return nil
+	}
+	ctx := context.Background()
+	prefix := ""
+	for _, seg := range strings.Split(rel, "/") {
+		parent := base
+		if prefix != "" {
+			parent = driveJoinRef(base, prefix)
+		}
+		body := map[string]interface{}{
+			"name":   seg,
+			"folder": map[string]interface{}{},
+		}
+		if _, err := client.Post(ctx, parent+"/children", body); err != nil {
+			if !strings.Contains(err.Error(), "nameAlreadyExists") {
+				return err
+			}
+		}
+		prefix = path.Join(prefix, seg)
+	}
+	return nil
+}
+
+// uploadTree 递归上传本地目录, prefix 是远程根下的目标路径。
+func uploadTree(client graph.Client, localDir, prefix string) (int, error) {
+	ctx := context.Background()
+	count := 0
+	err := filepath.WalkDir(localDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(localDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if d.IsDir() {
+			if rel == "." {
+				return ensureRemoteFolder(client, "/me/drive/root", prefix)
+			}
+			return ensureRemoteFolder(client, "/me/drive/root", path.Join(prefix, rel))
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		ref := driveJoinRef("/me/drive/root", path.Join(prefix, rel))
+		if _, err := client.Put(ctx, ref+"/content", data, "application/octet-stream"); err != nil {
+			return err
+		}
+		fmt.Printf("↑ %s\n", rel)
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// downloadTree 递归下载远程文件夹到本地目录。
+func downloadTree(client graph.Client, ref, outDir string) (int, error) {
+	ctx := context.Background()
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return 0, err
+	}
+
+	raw, _, err := graph.GetPaged(ctx, client, ref+"/children", nil, 0)
+	if err != nil {
+		return 0, err
+	}
+	items := make([]DriveItem, 0, len(raw))
+	for _, data := range raw {
+		var item DriveItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return 0, err
+		}
+		items = append(items, item)
+	}
+
+	count := 0
+	for _, item := range items {
+		if item.Folder != nil {
+			n, err := downloadTree(client, fmt.Sprintf("/me/drive/items/%s", item.ID), filepath.Join(outDir, item.Name))
+			count += n
+			if err != nil {
+				return count, err
+			}
+			continue
+		}
+		content, err := client.Get(ctx, fmt.Sprintf("/me/drive/items/%s/content", item.ID), nil)
+		if err != nil {
+			return count, err
+		}
+		outPath := filepath.Join(outDir, item.Name)
+		if err := os.WriteFile(outPath, content, 0644); err != nil {
+			return count, err
+		}
+		fmt.Printf("↓ %s\n", outPath)
+		count++
+	}
+	return count, nil
+}
+
+// driveSyncStatePath 返回同步状态文件的路径。
+func driveSyncStatePath() (string, error) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "drive_sync.json"), nil
+}
+
+// loadDriveSyncStates 加载所有目录对的同步状态。
+func loadDriveSyncStates() (map[string]*driveSyncState, error) {
+	states := make(map[string]*driveSyncState)
+
+	path, err := driveSyncStatePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return states, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, err
+	}
+	for _, s := range states {
+		if s.Files == nil {
+			s.Files = make(map[string]*driveSyncEntry)
+		}
+	}
+	return states, nil
+}
+
+// saveDriveSyncStates 持久化同步状态。
+func saveDriveSyncStates(states map[string]*driveSyncState) error {
+	path, err := driveSyncStatePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
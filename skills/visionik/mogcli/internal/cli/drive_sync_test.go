@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestRemoteSyncRel(t *testing.T) {
+	assert.Equal(t, "report.docx", remoteSyncRel("/drive/root:/Backup", "report.docx", "/Backup"))
+	assert.Equal(t, "sub/a.txt", remoteSyncRel("/drive/root:/Backup/sub", "a.txt", "/Backup"))
+	assert.Equal(t, "", remoteSyncRel("/drive/root:/Other", "a.txt", "/Backup"))
+	assert.Equal(t, "Backup/a.txt", remoteSyncRel("/drive/root:/Backup", "a.txt", "/"))
+}
+
+func TestDriveJoinRef(t *testing.T) {
+	assert.Equal(t, "/me/drive/root:/Backup/a.txt:", driveJoinRef("/me/drive/root", "Backup/a.txt"))
+	assert.Equal(t, "/me/drive/root", driveJoinRef("/me/drive/root", ""))
+}
+
+func TestDownloadTree(t *testing.T) {
+	outDir := t.TempDir()
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch path {
+			case "/me/drive/items/dir-1/children":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "f-1", "name": "a.txt"},
+						{"id": "dir-2", "name": "sub", "folder": map[string]int{"childCount": 1}},
+					},
+				}), nil
+			case "/me/drive/items/dir-2/children":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "f-2", "name": "b.txt"},
+					},
+				}), nil
+			case "/me/drive/items/f-1/content":
+				return []byte("aaa"), nil
+			case "/me/drive/items/f-2/content":
+				return []byte("bbb"), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+
+	var count int
+	var err error
+	captureOutput(func() { count, err = downloadTree(mock, "/me/drive/items/dir-1", outDir) })
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	content, err := os.ReadFile(filepath.Join(outDir, "sub", "b.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "bbb", string(content))
+}
+
+func TestUploadTree(t *testing.T) {
+	localDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(localDir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "a.txt"), []byte("aaa"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "sub", "b.txt"), []byte("bbb"), 0644))
+
+	var putPaths []string
+	var mkdirPaths []string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			mkdirPaths = append(mkdirPaths, path)
+			return mustJSON(map[string]string{"id": "dir-x"}), nil
+		},
+		PutFunc: func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+			putPaths = append(putPaths, path)
+			return mustJSON(map[string]string{"id": "f-x"}), nil
+		},
+	}
+
+	var count int
+	var err error
+	captureOutput(func() { count, err = uploadTree(mock, localDir, "Backup") })
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Contains(t, putPaths, "/me/drive/root:/Backup/a.txt:/content")
+	assert.Contains(t, putPaths, "/me/drive/root:/Backup/sub/b.txt:/content")
+	assert.Contains(t, mkdirPaths, "/me/drive/root:/Backup:/children")
+}
+
+func TestDriveSyncCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	localDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "local.txt"), []byte("local"), 0644))
+
+	var putPaths []string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch path {
+			case "/me/drive/root:/Backup:/delta":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{
+							"id":                   "f-remote",
+							"name":                 "remote.txt",
+							"size":                 6,
+							"lastModifiedDateTime": "2026-03-01T00:00:00Z",
+							"file":                 map[string]string{"mimeType": "text/plain"},
+							"parentReference":      map[string]string{"path": "/drive/root:/Backup"},
+						},
+					},
+					"@odata.deltaLink": "https://graph.microsoft.com/v1.0/me/drive/root/delta?$deltatoken=final",
+				}), nil
+			case "/me/drive/items/f-remote/content":
+				return []byte("remote"), nil
+			}
+			t.Fatalf("unexpected GET path: %s", path)
+			return nil, nil
+		},
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			return mustJSON(map[string]string{"id": "dir-x"}), nil
+		},
+		PutFunc: func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+			putPaths = append(putPaths, path)
+			return mustJSON(map[string]string{"id": "f-local", "lastModifiedDateTime": "2026-03-01T00:01:00Z"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveSyncCmd{Local: localDir, Remote: "/Backup"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "↓ remote.txt")
+	assert.Contains(t, output, "↑ local.txt")
+	assert.Equal(t, []string{"/me/drive/root:/Backup/local.txt:/content"}, putPaths)
+	assert.FileExists(t, filepath.Join(localDir, "remote.txt"))
+
+	// 状态已持久化, 再次同步时未变更的文件不再上传
+	states, err := loadDriveSyncStates()
+	require.NoError(t, err)
+	state := states[mustAbs(t, localDir)+" -> /Backup"]
+	require.NotNil(t, state)
+	assert.Contains(t, state.DeltaLink, "deltatoken=final")
+	require.NotNil(t, state.Files["local.txt"])
+	assert.Equal(t, "2026-03-01T00:01:00Z", state.Files["local.txt"].RemoteModified)
+}
+
+// mustAbs 返回绝对路径, 失败时终止测试。
+func mustAbs(t *testing.T, p string) string {
+	t.Helper()
+	abs, err := filepath.Abs(p)
+	require.NoError(t, err)
+	return abs
+}
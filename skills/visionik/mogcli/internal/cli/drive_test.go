@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/graph"
 	"github.com/visionik/mogcli/internal/testutil"
 )
 
@@ -190,7 +191,7 @@ func TestDriveGetCmd_Run(t *testing.T) {
 	}{
 		{
 			name: "successful get",
-			cmd:  &DriveGetCmd{ID: "file-123"},
+			cmd:  &DriveGetCmd{IDs: []string{"file-123"}},
 			root: &Root{},
 			mockResp: mustJSON(map[string]interface{}{
 				"id":                   "file-123",
@@ -204,7 +205,7 @@ func TestDriveGetCmd_Run(t *testing.T) {
 		},
 		{
 			name: "get with JSON output",
-			cmd:  &DriveGetCmd{ID: "file-123"},
+			cmd:  &DriveGetCmd{IDs: []string{"file-123"}},
 			root: &Root{JSON: true},
 			mockResp: mustJSON(map[string]interface{}{
 				"id":   "file-123",
@@ -214,7 +215,7 @@ func TestDriveGetCmd_Run(t *testing.T) {
 		},
 		{
 			name:    "file not found",
-			cmd:     &DriveGetCmd{ID: "invalid"},
+			cmd:     &DriveGetCmd{IDs: []string{"invalid"}},
 			root:    &Root{},
 			mockErr: errors.New("ResourceNotFound"),
 			wantErr: true,
@@ -689,3 +690,81 @@ func TestFormatSize(t *testing.T) {
 		})
 	}
 }
+
+func TestDriveGetCmd_MultipleUsesBatch(t *testing.T) {
+	var gotReqs []graph.BatchRequest
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/$batch", path)
+			gotReqs = batchRequestsOf(t, body)
+			return mustJSON(map[string]interface{}{
+				"responses": []map[string]interface{}{
+					{"id": "1", "status": 200, "body": map[string]interface{}{"id": "file-1", "name": "a.txt", "size": 10}},
+					{"id": "2", "status": 200, "body": map[string]interface{}{"id": "file-2", "name": "b.txt", "size": 20}},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveGetCmd{IDs: []string{"file-1", "file-2"}}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	require.Len(t, gotReqs, 2)
+	assert.Equal(t, "GET", gotReqs[0].Method)
+	assert.Contains(t, gotReqs[0].URL, "/me/drive/items/file-1?")
+	assert.Contains(t, gotReqs[0].URL, "%24select=")
+	assert.Contains(t, output, "a.txt")
+	assert.Contains(t, output, "b.txt")
+}
+
+func TestDriveSearchPath(t *testing.T) {
+	// 未限定: 整个驱动器
+	assert.Equal(t, "/me/drive/root/search(q='report')", driveSearchPath("", "report"))
+
+	// 路径形式的文件夹
+	assert.Equal(t, "/me/drive/root:/Documents:/search(q='report')",
+		driveSearchPath("Documents", "report"))
+
+	// 长 ID 形式的文件夹
+	longID := "01ABCDEFGHIJKLMNOPQRSTUVWXYZ123456"
+	assert.Equal(t, "/me/drive/items/"+longID+"/search(q='report')",
+		driveSearchPath(longID, "report"))
+}
+
+func TestDriveSearchCmd_Folder(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPath = path
+			return mustJSON(map[string]interface{}{"value": []interface{}{}}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveSearchCmd{Query: "report", Max: 25, Folder: "Projects/2026"}
+	captureOutput(func() { _ = cmd.Run(root) })
+
+	assert.Equal(t, "/me/drive/root:/Projects/2026:/search(q='report')", gotPath)
+}
+
+func TestSharedWithMeItems(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/sharedWithMe", path)
+			assert.Equal(t, "10", query.Get("$top"))
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "shared-1", "name": "budget.xlsx"},
+				},
+			}), nil
+		},
+	}
+
+	items, err := sharedWithMeItems(context.Background(), mock, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "budget.xlsx", items[0].Name)
+}
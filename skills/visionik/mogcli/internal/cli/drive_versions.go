@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// DriveVersionsCmd 管理文件历史版本。
+type DriveVersionsCmd struct {
+	List    DriveVersionsListCmd    `cmd:"" default:"withargs" help:"列出文件版本"`
+	Restore DriveVersionsRestoreCmd `cmd:"" help:"恢复到指定版本"`
+}
+
+// driveItemVersion 表示文件的单个历史版本。
+type driveItemVersion struct {
+	ID                   string `json:"id"`
+	Size                 int64  `json:"size"`
+	LastModifiedDateTime string `json:"lastModifiedDateTime"`
+	LastModifiedBy       *struct {
+		User struct {
+			DisplayName string `json:"displayName"`
+		} `json:"user"`
+	} `json:"lastModifiedBy"`
+}
+
+// DriveVersionsListCmd 列出文件版本。
+type DriveVersionsListCmd struct {
+	ID string `arg:"" help:"文件 ID 或路径"`
+}
+
+// Run 执行版本列表命令。
+func (c *DriveVersionsListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, driveItemRef(c.ID)+"/versions", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []driveItemVersion `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, ver := range resp.Value {
+		by := ""
+		if ver.LastModifiedBy != nil {
+			by = ver.LastModifiedBy.User.DisplayName
+		}
+		fmt.Printf("%-12s %-20s %8s  %s\n", ver.ID, ver.LastModifiedDateTime, formatSize(ver.Size), by)
+	}
+	return nil
+}
+
+// DriveVersionsRestoreCmd 恢复到指定版本。
+type DriveVersionsRestoreCmd struct {
+	ID      string `arg:"" help:"文件 ID 或路径"`
+	Version string `arg:"" help:"版本 ID (见 versions list)"`
+}
+
+// Run 执行版本恢复命令。
+func (c *DriveVersionsRestoreCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("%s/versions/%s/restoreVersion", driveItemRef(c.ID), c.Version)
+	if _, err := client.Post(ctx, path, nil); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已恢复到版本 %s\n", c.Version)
+	return nil
+}
+
+// DriveTrashCmd 查看回收站。
+type DriveTrashCmd struct {
+	List DriveTrashListCmd `cmd:"" default:"1" help:"列出回收站中的项目"`
+}
+
+// DriveTrashListCmd 列出回收站中的项目。
+type DriveTrashListCmd struct{}
+
+// Run 执行回收站列表命令。
+func (c *DriveTrashListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/drive/recycleBin/items", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []struct {
+			ID                  string `json:"id"`
+			Name                string `json:"name"`
+			Size                int64  `json:"size"`
+			DeletedDateTime     string `json:"deletedDateTime"`
+			DeletedFromLocation string `json:"deletedFromLocation"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("回收站为空")
+		return nil
+	}
+
+	for _, item := range resp.Value {
+		fmt.Printf("%-40s %8s  %-20s %s\n", item.Name, formatSize(item.Size), item.DeletedDateTime, graph.FormatID(item.ID))
+		if item.DeletedFromLocation != "" && root.Verbose {
+			fmt.Printf("  来自: %s\n", item.DeletedFromLocation)
+		}
+	}
+	return nil
+}
+
+// DriveRestoreCmd 从回收站恢复已删除的文件。
+type DriveRestoreCmd struct {
+	ID     string `arg:"" help:"已删除项目的 ID (见 trash list)"`
+	Parent string `help:"恢复到的目标文件夹 ID (默认: 原位置)"`
+	Name   string `help:"恢复时重命名"`
+}
+
+// Run 执行恢复命令。
+func (c *DriveRestoreCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{}
+	if c.Parent != "" {
+		body["parentReference"] = map[string]string{"id": graph.ResolveID(c.Parent)}
+	}
+	if c.Name != "" {
+		body["name"] = c.Name
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/restore", graph.ResolveID(c.ID))
+	data, err := client.Post(ctx, path, body)
+	if err != nil {
+		return err
+	}
+
+	var item DriveItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已恢复: %s (%s)\n", item.Name, graph.FormatID(item.ID))
+	return nil
+}
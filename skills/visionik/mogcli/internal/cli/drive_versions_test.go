@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestDriveVersionsListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/file-123/versions", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id":                   "2.0",
+						"size":                 2048,
+						"lastModifiedDateTime": "2026-03-01T10:00:00Z",
+						"lastModifiedBy": map[string]interface{}{
+							"user": map[string]string{"displayName": "Alice"},
+						},
+					},
+					{"id": "1.0", "size": 1024, "lastModifiedDateTime": "2026-02-01T10:00:00Z"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&DriveVersionsListCmd{ID: "file-123"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "2.0")
+	assert.Contains(t, output, "Alice")
+}
+
+func TestDriveVersionsRestoreCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveVersionsRestoreCmd{ID: "file-123", Version: "1.0"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/file-123/versions/1.0/restoreVersion", gotPath)
+	assert.Contains(t, output, "已恢复到版本 1.0")
+}
+
+func TestDriveTrashListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/recycleBin/items", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "del-1", "name": "old.docx", "size": 512, "deletedDateTime": "2026-03-01T10:00:00Z"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&DriveTrashListCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "old.docx")
+}
+
+func TestDriveRestoreCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return mustJSON(map[string]string{"id": "file-123", "name": "old.docx"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &DriveRestoreCmd{ID: "del-1", Name: "recovered.docx"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/del-1/restore", gotPath)
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, "recovered.docx", body["name"])
+	assert.Contains(t, output, "已恢复")
+}
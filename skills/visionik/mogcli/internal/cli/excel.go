@@ -1,34 +1,51 @@
 package cli
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/visionik/mogcli/internal/graph"
+	"github.com/visionik/mogcli/internal/output"
 )
 
 // ExcelCmd 处理Excel操作。
 type ExcelCmd struct {
-	List     ExcelListCmd     `cmd:"" help:"列出Excel工作簿"`
-	Metadata ExcelMetadataCmd `cmd:"" help:"列出工作簿中的工作表"`
-	Get      ExcelGetCmd      `cmd:"" help:"从工作表读取数据"`
-	Update   ExcelUpdateCmd   `cmd:"" help:"向工作表写入数据"`
-	Append   ExcelAppendCmd   `cmd:"" help:"向表格追加数据"`
-	Create   ExcelCreateCmd   `cmd:"" help:"创建新工作簿"`
-	AddSheet ExcelAddSheetCmd `cmd:"" help:"添加工作表" name:"add-sheet"`
-	Tables   ExcelTablesCmd   `cmd:"" help:"列出工作簿中的表格"`
-	Clear    ExcelClearCmd    `cmd:"" help:"清空区域"`
-	Export   ExcelExportCmd   `cmd:"" help:"导出工作簿"`
-	Copy     ExcelCopyCmd     `cmd:"" help:"复制工作簿"`
+	List          ExcelListCmd          `cmd:"" help:"列出Excel工作簿"`
+	Metadata      ExcelMetadataCmd      `cmd:"" help:"列出工作簿中的工作表"`
+	Get           ExcelGetCmd           `cmd:"" help:"从工作表读取数据"`
+	Update        ExcelUpdateCmd        `cmd:"" help:"向工作表写入数据"`
+	Append        ExcelAppendCmd        `cmd:"" help:"向表格追加数据"`
+	Create        ExcelCreateCmd        `cmd:"" help:"创建新工作簿"`
+	AddSheet      ExcelAddSheetCmd      `cmd:"" help:"添加工作表" name:"add-sheet"`
+	RenameSheet   ExcelRenameSheetCmd   `cmd:"" help:"重命名工作表" name:"rename-sheet"`
+	DeleteSheet   ExcelDeleteSheetCmd   `cmd:"" help:"删除工作表" name:"delete-sheet"`
+	ProtectSheet  ExcelProtectSheetCmd  `cmd:"" help:"保护/取消保护工作表" name:"protect-sheet"`
+	Names         ExcelNamesCmd         `cmd:"" help:"命名区域 (列出/读取/定义)"`
+	Tables        ExcelTablesCmd        `cmd:"" help:"列出工作簿中的表格"`
+	Rows          ExcelRowsCmd          `cmd:"" help:"查询表格行 (可过滤)"`
+	DeleteRow     ExcelDeleteRowCmd     `cmd:"" help:"删除表格行" name:"delete-row"`
+	Columns       ExcelColumnsCmd       `cmd:"" help:"列出表格的列"`
+	Charts        ExcelChartsCmd        `cmd:"" help:"图表 (列出/创建)"`
+	ChartImage    ExcelChartImageCmd    `cmd:"" help:"渲染图表为 PNG" name:"chart-image"`
+	RefreshPivots ExcelRefreshPivotsCmd `cmd:"" help:"刷新数据透视表" name:"refresh-pivots"`
+	Clear         ExcelClearCmd         `cmd:"" help:"清空区域"`
+	Export        ExcelExportCmd        `cmd:"" help:"导出工作簿"`
+	Copy          ExcelCopyCmd          `cmd:"" help:"复制工作簿"`
 }
 
 // ExcelListCmd 列出工作簿。
 type ExcelListCmd struct {
-	Max int `help:"最大结果数" default:"50"`
+	Max    int    `help:"最大结果数" default:"50"`
+	Folder string `help:"限定到文件夹 (ID 或路径)"`
+	Mine   bool   `help:"仅自己的驱动器 (默认)" xor:"scope"`
+	Shared bool   `name:"shared-with-me" help:"仅他人共享给我的文件" xor:"scope"`
 }
 
 // Run 执行excel list命令。
@@ -39,42 +56,69 @@ func (c *ExcelListCmd) Run(root *Root) error {
 	}
 
 	ctx := context.Background()
-	query := url.Values{}
-	query.Set("$top", fmt.Sprintf("%d", c.Max))
-	query.Set("$filter", "file/mimeType eq 'application/vnd.openxmlformats-officedocument.spreadsheetml.sheet'")
-	query.Set("$orderby", "lastModifiedDateTime desc")
 
-	data, err := client.Get(ctx, "/me/drive/root/search(q='.xlsx')", query)
-	if err != nil {
-		return err
-	}
+	var items []DriveItem
+	if c.Shared {
+		if c.Folder != "" {
+			return fmt.Errorf("--folder 不能与 --shared-with-me 同时使用")
+		}
+		shared, err := sharedWithMeItems(ctx, client, c.Max)
+		if err != nil {
+			return err
+		}
+		// sharedWithMe 不支持 $filter, 按扩展名过滤
+		for _, item := range shared {
+			if strings.HasSuffix(strings.ToLower(item.Name), ".xlsx") {
+				items = append(items, item)
+			}
+		}
+	} else {
+		query := url.Values{}
+		query.Set("$top", fmt.Sprintf("%d", c.Max))
+		query.Set("$filter", "file/mimeType eq 'application/vnd.openxmlformats-officedocument.spreadsheetml.sheet'")
+		query.Set("$orderby", "lastModifiedDateTime desc")
 
-	var resp struct {
-		Value []DriveItem `json:"value"`
-	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return err
+		data, err := client.Get(ctx, driveSearchPath(c.Folder, ".xlsx"), query)
+		if err != nil {
+			return err
+		}
+
+		var resp struct {
+			Value []DriveItem `json:"value"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+		items = resp.Value
 	}
 
 	if root.JSON {
-		return outputJSON(resp.Value)
+		return outputJSON(items)
 	}
 
-	if len(resp.Value) == 0 {
+	if root.Plain {
+		t := output.NewTable("id", "name", "size", "modified")
+		for _, wb := range items {
+			t.Row(graph.FormatID(wb.ID), wb.Name, strconv.FormatInt(wb.Size, 10), wb.LastModifiedDateTime)
+		}
+		return t.Render(os.Stdout, true)
+	}
+
+	if len(items) == 0 {
 		fmt.Println("未找到Excel工作簿")
 		return nil
 	}
 
 	fmt.Println("Excel工作簿")
 	fmt.Println()
-	for _, wb := range resp.Value {
+	for _, wb := range items {
 		fmt.Printf("📊 %s  %s  %s\n", wb.Name, formatSize(wb.Size), wb.LastModifiedDateTime[:10])
 		fmt.Printf("   ID: %s\n", graph.FormatID(wb.ID))
 		if root.Verbose && wb.WebURL != "" {
 			fmt.Printf("   URL: %s\n", wb.WebURL)
 		}
 	}
-	fmt.Printf("\n%d 个工作簿\n", len(resp.Value))
+	fmt.Printf("\n%d 个工作簿\n", len(items))
 	return nil
 }
 
@@ -359,7 +403,6 @@ func (c *ExcelCreateCmd) Run(root *Root) error {
 	}
 
 	// 通过上传最小的xlsx内容创建空工作簿
-	// 为简单起见，我们将创建一个空文件并让Graph处理它
 	ctx := context.Background()
 	var path string
 	if c.Folder != "" {
@@ -368,7 +411,6 @@ func (c *ExcelCreateCmd) Run(root *Root) error {
 		path = fmt.Sprintf("/me/drive/root:/%s:/content", name)
 	}
 
-	// 最小的xlsx内容（空工作簿）
 	emptyXlsx := getMinimalXlsx()
 
 	data, err := client.Put(ctx, path, emptyXlsx, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
@@ -575,7 +617,7 @@ func (c *ExcelExportCmd) Run(root *Root) error {
 			csv.WriteString(strings.Join(cells, ",") + "\n")
 		}
 
-		if err := os.WriteFile(c.Out, []byte(csv.String()), 0644); err != nil {
+		if err := writeFileAtomic(c.Out, []byte(csv.String()), 0644); err != nil {
 			return err
 		}
 
@@ -591,7 +633,7 @@ func (c *ExcelExportCmd) Run(root *Root) error {
 			return err
 		}
 
-		if err := os.WriteFile(c.Out, data, 0644); err != nil {
+		if err := writeFileAtomic(c.Out, data, 0644); err != nil {
 			return err
 		}
 
@@ -608,6 +650,7 @@ type ExcelCopyCmd struct {
 	ID     string `arg:"" help:"工作簿ID"`
 	Name   string `arg:"" help:"新名称"`
 	Folder string `help:"目标文件夹ID"`
+	Wait   bool   `help:"等待复制完成并输出新文件 ID"`
 }
 
 // Run 执行excel copy命令。
@@ -626,16 +669,25 @@ func (c *ExcelCopyCmd) Run(root *Root) error {
 		}
 	}
 
-	ctx := context.Background()
 	path := fmt.Sprintf("/me/drive/items/%s/copy", graph.ResolveID(c.ID))
-
-	_, err = client.Post(ctx, path, body)
+	newID, err := runCopy(client, path, body, c.Wait)
 	if err != nil {
 		return err
 	}
 
 	if root.JSON {
-		return outputJSON(map[string]interface{}{"success": true, "name": c.Name})
+		out := map[string]interface{}{"success": true, "name": c.Name}
+		if c.Wait {
+			out["id"] = newID
+		}
+		return outputJSON(out)
+	}
+
+	if c.Wait {
+		fmt.Println("✓ 复制完成")
+		fmt.Printf("  名称: %s\n", c.Name)
+		fmt.Printf("  ID: %s\n", graph.FormatID(newID))
+		return nil
 	}
 
 	fmt.Println("✓ 复制已启动")
@@ -730,10 +782,38 @@ func parseCell(cell string) (col, row int) {
 	return col, row
 }
 
-// getMinimalXlsx 返回最小有效的xlsx文件
+// getMinimalXlsx 在内存中生成最小但有效的xlsx文件：
+// 一个包含必需部件和单个空工作表的zip包，保证能在Excel中打开。
 func getMinimalXlsx() []byte {
-	// 这是一个base64解码的最小xlsx文件
-	// 实际上，您可能需要使用适当的xlsx库
-	// 现在，我们将依赖Graph API来处理空内容
-	return []byte{}
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`},
+		{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`},
+		{"xl/worksheets/sheet1.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`},
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil
+	}
+	return buf.Bytes()
 }
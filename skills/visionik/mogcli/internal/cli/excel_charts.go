@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// ExcelChartsCmd 管理工作表中的图表。
+type ExcelChartsCmd struct {
+	List ExcelChartsListCmd `cmd:"" default:"withargs" help:"列出工作表中的图表"`
+	Add  ExcelChartsAddCmd  `cmd:"" help:"根据数据区域创建图表"`
+}
+
+// chartTypeAliases 把常用简称映射到 Graph 的图表类型名。
+var chartTypeAliases = map[string]string{
+	"line":    "Line",
+	"bar":     "BarClustered",
+	"column":  "ColumnClustered",
+	"pie":     "Pie",
+	"area":    "Area",
+	"scatter": "XYScatter",
+}
+
+// ExcelChartsListCmd 列出工作表中的图表。
+type ExcelChartsListCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Sheet string `arg:"" help:"工作表名称"`
+}
+
+// Run 执行excel charts list命令。
+func (c *ExcelChartsListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')/charts",
+		graph.ResolveID(c.ID), c.Sheet)
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []struct {
+			ID     string  `json:"id"`
+			Name   string  `json:"name"`
+			Width  float64 `json:"width"`
+			Height float64 `json:"height"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("工作表中未找到图表")
+		return nil
+	}
+
+	for _, chart := range resp.Value {
+		fmt.Printf("📈 %s (%.0f×%.0f)\n", chart.Name, chart.Width, chart.Height)
+	}
+	return nil
+}
+
+// ExcelChartsAddCmd 根据数据区域创建图表。
+type ExcelChartsAddCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Sheet string `arg:"" help:"工作表名称"`
+	Type  string `help:"图表类型 (line, bar, column, pie, area, scatter)" default:"line"`
+	Range string `help:"数据区域 (如 A1:C20)" required:""`
+}
+
+// Run 执行excel charts add命令。
+func (c *ExcelChartsAddCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	chartType := c.Type
+	if mapped, ok := chartTypeAliases[strings.ToLower(c.Type)]; ok {
+		chartType = mapped
+	}
+
+	body := map[string]interface{}{
+		"type":       chartType,
+		"sourceData": c.Range,
+		"seriesBy":   "Auto",
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')/charts/add",
+		graph.ResolveID(c.ID), c.Sheet)
+
+	data, err := client.Post(ctx, path, body)
+	if err != nil {
+		return err
+	}
+
+	var chart struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &chart); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]interface{}{"success": true, "name": chart.Name, "type": chartType})
+	}
+
+	fmt.Printf("✓ 已创建图表: %s (%s)\n", chart.Name, chartType)
+	return nil
+}
+
+// ExcelChartImageCmd 渲染图表为 PNG 图片。
+type ExcelChartImageCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Sheet string `arg:"" help:"工作表名称"`
+	Chart string `arg:"" help:"图表名称"`
+	Out   string `help:"输出路径 ('-' 表示标准输出)" required:""`
+	Width int    `help:"图片宽度 (0 = 默认)" default:"0"`
+}
+
+// Run 执行excel chart-image命令。
+func (c *ExcelChartImageCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')/charts('%s')/image(width=%d,height=0,fittingMode='fit')",
+		graph.ResolveID(c.ID), c.Sheet, c.Chart, c.Width)
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	image, err := base64.StdEncoding.DecodeString(resp.Value)
+	if err != nil {
+		return fmt.Errorf("无法解码图表图片: %w", err)
+	}
+
+	if c.Out == "-" {
+		_, err := os.Stdout.Write(image)
+		return err
+	}
+	if err := writeFileAtomic(c.Out, image, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已保存图表图片: %s\n", c.Out)
+	return nil
+}
+
+// ExcelRefreshPivotsCmd 刷新工作表中的所有数据透视表。
+type ExcelRefreshPivotsCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Sheet string `arg:"" help:"工作表名称"`
+}
+
+// Run 执行excel refresh-pivots命令。
+func (c *ExcelRefreshPivotsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')/pivotTables/refreshAll",
+		graph.ResolveID(c.ID), c.Sheet)
+
+	if _, err := client.Post(ctx, path, nil); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 已刷新数据透视表")
+	return nil
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestExcelChartsListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/wb-1/workbook/worksheets('Sheet1')/charts", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "ch-1", "name": "Chart 1", "width": 400.0, "height": 300.0},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&ExcelChartsListCmd{ID: "wb-1", Sheet: "Sheet1"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Chart 1")
+}
+
+func TestExcelChartsAddCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/wb-1/workbook/worksheets('Sheet1')/charts/add", path)
+			gotBody = body
+			return mustJSON(map[string]string{"name": "Chart 2"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &ExcelChartsAddCmd{ID: "wb-1", Sheet: "Sheet1", Type: "line", Range: "A1:C20"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, "Line", body["type"])
+	assert.Equal(t, "A1:C20", body["sourceData"])
+	assert.Contains(t, output, "已创建图表")
+}
+
+func TestExcelChartImageCmd_Run(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G'}
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Contains(t, path, "/charts('Chart 1')/image(")
+			return mustJSON(map[string]string{"value": base64.StdEncoding.EncodeToString(png)}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	outFile := filepath.Join(t.TempDir(), "chart.png")
+	cmd := &ExcelChartImageCmd{ID: "wb-1", Sheet: "Sheet1", Chart: "Chart 1", Out: outFile}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	content, err := os.ReadFile(outFile)
+	require.NoError(t, err)
+	assert.Equal(t, png, content)
+}
+
+func TestExcelRefreshPivotsCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&ExcelRefreshPivotsCmd{ID: "wb-1", Sheet: "Sheet1"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/wb-1/workbook/worksheets('Sheet1')/pivotTables/refreshAll", gotPath)
+	assert.Contains(t, output, "已刷新")
+}
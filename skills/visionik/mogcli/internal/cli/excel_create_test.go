@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestGetMinimalXlsx(t *testing.T) {
+	data := getMinimalXlsx()
+	require.NotEmpty(t, data)
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	names := make(map[string]bool)
+	for _, f := range zr.File {
+		names[f.Name] = true
+	}
+	for _, want := range []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels",
+		"xl/worksheets/sheet1.xml",
+	} {
+		assert.True(t, names[want], "missing part: %s", want)
+	}
+}
+
+func TestExcelCreateCmd_UploadsValidXlsx(t *testing.T) {
+	var gotData []byte
+	mock := &testutil.MockClient{
+		PutFunc: func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+			gotData = data
+			return mustJSON(map[string]string{"id": "wb-new", "name": "report.xlsx"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &ExcelCreateCmd{Name: "report"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	require.NotEmpty(t, gotData)
+	_, err = zip.NewReader(bytes.NewReader(gotData), int64(len(gotData)))
+	assert.NoError(t, err)
+}
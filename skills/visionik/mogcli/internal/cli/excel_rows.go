@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// ExcelRowsCmd 查询表格行, 支持按列值过滤, 把表格当作轻量数据库使用。
+type ExcelRowsCmd struct {
+	ID     string `arg:"" help:"工作簿ID"`
+	Table  string `arg:"" help:"表格名称"`
+	Filter string `help:"按 '列=值' 过滤行 (如 'Status=Open')"`
+	Max    int    `help:"最大行数 (0 = 全部)" default:"0"`
+}
+
+// Run 执行excel rows命令。
+func (c *ExcelRowsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	workbookID := graph.ResolveID(c.ID)
+
+	headers, rows, err := getTableData(client, ctx, workbookID, c.Table)
+	if err != nil {
+		return err
+	}
+
+	if c.Filter != "" {
+		rows, err = filterTableRows(headers, rows, c.Filter)
+		if err != nil {
+			return err
+		}
+	}
+	if c.Max > 0 && len(rows) > c.Max {
+		rows = rows[:c.Max]
+	}
+
+	if root.JSON {
+		objects := make([]map[string]interface{}, 0, len(rows))
+		for _, row := range rows {
+			obj := make(map[string]interface{}, len(headers))
+			for i, h := range headers {
+				if i < len(row) {
+					obj[h] = row[i]
+				}
+			}
+			objects = append(objects, obj)
+		}
+		return outputJSON(objects)
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("没有匹配的行")
+		return nil
+	}
+
+	line := strings.Join(headers, "\t")
+	fmt.Println(line)
+	fmt.Println(strings.Repeat("-", len(line)))
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = fmt.Sprintf("%v", cell)
+		}
+		fmt.Println(strings.Join(cells, "\t"))
+	}
+	fmt.Printf("\n%d 行\n", len(rows))
+	return nil
+}
+
+// ExcelDeleteRowCmd 删除表格中的一行。
+type ExcelDeleteRowCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Table string `arg:"" help:"表格名称"`
+	Index int    `arg:"" help:"行索引 (从 0 开始, 不含标题行)"`
+}
+
+// Run 执行excel delete-row命令。
+func (c *ExcelDeleteRowCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/tables('%s')/rows/itemAt(index=%d)",
+		graph.ResolveID(c.ID), c.Table, c.Index)
+
+	if err := client.Delete(ctx, path); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]interface{}{"success": true, "table": c.Table, "index": c.Index})
+	}
+
+	fmt.Printf("✓ 已删除第 %d 行\n", c.Index)
+	return nil
+}
+
+// ExcelColumnsCmd 列出表格的列。
+type ExcelColumnsCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Table string `arg:"" help:"表格名称"`
+}
+
+// Run 执行excel columns命令。
+func (c *ExcelColumnsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/tables('%s')/columns",
+		graph.ResolveID(c.ID), c.Table)
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []struct {
+			Index int    `json:"index"`
+			Name  string `json:"name"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, col := range resp.Value {
+		fmt.Printf("%3d  %s\n", col.Index, col.Name)
+	}
+	return nil
+}
+
+// getTableData 读取表格的标题行和数据行。
+func getTableData(client graph.Client, ctx context.Context, workbookID, table string) ([]string, [][]interface{}, error) {
+	base := fmt.Sprintf("/me/drive/items/%s/workbook/tables('%s')", workbookID, table)
+
+	data, err := client.Get(ctx, base+"/headerRowRange", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var headerRange RangeData
+	if err := json.Unmarshal(data, &headerRange); err != nil {
+		return nil, nil, err
+	}
+	if len(headerRange.Values) == 0 {
+		return nil, nil, fmt.Errorf("表格 %s 没有标题行", table)
+	}
+	headers := make([]string, len(headerRange.Values[0]))
+	for i, cell := range headerRange.Values[0] {
+		headers[i] = fmt.Sprintf("%v", cell)
+	}
+
+	data, err = client.Get(ctx, base+"/dataBodyRange", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var bodyRange RangeData
+	if err := json.Unmarshal(data, &bodyRange); err != nil {
+		return nil, nil, err
+	}
+	return headers, bodyRange.Values, nil
+}
+
+// filterTableRows 按 '列=值' 表达式过滤行。列名不区分大小写。
+func filterTableRows(headers []string, rows [][]interface{}, filter string) ([][]interface{}, error) {
+	column, want, found := strings.Cut(filter, "=")
+	if !found {
+		return nil, fmt.Errorf("无效的过滤表达式: %s (使用 '列=值')", filter)
+	}
+
+	colIndex := -1
+	for i, h := range headers {
+		if strings.EqualFold(h, strings.TrimSpace(column)) {
+			colIndex = i
+			break
+		}
+	}
+	if colIndex < 0 {
+		return nil, fmt.Errorf("未找到列: %s (可用: %s)", column, strings.Join(headers, ", "))
+	}
+
+	var matched [][]interface{}
+	for _, row := range rows {
+		if colIndex < len(row) && fmt.Sprintf("%v", row[colIndex]) == want {
+			matched = append(matched, row)
+		}
+	}
+	return matched, nil
+}
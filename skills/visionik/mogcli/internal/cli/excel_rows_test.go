@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func tableDataMock(t *testing.T) *testutil.MockClient {
+	t.Helper()
+	return &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch path {
+			case "/me/drive/items/wb-1/workbook/tables('Tasks')/headerRowRange":
+				return mustJSON(map[string]interface{}{
+					"values": [][]string{{"Task", "Status"}},
+				}), nil
+			case "/me/drive/items/wb-1/workbook/tables('Tasks')/dataBodyRange":
+				return mustJSON(map[string]interface{}{
+					"values": [][]string{
+						{"Write report", "Open"},
+						{"Review PR", "Done"},
+						{"Fix bug", "Open"},
+					},
+				}), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+}
+
+func TestExcelRowsCmd_Run(t *testing.T) {
+	root := &Root{ClientFactory: mockClientFactory(tableDataMock(t))}
+
+	cmd := &ExcelRowsCmd{ID: "wb-1", Table: "Tasks"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Write report")
+	assert.Contains(t, output, "3 行")
+}
+
+func TestExcelRowsCmd_Filter(t *testing.T) {
+	root := &Root{ClientFactory: mockClientFactory(tableDataMock(t))}
+
+	cmd := &ExcelRowsCmd{ID: "wb-1", Table: "Tasks", Filter: "status=Open"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Write report")
+	assert.Contains(t, output, "Fix bug")
+	assert.NotContains(t, output, "Review PR")
+}
+
+func TestExcelRowsCmd_FilterUnknownColumn(t *testing.T) {
+	root := &Root{ClientFactory: mockClientFactory(tableDataMock(t))}
+
+	cmd := &ExcelRowsCmd{ID: "wb-1", Table: "Tasks", Filter: "Owner=me"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "未找到列")
+}
+
+func TestExcelRowsCmd_JSON(t *testing.T) {
+	root := &Root{JSON: true, ClientFactory: mockClientFactory(tableDataMock(t))}
+
+	cmd := &ExcelRowsCmd{ID: "wb-1", Table: "Tasks", Max: 1}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, `"Task": "Write report"`)
+	assert.NotContains(t, output, "Fix bug")
+}
+
+func TestExcelDeleteRowCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		DeleteFunc: func(ctx context.Context, path string) error {
+			gotPath = path
+			return nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &ExcelDeleteRowCmd{ID: "wb-1", Table: "Tasks", Index: 2}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/wb-1/workbook/tables('Tasks')/rows/itemAt(index=2)", gotPath)
+	assert.Contains(t, output, "已删除第 2 行")
+}
+
+func TestExcelColumnsCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/wb-1/workbook/tables('Tasks')/columns", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"index": 0, "name": "Task"},
+					{"index": 1, "name": "Status"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&ExcelColumnsCmd{ID: "wb-1", Table: "Tasks"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Status")
+}
@@ -0,0 +1,234 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// ExcelNamesCmd 管理工作簿的命名区域。
+type ExcelNamesCmd struct {
+	List ExcelNamesListCmd `cmd:"" default:"withargs" help:"列出命名区域"`
+	Get  ExcelNamesGetCmd  `cmd:"" help:"读取命名区域的值"`
+	Set  ExcelNamesSetCmd  `cmd:"" help:"定义命名区域"`
+}
+
+// ExcelNamesListCmd 列出命名区域。
+type ExcelNamesListCmd struct {
+	ID string `arg:"" help:"工作簿ID"`
+}
+
+// Run 执行excel names list命令。
+func (c *ExcelNamesListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/names", graph.ResolveID(c.ID))
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []struct {
+			Name    string      `json:"name"`
+			Value   interface{} `json:"value"`
+			Type    string      `json:"type"`
+			Visible bool        `json:"visible"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("工作簿中未找到命名区域")
+		return nil
+	}
+
+	for _, name := range resp.Value {
+		hidden := ""
+		if !name.Visible {
+			hidden = " (隐藏)"
+		}
+		fmt.Printf("%-25s %v%s\n", name.Name, name.Value, hidden)
+	}
+	return nil
+}
+
+// ExcelNamesGetCmd 读取命名区域的值。
+type ExcelNamesGetCmd struct {
+	ID   string `arg:"" help:"工作簿ID"`
+	Name string `arg:"" help:"命名区域名称"`
+}
+
+// Run 执行excel names get命令。
+func (c *ExcelNamesGetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/names('%s')/range",
+		graph.ResolveID(c.ID), c.Name)
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var rangeData RangeData
+	if err := json.Unmarshal(data, &rangeData); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(rangeData)
+	}
+
+	for _, row := range rangeData.Values {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = fmt.Sprintf("%v", cell)
+		}
+		fmt.Println(strings.Join(cells, "\t"))
+	}
+	return nil
+}
+
+// ExcelNamesSetCmd 定义命名区域。
+type ExcelNamesSetCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Name  string `arg:"" help:"命名区域名称"`
+	Range string `arg:"" help:"引用的区域 (如 Sheet1!A1:B10)"`
+}
+
+// Run 执行excel names set命令。
+func (c *ExcelNamesSetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	reference := c.Range
+	if !strings.HasPrefix(reference, "=") {
+		reference = "=" + reference
+	}
+
+	body := map[string]interface{}{
+		"name":      c.Name,
+		"reference": reference,
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/names/add", graph.ResolveID(c.ID))
+
+	if _, err := client.Post(ctx, path, body); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已定义命名区域: %s -> %s\n", c.Name, c.Range)
+	return nil
+}
+
+// ExcelRenameSheetCmd 重命名工作表。
+type ExcelRenameSheetCmd struct {
+	ID      string `arg:"" help:"工作簿ID"`
+	Sheet   string `arg:"" help:"当前工作表名称"`
+	NewName string `arg:"" help:"新名称"`
+}
+
+// Run 执行excel rename-sheet命令。
+func (c *ExcelRenameSheetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"name": c.NewName,
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')",
+		graph.ResolveID(c.ID), c.Sheet)
+
+	if _, err := client.Patch(ctx, path, body); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已重命名为: %s\n", c.NewName)
+	return nil
+}
+
+// ExcelDeleteSheetCmd 删除工作表。
+type ExcelDeleteSheetCmd struct {
+	ID    string `arg:"" help:"工作簿ID"`
+	Sheet string `arg:"" help:"工作表名称"`
+}
+
+// Run 执行excel delete-sheet命令。
+func (c *ExcelDeleteSheetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')",
+		graph.ResolveID(c.ID), c.Sheet)
+
+	if err := client.Delete(ctx, path); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 工作表删除成功")
+	return nil
+}
+
+// ExcelProtectSheetCmd 保护或取消保护工作表。
+type ExcelProtectSheetCmd struct {
+	ID        string `arg:"" help:"工作簿ID"`
+	Sheet     string `arg:"" help:"工作表名称"`
+	Unprotect bool   `help:"取消保护"`
+}
+
+// Run 执行excel protect-sheet命令。
+func (c *ExcelProtectSheetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	action := "protect"
+	if c.Unprotect {
+		action = "unprotect"
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/workbook/worksheets('%s')/protection/%s",
+		graph.ResolveID(c.ID), c.Sheet, action)
+
+	if _, err := client.Post(ctx, path, map[string]interface{}{}); err != nil {
+		return err
+	}
+
+	if c.Unprotect {
+		fmt.Println("✓ 已取消保护工作表")
+	} else {
+		fmt.Println("✓ 已保护工作表")
+	}
+	return nil
+}
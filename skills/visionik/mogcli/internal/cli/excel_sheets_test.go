@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestExcelNamesListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/wb-1/workbook/names", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"name": "Budget", "value": "Sheet1!$A$1:$B$10", "type": "Range", "visible": true},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&ExcelNamesListCmd{ID: "wb-1"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Budget")
+}
+
+func TestExcelNamesSetCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/wb-1/workbook/names/add", path)
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &ExcelNamesSetCmd{ID: "wb-1", Name: "Budget", Range: "Sheet1!A1:B10"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, "=Sheet1!A1:B10", body["reference"])
+}
+
+func TestExcelRenameSheetCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &ExcelRenameSheetCmd{ID: "wb-1", Sheet: "Sheet1", NewName: "Data"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/wb-1/workbook/worksheets('Sheet1')", gotPath)
+	assert.Equal(t, map[string]interface{}{"name": "Data"}, gotBody)
+}
+
+func TestExcelDeleteSheetCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		DeleteFunc: func(ctx context.Context, path string) error {
+			gotPath = path
+			return nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&ExcelDeleteSheetCmd{ID: "wb-1", Sheet: "Old"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/drive/items/wb-1/workbook/worksheets('Old')", gotPath)
+}
+
+func TestExcelProtectSheetCmd_Run(t *testing.T) {
+	var gotPaths []string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPaths = append(gotPaths, path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&ExcelProtectSheetCmd{ID: "wb-1", Sheet: "Data"}).Run(root) })
+	require.NoError(t, err)
+
+	captureOutput(func() {
+		err = (&ExcelProtectSheetCmd{ID: "wb-1", Sheet: "Data", Unprotect: true}).Run(root)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/me/drive/items/wb-1/workbook/worksheets('Data')/protection/protect",
+		"/me/drive/items/wb-1/workbook/worksheets('Data')/protection/unprotect",
+	}, gotPaths)
+}
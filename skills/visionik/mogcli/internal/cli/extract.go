@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 实体提取的正则表达式。快递单号覆盖 UPS (1Z 前缀)、USPS
+// (9x 开头的长数字) 和 FedEx (12/15 位数字) 的常见格式。
+var (
+	entityURLRe   = regexp.MustCompile(`https?://[^\s<>"'\)\]]+`)
+	entityDateRes = []*regexp.Regexp{
+		regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}(?::\d{2})?)?\b`),
+		regexp.MustCompile(`\b\d{1,2}/\d{1,2}/\d{2,4}\b`),
+		regexp.MustCompile(`(?i)\b(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\.?\s+\d{1,2}(?:st|nd|rd|th)?(?:,?\s+\d{4})?\b`),
+	}
+	entityTrackingRes = []*regexp.Regexp{
+		regexp.MustCompile(`\b1Z[0-9A-Z]{16}\b`),   // UPS
+		regexp.MustCompile(`\b9[2345]\d{20,24}\b`), // USPS
+		regexp.MustCompile(`\b\d{12}(?:\d{3})?\b`), // FedEx
+	}
+)
+
+// extractEntities 从正文中提取指定类型的实体, 去重并保持出现顺序。
+func extractEntities(kind, body string) []string {
+	var matches []string
+	switch kind {
+	case "urls":
+		matches = entityURLRe.FindAllString(body, -1)
+		for i, m := range matches {
+			matches[i] = strings.TrimRight(m, ".,;:")
+		}
+	case "dates":
+		for _, re := range entityDateRes {
+			matches = append(matches, re.FindAllString(body, -1)...)
+		}
+	case "tracking-numbers":
+		for _, re := range entityTrackingRes {
+			matches = append(matches, re.FindAllString(body, -1)...)
+		}
+	}
+
+	seen := make(map[string]bool, len(matches))
+	result := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if !seen[m] {
+			seen[m] = true
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// extractedEntity 是 --extract 的 JSON 输出条目。
+type extractedEntity struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+// printExtracted 以 JSON 输出从正文提取到的实体。
+func printExtracted(kind, body string) error {
+	entityKind := strings.TrimSuffix(kind, "s") // urls -> url
+	entities := []extractedEntity{}
+	for _, v := range extractEntities(kind, body) {
+		entities = append(entities, extractedEntity{Kind: entityKind, Value: v})
+	}
+	return outputJSON(entities)
+}
+
+// messageBodyText 返回消息正文的纯文本形式 (HTML 会被剥离)。
+func messageBodyText(msg Message) string {
+	if msg.Body == nil {
+		return ""
+	}
+	content := msg.Body.Content
+	if msg.Body.ContentType == "html" {
+		content = stripHTML(content)
+	}
+	return content
+}
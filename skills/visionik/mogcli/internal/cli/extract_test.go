@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractEntities(t *testing.T) {
+	tests := []struct {
+		name string
+		kind string
+		body string
+		want []string
+	}{
+		{
+			name: "urls with trailing punctuation",
+			kind: "urls",
+			body: "See https://example.com/a, and https://example.com/b.",
+			want: []string{"https://example.com/a", "https://example.com/b"},
+		},
+		{
+			name: "urls deduplicated",
+			kind: "urls",
+			body: "https://example.com https://example.com",
+			want: []string{"https://example.com"},
+		},
+		{
+			name: "iso and month dates",
+			kind: "dates",
+			body: "Due 2026-03-01, meeting on Mar 5, 2026.",
+			want: []string{"2026-03-01", "Mar 5, 2026"},
+		},
+		{
+			name: "ups tracking number",
+			kind: "tracking-numbers",
+			body: "Shipped: 1Z999AA10123456784 arriving soon",
+			want: []string{"1Z999AA10123456784"},
+		},
+		{
+			name: "fedex tracking number",
+			kind: "tracking-numbers",
+			body: "Tracking 123456789012",
+			want: []string{"123456789012"},
+		},
+		{
+			name: "no matches",
+			kind: "urls",
+			body: "nothing to see here",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractEntities(tt.kind, tt.body)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMessageBodyText(t *testing.T) {
+	assert.Equal(t, "", messageBodyText(Message{}))
+	assert.Equal(t, "plain", messageBodyText(Message{Body: &MessageBody{ContentType: "text", Content: "plain"}}))
+	assert.Equal(t, "Link", messageBodyText(Message{Body: &MessageBody{ContentType: "html", Content: `<a href="x">Link</a>`}}))
+}
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"os"
+)
+
+// writeFileAtomic 先写入同目录下的 .partial 临时文件再重命名到位,
+// 这样下载被 Ctrl-C 或超时中断时不会留下损坏的目标文件。
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".partial"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.bin")
+
+	require.NoError(t, writeFileAtomic(path, []byte("内容"), 0644))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "内容", string(data))
+
+	// 临时文件不应残留
+	_, err = os.Stat(path + ".partial")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWriteFileAtomic_FailureLeavesNoPartial(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing-dir", "out.bin")
+
+	assert.Error(t, writeFileAtomic(path, []byte("x"), 0644))
+
+	_, err := os.Stat(path + ".partial")
+	assert.True(t, os.IsNotExist(err))
+}
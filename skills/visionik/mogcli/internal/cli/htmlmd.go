@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	mdHeadRe    = regexp.MustCompile(`(?is)<head.*?</head>`)
+	mdHeadingRe = regexp.MustCompile(`(?is)<h([1-6])[^>]*>(.*?)</h[1-6]>`)
+	mdListRe    = regexp.MustCompile(`(?is)<li[^>]*>(.*?)</li>`)
+	mdBoldRe    = regexp.MustCompile(`(?is)<(?:b|strong)(?:\s[^>]*)?>(.*?)</(?:b|strong)>`)
+	mdItalicRe  = regexp.MustCompile(`(?is)<(?:i|em)(?:\s[^>]*)?>(.*?)</(?:i|em)>`)
+	mdLinkRe    = regexp.MustCompile(`(?is)<a[^>]*href="([^"]+)"[^>]*>(.*?)</a>`)
+	mdImgRe     = regexp.MustCompile(`(?is)<img[^>]*src="([^"]+)"[^>]*/?>`)
+	mdCellRe    = regexp.MustCompile(`(?is)<t[dh][^>]*>(.*?)</t[dh]>`)
+	mdRowRe     = regexp.MustCompile(`(?i)</tr>`)
+	mdBlockRe   = regexp.MustCompile(`(?i)</(?:p|div|table|ul|ol)>`)
+	mdBreakRe   = regexp.MustCompile(`(?i)<br[^>]*/?>`)
+	mdTagRe     = regexp.MustCompile(`<[^>]+>`)
+	mdBlankRe   = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToMarkdown 把HTML正文转换为Markdown, 保留链接、列表和表格
+// 单元格 — 供 mail get / calendar get / onenote get 的 --format
+// markdown 使用。
+func htmlToMarkdown(h string) string {
+	h = mdHeadRe.ReplaceAllString(h, "")
+	h = mdBreakRe.ReplaceAllString(h, "\n")
+	h = mdHeadingRe.ReplaceAllStringFunc(h, func(m string) string {
+		sub := mdHeadingRe.FindStringSubmatch(m)
+		level := int(sub[1][0] - '0')
+		return "\n" + strings.Repeat("#", level) + " " + strings.TrimSpace(sub[2]) + "\n\n"
+	})
+	h = mdListRe.ReplaceAllString(h, "- $1\n")
+	h = mdBoldRe.ReplaceAllString(h, "**$1**")
+	h = mdItalicRe.ReplaceAllString(h, "*$1*")
+	h = mdLinkRe.ReplaceAllString(h, "[$2]($1)")
+	h = mdImgRe.ReplaceAllString(h, "![]($1)\n")
+	h = mdCellRe.ReplaceAllString(h, "| $1 ")
+	h = mdRowRe.ReplaceAllString(h, "|\n")
+	h = mdBlockRe.ReplaceAllString(h, "\n\n")
+	h = mdTagRe.ReplaceAllString(h, "")
+	h = html.UnescapeString(h)
+
+	// 清理行尾空白并压缩空行
+	var lines []string
+	for _, line := range strings.Split(h, "\n") {
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	h = strings.Join(lines, "\n")
+	h = mdBlankRe.ReplaceAllString(h, "\n\n")
+	return strings.TrimSpace(h) + "\n"
+}
+
+// renderBody 按 --format 渲染消息/事件正文: html 原样输出,
+// markdown 经 htmlToMarkdown 转换, text 剥离全部标签。
+// 纯文本正文不受 format 影响。
+func renderBody(content, contentType, format string) string {
+	if contentType != "html" {
+		return content
+	}
+	switch format {
+	case "html":
+		return content
+	case "markdown":
+		return htmlToMarkdown(content)
+	default:
+		return stripHTML(content)
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLToMarkdownTable(t *testing.T) {
+	html := `<html><head><style>x</style></head><body>` +
+		`<h2>标题</h2>` +
+		`<p>见 <a href="https://example.com">链接</a> 和 <b>重点</b></p>` +
+		`<ul><li>第一</li><li>第二</li></ul>` +
+		`<table><tr><th>名称</th><th>值</th></tr><tr><td>a</td><td>1</td></tr></table>` +
+		`</body></html>`
+
+	md := htmlToMarkdown(html)
+
+	assert.Contains(t, md, "## 标题")
+	assert.Contains(t, md, "[链接](https://example.com)")
+	assert.Contains(t, md, "**重点**")
+	assert.Contains(t, md, "- 第一")
+	assert.Contains(t, md, "| 名称 | 值 |")
+	assert.Contains(t, md, "| a | 1 |")
+	assert.NotContains(t, md, "<")
+}
+
+func TestRenderBody(t *testing.T) {
+	html := `<p>见 <a href="https://example.com">链接</a></p>`
+
+	assert.Equal(t, html, renderBody(html, "html", "html"))
+	assert.Contains(t, renderBody(html, "html", "markdown"), "[链接](https://example.com)")
+	assert.Equal(t, "见 链接", renderBody(html, "html", "text"))
+
+	// 纯文本正文原样返回
+	assert.Equal(t, "plain", renderBody("plain", "text", "markdown"))
+}
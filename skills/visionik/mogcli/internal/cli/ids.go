@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// IdsCmd 管理短 ID 缓存。
+type IdsCmd struct {
+	Gc IdsGcCmd `cmd:"" default:"1" help:"清理长期未使用的短 ID 映射"`
+}
+
+// IdsGcCmd 清理长期未使用的短 ID 映射。
+type IdsGcCmd struct {
+	Days int `help:"保留天数 (超过此时长未使用的映射将被删除)" default:"30"`
+}
+
+// Run 执行ids gc命令。
+func (c *IdsGcCmd) Run(root *Root) error {
+	removed, err := graph.PruneSlugs(time.Duration(c.Days) * 24 * time.Hour)
+	if err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]int{"removed": removed})
+	}
+
+	fmt.Printf("✓ 已清理 %d 条短 ID 映射\n", removed)
+	return nil
+}
@@ -7,10 +7,12 @@ import (
 	"io"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/visionik/mogcli/internal/graph"
+	"github.com/visionik/mogcli/internal/output"
 )
 
 // MailCmd 处理邮件操作命令。
@@ -22,6 +24,13 @@ type MailCmd struct {
 	Folders    MailFoldersCmd    `cmd:"" help:"列出邮件文件夹"`
 	Drafts     MailDraftsCmd     `cmd:"" help:"草稿操作"`
 	Attachment MailAttachmentCmd `cmd:"" help:"附件操作"`
+	Move       MailMoveCmd       `cmd:"" help:"移动消息到其他文件夹"`
+	Archive    MailArchiveCmd    `cmd:"" help:"存档消息"`
+	Delete     MailDeleteCmd     `cmd:"" aliases:"rm" help:"删除消息"`
+	Mark       MailMarkCmd       `cmd:"" help:"标记消息 (read|unread|flag|unflag)"`
+	Export     MailExportCmd     `cmd:"" help:"导出消息为 .eml (原始 MIME)"`
+	Import     MailImportCmd     `cmd:"" help:"从 .eml 文件导入消息"`
+	Autoreply  MailAutoreplyCmd  `cmd:"" help:"管理外出自动回复"`
 }
 
 // MailListCmd 列出消息（search * 的别名）。
@@ -45,6 +54,7 @@ type MailSearchCmd struct {
 	Query  string `arg:"" help:"搜索查询（使用 * 表示所有）"`
 	Max    int    `help:"最大结果数" default:"25"`
 	Folder string `help:"要搜索的文件夹 ID"`
+	All    bool   `help:"跟随 @odata.nextLink 获取所有页"`
 }
 
 // Run 执行邮件搜索命令。
@@ -58,7 +68,7 @@ func (c *MailSearchCmd) Run(root *Root) error {
 	query := url.Values{}
 	query.Set("$top", fmt.Sprintf("%d", c.Max))
 	query.Set("$orderby", "receivedDateTime desc")
-	query.Set("$select", "id,subject,from,receivedDateTime,isRead,hasAttachments")
+	query = applySelect(query, root, "id,subject,from,receivedDateTime,isRead,hasAttachments")
 
 	if c.Query != "*" && c.Query != "" {
 		query.Set("$search", fmt.Sprintf(`"%s"`, c.Query))
@@ -69,38 +79,64 @@ func (c *MailSearchCmd) Run(root *Root) error {
 		path = fmt.Sprintf("/me/mailFolders/%s/messages", graph.ResolveID(c.Folder))
 	}
 
-	data, err := client.Get(ctx, path, query)
+	// --all 时不限条目数, 否则翻页直到收集满 --max
+	maxItems := c.Max
+	if c.All {
+		maxItems = 0
+	}
+
+	items, nextLink, err := graph.GetPaged(ctx, client, path, query, maxItems)
 	if err != nil {
 		return err
 	}
 
-	var resp struct {
-		Value []Message `json:"value"`
-	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return err
+	messages := make([]Message, 0, len(items))
+	for _, raw := range items {
+		var msg Message
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return err
+		}
+		messages = append(messages, msg)
 	}
 
 	if root.JSON {
-		return outputJSON(resp.Value)
+		return outputJSON(struct {
+			Messages []Message `json:"messages"`
+			NextLink string    `json:"nextLink,omitempty"`
+		}{Messages: messages, NextLink: nextLink})
+	}
+
+	if root.Plain {
+		t := output.NewTable("id", "received", "from", "subject", "read", "attachments")
+		for _, msg := range messages {
+			from := ""
+			if msg.From != nil {
+				from = msg.From.EmailAddress.Address
+			}
+			t.Row(graph.FormatTypedID("msg", msg.ID), msg.ReceivedDateTime, from, msg.Subject,
+				strconv.FormatBool(msg.IsRead), strconv.FormatBool(msg.HasAttachments))
+		}
+		return t.Render(os.Stdout, true)
 	}
 
-	if len(resp.Value) == 0 {
+	if len(messages) == 0 {
 		fmt.Println("未找到消息")
 		return nil
 	}
 
-	for _, msg := range resp.Value {
+	for _, msg := range messages {
 		printMessage(msg, root.Verbose)
 	}
 
-	fmt.Printf("\n%d 条消息\n", len(resp.Value))
+	fmt.Printf("\n%d 条消息\n", len(messages))
 	return nil
 }
 
 // MailGetCmd 获取消息。
 type MailGetCmd struct {
-	ID string `arg:"" help:"消息 ID 或短 ID"`
+	ID      string `arg:"" help:"消息 ID 或短 ID"`
+	Extract string `help:"从正文中提取实体并以 JSON 输出" enum:",urls,dates,tracking-numbers" default:""`
+	Format  string `help:"正文渲染格式" enum:"text,markdown,html" default:"text"`
 }
 
 // Run 执行邮件获取命令。
@@ -123,11 +159,16 @@ func (c *MailGetCmd) Run(root *Root) error {
 		return err
 	}
 
+	// 实体提取模式: 只输出提取结果
+	if c.Extract != "" {
+		return printExtracted(c.Extract, messageBodyText(msg))
+	}
+
 	if root.JSON {
 		return outputJSON(msg)
 	}
 
-	printMessageDetail(msg, root.Verbose)
+	printMessageDetail(msg, root.Verbose, c.Format)
 	return nil
 }
 
@@ -141,6 +182,7 @@ type MailSendCmd struct {
 	BodyFile         string   `help:"从文件读取正文（- 表示标准输入）" name:"body-file"`
 	BodyHTML         string   `help:"HTML 正文" name:"body-html"`
 	ReplyToMessageID string   `help:"回复消息 ID" name:"reply-to-message-id"`
+	Attach           []string `help:"附件文件路径 (可重复)"`
 }
 
 // Run 执行邮件发送命令。
@@ -177,6 +219,9 @@ func (c *MailSendCmd) Run(root *Root) error {
 
 	// 回复现有消息
 	if c.ReplyToMessageID != "" {
+		if len(c.Attach) > 0 {
+			return fmt.Errorf("--attach 不能与 --reply-to-message-id 一起使用")
+		}
 		messageID := graph.ResolveID(c.ReplyToMessageID)
 		replyMsg := map[string]interface{}{
 			"message": map[string]interface{}{
@@ -195,23 +240,51 @@ func (c *MailSendCmd) Run(root *Root) error {
 			return err
 		}
 	} else {
-		// 发送新邮件
-		msg := map[string]interface{}{
-			"message": map[string]interface{}{
-				"subject": c.Subject,
-				"body": map[string]string{
-					"contentType": contentType,
-					"content":     body,
-				},
-				"toRecipients":  formatRecipients(c.To),
-				"ccRecipients":  formatRecipients(c.Cc),
-				"bccRecipients": formatRecipients(c.Bcc),
+		message := map[string]interface{}{
+			"subject": c.Subject,
+			"body": map[string]string{
+				"contentType": contentType,
+				"content":     body,
 			},
+			"toRecipients":  formatRecipients(c.To),
+			"ccRecipients":  formatRecipients(c.Cc),
+			"bccRecipients": formatRecipients(c.Bcc),
 		}
-		_, err = client.Post(ctx, "/me/sendMail", msg)
+
+		inline, large, err := splitAttachments(c.Attach)
 		if err != nil {
 			return err
 		}
+
+		if len(large) > 0 {
+			// 大附件需要 uploadSession, 只能先建草稿再发送
+			data, err := client.Post(ctx, "/me/messages", message)
+			if err != nil {
+				return err
+			}
+			var draft Message
+			if err := json.Unmarshal(data, &draft); err != nil {
+				return err
+			}
+			if err := attachToDraft(ctx, client, draft.ID, c.Attach); err != nil {
+				return err
+			}
+			_, err = client.Post(ctx, fmt.Sprintf("/me/messages/%s/send", draft.ID), nil)
+			if err != nil {
+				return err
+			}
+		} else {
+			if len(inline) > 0 {
+				message["attachments"] = inline
+			}
+			msg := map[string]interface{}{
+				"message": message,
+			}
+			_, err = client.Post(ctx, "/me/sendMail", msg)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	fmt.Println("✓ 邮件发送成功")
@@ -247,7 +320,7 @@ func (c *MailFoldersCmd) Run(root *Root) error {
 
 	fmt.Printf("%-10s %-20s %s\n", "未读", "名称", "ID")
 	for _, f := range resp.Value {
-		slug := graph.FormatID(f.ID)
+		slug := graph.FormatTypedID("fld", f.ID)
 		fmt.Printf("%-10d %-20s %s\n", f.UnreadItemCount, f.DisplayName, slug)
 		if root.Verbose {
 			fmt.Printf("           完整 ID: %s\n", f.ID)
@@ -279,6 +352,7 @@ func (c *MailDraftsListCmd) Run(root *Root) error {
 	ctx := context.Background()
 	query := url.Values{}
 	query.Set("$top", fmt.Sprintf("%d", c.Max))
+	query = applySelect(query, root, "id,subject,toRecipients,receivedDateTime")
 
 	data, err := client.Get(ctx, "/me/mailFolders/drafts/messages", query)
 	if err != nil {
@@ -313,6 +387,7 @@ type MailDraftsCreateCmd struct {
 	Subject  string   `help:"主题行"`
 	Body     string   `help:"消息正文"`
 	BodyFile string   `help:"从文件读取正文" name:"body-file"`
+	Attach   []string `help:"附件文件路径 (可重复)"`
 }
 
 // Run 执行草稿创建命令。
@@ -351,7 +426,13 @@ func (c *MailDraftsCreateCmd) Run(root *Root) error {
 		return err
 	}
 
-	fmt.Printf("✓ 草稿创建成功: %s\n", graph.FormatID(created.ID))
+	if len(c.Attach) > 0 {
+		if err := attachToDraft(ctx, client, created.ID, c.Attach); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("✓ 草稿创建成功: %s\n", graph.FormatTypedID("msg", created.ID))
 	return nil
 }
 
@@ -437,7 +518,7 @@ func (c *MailAttachmentListCmd) Run(root *Root) error {
 	}
 
 	for _, a := range resp.Value {
-		fmt.Printf("%s  %s (%d 字节)\n", graph.FormatID(a.ID), a.Name, a.Size)
+		fmt.Printf("%s  %s (%d 字节)\n", graph.FormatTypedID("att", a.ID), a.Name, a.Size)
 	}
 	return nil
 }
@@ -469,7 +550,7 @@ func (c *MailAttachmentDownloadCmd) Run(root *Root) error {
 		return err
 	}
 
-	if err := os.WriteFile(c.Out, att.ContentBytes, 0644); err != nil {
+	if err := writeFileAtomic(c.Out, att.ContentBytes, 0644); err != nil {
 		return err
 	}
 
@@ -562,15 +643,15 @@ func printMessage(msg Message, verbose bool) {
 	}
 
 	fmt.Printf("%s %s %-8s %-20s %s\n", read, attach, date, from, subject)
-	fmt.Printf("  ID: %s\n", graph.FormatID(msg.ID))
+	fmt.Printf("  ID: %s\n", graph.FormatTypedID("msg", msg.ID))
 	if verbose {
 		fmt.Printf("  完整: %s\n", msg.ID)
 	}
 }
 
 // printMessageDetail 打印邮件详细信息。
-func printMessageDetail(msg Message, verbose bool) {
-	fmt.Printf("ID:      %s\n", graph.FormatID(msg.ID))
+func printMessageDetail(msg Message, verbose bool, format string) {
+	fmt.Printf("ID:      %s\n", graph.FormatTypedID("msg", msg.ID))
 	if verbose {
 		fmt.Printf("完整 ID: %s\n", msg.ID)
 	}
@@ -582,11 +663,7 @@ func printMessageDetail(msg Message, verbose bool) {
 	fmt.Printf("已读:    %v\n", msg.IsRead)
 	if msg.Body != nil {
 		fmt.Println("\n--- 正文 ---")
-		content := msg.Body.Content
-		if msg.Body.ContentType == "html" {
-			content = stripHTML(content)
-		}
-		fmt.Println(content)
+		fmt.Println(renderBody(msg.Body.Content, msg.Body.ContentType, format))
 	}
 }
 
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// attachInlineLimit 是内联 fileAttachment 的大小上限。
+// 超过此大小的文件必须通过 uploadSession 分块上传。
+const attachInlineLimit = 3 * 1024 * 1024
+
+// attachUploadChunkSize 是 uploadSession 的分块大小 (320 KiB 的倍数)。
+const attachUploadChunkSize = 10 * 320 * 1024
+
+// splitAttachments 把附件路径分为可内联的小文件和需要
+// uploadSession 的大文件。
+func splitAttachments(paths []string) (inline []map[string]interface{}, large []string, err error) {
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("读取附件失败: %w", err)
+		}
+		if info.Size() > attachInlineLimit {
+			large = append(large, path)
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("读取附件失败: %w", err)
+		}
+
+		contentType := mime.TypeByExtension(filepath.Ext(path))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		inline = append(inline, map[string]interface{}{
+			"@odata.type":  "#microsoft.graph.fileAttachment",
+			"name":         filepath.Base(path),
+			"contentType":  contentType,
+			"contentBytes": base64.StdEncoding.EncodeToString(data),
+		})
+	}
+	return inline, large, nil
+}
+
+// uploadLargeAttachment 通过 uploadSession 将大文件分块上传到
+// 草稿消息。
+func uploadLargeAttachment(ctx context.Context, client graph.Client, messageID, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("读取附件失败: %w", err)
+	}
+
+	body := map[string]interface{}{
+		"AttachmentItem": map[string]interface{}{
+			"attachmentType": "file",
+			"name":           filepath.Base(path),
+			"size":           info.Size(),
+		},
+	}
+	data, err := client.Post(ctx,
+		fmt.Sprintf("/me/messages/%s/attachments/createUploadSession", messageID), body)
+	if err != nil {
+		return fmt.Errorf("创建上传会话失败: %w", err)
+	}
+
+	var session struct {
+		UploadURL string `json:"uploadUrl"`
+	}
+	if err := json.Unmarshal(data, &session); err != nil {
+		return err
+	}
+	if session.UploadURL == "" {
+		return fmt.Errorf("上传会话未返回 uploadUrl")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("读取附件失败: %w", err)
+	}
+	defer f.Close()
+
+	// uploadUrl 已预授权, 直接 PUT 分块
+	total := info.Size()
+	for offset := int64(0); offset < total; offset += attachUploadChunkSize {
+		chunk := make([]byte, attachUploadChunkSize)
+		n, err := io.ReadFull(f, chunk)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return fmt.Errorf("读取附件失败: %w", err)
+		}
+		chunk = chunk[:n]
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut,
+			session.UploadURL, bytes.NewReader(chunk))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Range",
+			fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(n)-1, total))
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("上传分块失败: %w", err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("上传分块失败: %s", resp.Status)
+		}
+	}
+	return nil
+}
+
+// attachToDraft 将附件添加到草稿消息: 小文件内联, 大文件走
+// uploadSession。
+func attachToDraft(ctx context.Context, client graph.Client, messageID string, paths []string) error {
+	inline, large, err := splitAttachments(paths)
+	if err != nil {
+		return err
+	}
+
+	for _, att := range inline {
+		_, err := client.Post(ctx,
+			fmt.Sprintf("/me/messages/%s/attachments", messageID), att)
+		if err != nil {
+			return fmt.Errorf("添加附件失败: %w", err)
+		}
+	}
+
+	for _, path := range large {
+		if err := uploadLargeAttachment(ctx, client, messageID, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
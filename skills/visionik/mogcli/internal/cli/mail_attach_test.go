@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestSplitAttachments(t *testing.T) {
+	dir := t.TempDir()
+	small := filepath.Join(dir, "small.txt")
+	require.NoError(t, os.WriteFile(small, []byte("hello"), 0644))
+	big := filepath.Join(dir, "big.bin")
+	require.NoError(t, os.WriteFile(big, make([]byte, attachInlineLimit+1), 0644))
+
+	inline, large, err := splitAttachments([]string{small, big})
+	require.NoError(t, err)
+
+	require.Len(t, inline, 1)
+	assert.Equal(t, "#microsoft.graph.fileAttachment", inline[0]["@odata.type"])
+	assert.Equal(t, "small.txt", inline[0]["name"])
+	assert.NotEmpty(t, inline[0]["contentBytes"])
+
+	assert.Equal(t, []string{big}, large)
+}
+
+func TestSplitAttachmentsMissingFile(t *testing.T) {
+	_, _, err := splitAttachments([]string{"/no/such/file"})
+	assert.Error(t, err)
+}
+
+func TestMailSendCmd_InlineAttachment(t *testing.T) {
+	dir := t.TempDir()
+	att := filepath.Join(dir, "note.txt")
+	require.NoError(t, os.WriteFile(att, []byte("attached"), 0644))
+
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return []byte(`{}`), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailSendCmd{
+		To:      []string{"user@example.com"},
+		Subject: "Hi",
+		Body:    "Hello",
+		Attach:  []string{att},
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/sendMail", gotPath)
+	data, _ := json.Marshal(gotBody)
+	assert.Contains(t, string(data), `"name":"note.txt"`)
+	assert.Contains(t, string(data), "fileAttachment")
+}
+
+func TestMailSendCmd_LargeAttachmentUsesUploadSession(t *testing.T) {
+	dir := t.TempDir()
+	big := filepath.Join(dir, "big.bin")
+	require.NoError(t, os.WriteFile(big, make([]byte, attachInlineLimit+1), 0644))
+
+	var uploads []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploads = append(uploads, r.Header.Get("Content-Range"))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer upstream.Close()
+
+	var postPaths []string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			postPaths = append(postPaths, path)
+			if strings.HasSuffix(path, "/createUploadSession") {
+				return []byte(`{"uploadUrl":"` + upstream.URL + `"}`), nil
+			}
+			return []byte(`{"id":"draft-1"}`), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailSendCmd{
+		To:      []string{"user@example.com"},
+		Subject: "Hi",
+		Body:    "Hello",
+		Attach:  []string{big},
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/me/messages",
+		"/me/messages/draft-1/attachments/createUploadSession",
+		"/me/messages/draft-1/send",
+	}, postPaths)
+	require.NotEmpty(t, uploads)
+	assert.True(t, strings.HasPrefix(uploads[0], "bytes 0-"))
+}
+
+func TestMailDraftsCreateCmd_Attachment(t *testing.T) {
+	dir := t.TempDir()
+	att := filepath.Join(dir, "note.txt")
+	require.NoError(t, os.WriteFile(att, []byte("attached"), 0644))
+
+	var postPaths []string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			postPaths = append(postPaths, path)
+			return []byte(`{"id":"draft-1"}`), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailDraftsCreateCmd{
+		To:      []string{"user@example.com"},
+		Subject: "Hi",
+		Body:    "Hello",
+		Attach:  []string{att},
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/me/messages",
+		"/me/messages/draft-1/attachments",
+	}, postPaths)
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MailAutoreplyCmd 管理外出自动回复 (mailboxSettings.automaticRepliesSetting)。
+type MailAutoreplyCmd struct {
+	Get     MailAutoreplyGetCmd     `cmd:"" help:"显示自动回复设置"`
+	Set     MailAutoreplySetCmd     `cmd:"" help:"启用自动回复"`
+	Disable MailAutoreplyDisableCmd `cmd:"" help:"禁用自动回复"`
+}
+
+// autoReplies 对应 Graph 的 automaticRepliesSetting。
+type autoReplies struct {
+	Status                 string `json:"status"`
+	ExternalAudience       string `json:"externalAudience,omitempty"`
+	InternalReplyMessage   string `json:"internalReplyMessage,omitempty"`
+	ExternalReplyMessage   string `json:"externalReplyMessage,omitempty"`
+	ScheduledStartDateTime *Time  `json:"scheduledStartDateTime,omitempty"`
+	ScheduledEndDateTime   *Time  `json:"scheduledEndDateTime,omitempty"`
+}
+
+// MailAutoreplyGetCmd 显示当前的自动回复设置。
+type MailAutoreplyGetCmd struct{}
+
+// Run 执行 autoreply get 命令。
+func (c *MailAutoreplyGetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/mailboxSettings/automaticRepliesSetting", nil)
+	if err != nil {
+		return err
+	}
+
+	var settings autoReplies
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(settings)
+	}
+
+	fmt.Printf("状态: %s\n", settings.Status)
+	if settings.Status == "scheduled" {
+		if settings.ScheduledStartDateTime != nil {
+			fmt.Printf("开始: %s\n", settings.ScheduledStartDateTime.DateTime)
+		}
+		if settings.ScheduledEndDateTime != nil {
+			fmt.Printf("结束: %s\n", settings.ScheduledEndDateTime.DateTime)
+		}
+	}
+	if settings.Status != "disabled" {
+		fmt.Printf("外部受众: %s\n", settings.ExternalAudience)
+		if settings.InternalReplyMessage != "" {
+			fmt.Printf("内部消息: %s\n", settings.InternalReplyMessage)
+		}
+		if settings.ExternalReplyMessage != "" {
+			fmt.Printf("外部消息: %s\n", settings.ExternalReplyMessage)
+		}
+	}
+	return nil
+}
+
+// MailAutoreplySetCmd 启用自动回复。
+type MailAutoreplySetCmd struct {
+	Start            string `help:"开始时间 (ISO 格式; 与 --end 一起启用计划模式)"`
+	End              string `help:"结束时间 (ISO 格式)"`
+	InternalMessage  string `help:"给组织内部发件人的回复" name:"internal-message"`
+	ExternalMessage  string `help:"给外部发件人的回复 (默认: 与内部相同)" name:"external-message"`
+	ExternalAudience string `help:"外部受众" name:"external-audience" default:"all" enum:"none,contactsOnly,all"`
+}
+
+// Run 执行 autoreply set 命令。
+func (c *MailAutoreplySetCmd) Run(root *Root) error {
+	if c.InternalMessage == "" {
+		return fmt.Errorf("--internal-message 是必需的")
+	}
+	if (c.Start == "") != (c.End == "") {
+		return fmt.Errorf("--start 和 --end 必须一起指定")
+	}
+
+	settings := autoReplies{
+		Status:               "alwaysEnabled",
+		ExternalAudience:     c.ExternalAudience,
+		InternalReplyMessage: c.InternalMessage,
+		ExternalReplyMessage: c.ExternalMessage,
+	}
+	if settings.ExternalReplyMessage == "" {
+		settings.ExternalReplyMessage = c.InternalMessage
+	}
+	if c.Start != "" {
+		settings.Status = "scheduled"
+		settings.ScheduledStartDateTime = &Time{DateTime: c.Start, TimeZone: "UTC"}
+		settings.ScheduledEndDateTime = &Time{DateTime: c.End, TimeZone: "UTC"}
+	}
+
+	if err := patchAutoReplies(root, settings); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已启用自动回复 (%s)\n", settings.Status)
+	return nil
+}
+
+// MailAutoreplyDisableCmd 禁用自动回复。
+type MailAutoreplyDisableCmd struct{}
+
+// Run 执行 autoreply disable 命令。
+func (c *MailAutoreplyDisableCmd) Run(root *Root) error {
+	if err := patchAutoReplies(root, autoReplies{Status: "disabled"}); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 已禁用自动回复")
+	return nil
+}
+
+// patchAutoReplies 将设置写回 /me/mailboxSettings。
+func patchAutoReplies(root *Root, settings autoReplies) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	body := map[string]interface{}{"automaticRepliesSetting": settings}
+	_, err = client.Patch(ctx, "/me/mailboxSettings", body)
+	return err
+}
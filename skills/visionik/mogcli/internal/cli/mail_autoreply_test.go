@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestMailAutoreplyGetCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/mailboxSettings/automaticRepliesSetting", path)
+			return mustJSON(map[string]interface{}{
+				"status":               "scheduled",
+				"externalAudience":     "all",
+				"internalReplyMessage": "外出中",
+				"scheduledStartDateTime": map[string]string{
+					"dateTime": "2026-03-01T00:00:00", "timeZone": "UTC",
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&MailAutoreplyGetCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "scheduled")
+	assert.Contains(t, output, "2026-03-01")
+	assert.Contains(t, output, "外出中")
+}
+
+func TestMailAutoreplySetCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath, gotBody = path, body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailAutoreplySetCmd{
+		Start:           "2026-03-01T00:00:00",
+		End:             "2026-03-08T00:00:00",
+		InternalMessage: "外出中",
+	}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/mailboxSettings", gotPath)
+
+	settings := gotBody.(map[string]interface{})["automaticRepliesSetting"].(autoReplies)
+	assert.Equal(t, "scheduled", settings.Status)
+	assert.Equal(t, "外出中", settings.InternalReplyMessage)
+	// 未指定外部消息时复用内部消息
+	assert.Equal(t, "外出中", settings.ExternalReplyMessage)
+	require.NotNil(t, settings.ScheduledEndDateTime)
+	assert.Equal(t, "2026-03-08T00:00:00", settings.ScheduledEndDateTime.DateTime)
+}
+
+func TestMailAutoreplySetCmd_Validation(t *testing.T) {
+	root := &Root{}
+
+	err := (&MailAutoreplySetCmd{Start: "2026-03-01T00:00:00", InternalMessage: "x"}).Run(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--start 和 --end")
+
+	err = (&MailAutoreplySetCmd{}).Run(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--internal-message")
+}
+
+func TestMailAutoreplyDisableCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&MailAutoreplyDisableCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	settings := gotBody.(map[string]interface{})["automaticRepliesSetting"].(autoReplies)
+	assert.Equal(t, "disabled", settings.Status)
+}
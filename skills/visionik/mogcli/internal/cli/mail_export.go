@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// MailExportCmd 将消息导出为 .eml (原始 MIME)。
+type MailExportCmd struct {
+	ID  string `arg:"" help:"消息 ID"`
+	Out string `help:"输出文件路径 (- 表示标准输出)" required:""`
+}
+
+// Run 执行邮件导出命令。
+func (c *MailExportCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	// $value 端点返回消息的原始 MIME 内容
+	path := fmt.Sprintf("/me/messages/%s/$value", graph.ResolveID(c.ID))
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	if c.Out == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	if err := writeFileAtomic(c.Out, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已导出: %s (%d 字节)\n", c.Out, len(data))
+	return nil
+}
+
+// MailImportCmd 从 .eml 文件 (原始 MIME) 创建消息。
+type MailImportCmd struct {
+	File   string `arg:"" help:".eml 文件路径" type:"existingfile"`
+	Folder string `help:"目标文件夹 ID (默认: 草稿)"`
+}
+
+// Run 执行邮件导入命令。
+func (c *MailImportCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(c.File)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := "/me/messages"
+	if c.Folder != "" {
+		path = fmt.Sprintf("/me/mailFolders/%s/messages", graph.ResolveID(c.Folder))
+	}
+
+	// Graph 要求以 text/plain 提交 base64 编码的 MIME
+	encoded := base64.StdEncoding.EncodeToString(raw)
+	data, err := client.PostRaw(ctx, path, []byte(encoded), "text/plain")
+	if err != nil {
+		return err
+	}
+
+	var msg Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(msg)
+	}
+
+	fmt.Printf("✓ 已导入 %s: %s\n", filepath.Base(c.File), graph.FormatID(msg.ID))
+	return nil
+}
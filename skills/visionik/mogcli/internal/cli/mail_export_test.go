@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestMailExportCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	raw := []byte("From: a@example.com\r\nSubject: Hi\r\n\r\nBody\r\n")
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Contains(t, path, "/$value")
+			return raw, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	out := filepath.Join(t.TempDir(), "msg.eml")
+	cmd := &MailExportCmd{ID: "msg-1", Out: out}
+	require.NoError(t, cmd.Run(root))
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, raw, data)
+}
+
+func TestMailImportCmd_Run(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	raw := []byte("From: a@example.com\r\nSubject: Hi\r\n\r\nBody\r\n")
+	file := filepath.Join(t.TempDir(), "msg.eml")
+	require.NoError(t, os.WriteFile(file, raw, 0644))
+
+	var gotPath, gotType string
+	var gotBody []byte
+	mock := &testutil.MockClient{
+		PostRawFunc: func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+			gotPath, gotType, gotBody = path, contentType, data
+			return mustJSON(map[string]string{"id": "imported-1"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailImportCmd{File: file}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/messages", gotPath)
+	assert.Equal(t, "text/plain", gotType)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(raw), string(gotBody))
+	assert.Contains(t, output, "已导入")
+}
+
+func TestMailImportCmd_Folder(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	file := filepath.Join(t.TempDir(), "msg.eml")
+	require.NoError(t, os.WriteFile(file, []byte("Subject: Hi\r\n\r\n"), 0644))
+
+	var gotPath string
+	mock := &testutil.MockClient{
+		PostRawFunc: func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+			gotPath = path
+			return mustJSON(map[string]string{"id": "imported-2"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailImportCmd{File: file, Folder: "archive"}
+	captureOutput(func() { _ = cmd.Run(root) })
+
+	assert.Equal(t, "/me/mailFolders/archive/messages", gotPath)
+}
@@ -903,7 +903,7 @@ func TestPrintMessageDetail(t *testing.T) {
 	}
 
 	output := captureOutput(func() {
-		printMessageDetail(msg, false)
+		printMessageDetail(msg, false, "text")
 	})
 
 	assert.Contains(t, output, "Test Subject")
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// MailMoveCmd 将消息移动到其他文件夹。
+type MailMoveCmd struct {
+	IDs    []string `arg:"" help:"消息 ID (可指定多个)"`
+	Folder string   `help:"目标文件夹 ID" required:""`
+}
+
+// Run 执行邮件移动命令。
+func (c *MailMoveCmd) Run(root *Root) error {
+	return forEachMessage(root, c.IDs, "移动", func(id string) graph.BatchRequest {
+		return graph.BatchRequest{
+			Method: "POST",
+			URL:    fmt.Sprintf("/me/messages/%s/move", id),
+			Body: map[string]interface{}{
+				"destinationId": graph.ResolveID(c.Folder),
+			},
+		}
+	})
+}
+
+// MailArchiveCmd 将消息移动到存档文件夹。
+type MailArchiveCmd struct {
+	IDs []string `arg:"" help:"消息 ID (可指定多个)"`
+}
+
+// Run 执行邮件存档命令。
+func (c *MailArchiveCmd) Run(root *Root) error {
+	return forEachMessage(root, c.IDs, "存档", func(id string) graph.BatchRequest {
+		// "archive" 是 Graph 的众所周知文件夹名称
+		return graph.BatchRequest{
+			Method: "POST",
+			URL:    fmt.Sprintf("/me/messages/%s/move", id),
+			Body: map[string]interface{}{
+				"destinationId": "archive",
+			},
+		}
+	})
+}
+
+// MailDeleteCmd 删除消息。
+type MailDeleteCmd struct {
+	IDs []string `arg:"" help:"消息 ID (可指定多个)"`
+}
+
+// Run 执行邮件删除命令。
+func (c *MailDeleteCmd) Run(root *Root) error {
+	return forEachMessage(root, c.IDs, "删除", func(id string) graph.BatchRequest {
+		return graph.BatchRequest{
+			Method: "DELETE",
+			URL:    fmt.Sprintf("/me/messages/%s", id),
+		}
+	})
+}
+
+// MailMarkCmd 标记消息状态。
+type MailMarkCmd struct {
+	State string   `arg:"" help:"标记状态" enum:"read,unread,flag,unflag"`
+	IDs   []string `arg:"" help:"消息 ID (可指定多个)"`
+}
+
+// Run 执行邮件标记命令。
+func (c *MailMarkCmd) Run(root *Root) error {
+	var body map[string]interface{}
+	switch c.State {
+	case "read":
+		body = map[string]interface{}{"isRead": true}
+	case "unread":
+		body = map[string]interface{}{"isRead": false}
+	case "flag":
+		body = map[string]interface{}{
+			"flag": map[string]string{"flagStatus": "flagged"},
+		}
+	case "unflag":
+		body = map[string]interface{}{
+			"flag": map[string]string{"flagStatus": "notFlagged"},
+		}
+	}
+
+	return forEachMessage(root, c.IDs, "标记", func(id string) graph.BatchRequest {
+		return graph.BatchRequest{
+			Method: "PATCH",
+			URL:    fmt.Sprintf("/me/messages/%s", id),
+			Body:   body,
+		}
+	})
+}
+
+// forEachMessage 把每个消息 ID 的操作合并为单个 $batch 请求。单个
+// 失败不会中断其他消息的处理; 所有失败在最后汇总返回。
+func forEachMessage(root *Root, ids []string, action string, build func(id string) graph.BatchRequest) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	reqs := make([]graph.BatchRequest, len(ids))
+	for i, id := range ids {
+		reqs[i] = build(graph.ResolveID(id))
+	}
+
+	ctx := context.Background()
+	resps, err := graph.Batch(ctx, client, reqs)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for i, resp := range resps {
+		if err := resp.Err(); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", ids[i], err))
+			continue
+		}
+		fmt.Printf("✓ 已%s: %s\n", action, ids[i])
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%s失败:\n%s", action, strings.Join(failed, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/graph"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+// batchRequestsOf 从 $batch 请求体中取出子请求列表。
+func batchRequestsOf(t *testing.T, body interface{}) []graph.BatchRequest {
+	t.Helper()
+	m, ok := body.(map[string]interface{})
+	require.True(t, ok)
+	reqs, ok := m["requests"].([]graph.BatchRequest)
+	require.True(t, ok)
+	return reqs
+}
+
+// batchResponsesOK 构造 n 个全部成功的 $batch 响应。
+func batchResponsesOK(n int) []byte {
+	resps := make([]map[string]interface{}, n)
+	for i := range resps {
+		resps[i] = map[string]interface{}{
+			"id": strconv.Itoa(i + 1), "status": 200, "body": map[string]interface{}{},
+		}
+	}
+	data, _ := json.Marshal(map[string]interface{}{"responses": resps})
+	return data
+}
+
+func TestMailMoveCmd_Run(t *testing.T) {
+	var batchCalls int
+	var gotReqs []graph.BatchRequest
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/$batch", path)
+			batchCalls++
+			gotReqs = batchRequestsOf(t, body)
+			return batchResponsesOK(len(gotReqs)), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailMoveCmd{IDs: []string{"msg-1", "msg-2"}, Folder: "folder-9"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, batchCalls)
+	require.Len(t, gotReqs, 2)
+	assert.Equal(t, "POST", gotReqs[0].Method)
+	assert.Equal(t, "/me/messages/msg-1/move", gotReqs[0].URL)
+	assert.Equal(t, "/me/messages/msg-2/move", gotReqs[1].URL)
+	assert.Contains(t, output, "✓ 已移动: msg-1")
+	assert.Contains(t, output, "✓ 已移动: msg-2")
+
+	data, _ := json.Marshal(gotReqs[0].Body)
+	assert.Contains(t, string(data), `"destinationId":"folder-9"`)
+}
+
+func TestMailArchiveCmd_Run(t *testing.T) {
+	var gotReqs []graph.BatchRequest
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotReqs = batchRequestsOf(t, body)
+			return batchResponsesOK(len(gotReqs)), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailArchiveCmd{IDs: []string{"msg-1"}}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	require.Len(t, gotReqs, 1)
+	data, _ := json.Marshal(gotReqs[0].Body)
+	assert.Contains(t, string(data), `"destinationId":"archive"`)
+}
+
+func TestMailDeleteCmd_Run(t *testing.T) {
+	var gotReqs []graph.BatchRequest
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotReqs = batchRequestsOf(t, body)
+			return batchResponsesOK(len(gotReqs)), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailDeleteCmd{IDs: []string{"msg-1", "msg-2"}}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	require.Len(t, gotReqs, 2)
+	assert.Equal(t, "DELETE", gotReqs[0].Method)
+	assert.Equal(t, "/me/messages/msg-1", gotReqs[0].URL)
+	assert.Equal(t, "/me/messages/msg-2", gotReqs[1].URL)
+}
+
+func TestMailMarkCmd_Run(t *testing.T) {
+	tests := []struct {
+		state      string
+		wantInBody string
+	}{
+		{"read", `"isRead":true`},
+		{"unread", `"isRead":false`},
+		{"flag", `"flagStatus":"flagged"`},
+		{"unflag", `"flagStatus":"notFlagged"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			var gotReqs []graph.BatchRequest
+			mock := &testutil.MockClient{
+				PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+					gotReqs = batchRequestsOf(t, body)
+					return batchResponsesOK(len(gotReqs)), nil
+				},
+			}
+			root := &Root{ClientFactory: mockClientFactory(mock)}
+
+			cmd := &MailMarkCmd{State: tt.state, IDs: []string{"msg-1"}}
+			var err error
+			captureOutput(func() { err = cmd.Run(root) })
+
+			require.NoError(t, err)
+			require.Len(t, gotReqs, 1)
+			assert.Equal(t, "PATCH", gotReqs[0].Method)
+			data, _ := json.Marshal(gotReqs[0].Body)
+			assert.Contains(t, string(data), tt.wantInBody)
+		})
+	}
+}
+
+func TestMailTriageContinuesOnError(t *testing.T) {
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			return mustJSON(map[string]interface{}{
+				"responses": []map[string]interface{}{
+					{"id": "1", "status": 404, "body": map[string]interface{}{
+						"error": map[string]string{"code": "itemNotFound", "message": "not found"},
+					}},
+					{"id": "2", "status": 204},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &MailDeleteCmd{IDs: []string{"msg-1", "msg-2"}}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "msg-1")
+	assert.Contains(t, output, "✓ 已删除: msg-2")
+}
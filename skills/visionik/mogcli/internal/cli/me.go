@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// MeCmd 处理当前用户资料和组织结构操作。
+type MeCmd struct {
+	Profile MeProfileCmd `cmd:"" default:"1" help:"显示我的资料"`
+	Photo   MePhotoCmd   `cmd:"" help:"下载我的头像"`
+	Manager MeManagerCmd `cmd:"" help:"显示我的经理"`
+	Reports MeReportsCmd `cmd:"" help:"列出我的直接下属"`
+}
+
+// UserProfile 表示用户资料。
+type UserProfile struct {
+	ID                string   `json:"id"`
+	DisplayName       string   `json:"displayName"`
+	Mail              string   `json:"mail"`
+	UserPrincipalName string   `json:"userPrincipalName"`
+	JobTitle          string   `json:"jobTitle"`
+	Department        string   `json:"department"`
+	OfficeLocation    string   `json:"officeLocation"`
+	MobilePhone       string   `json:"mobilePhone"`
+	BusinessPhones    []string `json:"businessPhones"`
+}
+
+// printUserProfile 输出用户资料详情。
+func printUserProfile(u UserProfile) {
+	fmt.Printf("姓名:   %s\n", u.DisplayName)
+	if u.Mail != "" {
+		fmt.Printf("邮箱:   %s\n", u.Mail)
+	} else if u.UserPrincipalName != "" {
+		fmt.Printf("邮箱:   %s\n", u.UserPrincipalName)
+	}
+	if u.JobTitle != "" {
+		fmt.Printf("职位:   %s\n", u.JobTitle)
+	}
+	if u.Department != "" {
+		fmt.Printf("部门:   %s\n", u.Department)
+	}
+	if u.OfficeLocation != "" {
+		fmt.Printf("办公室: %s\n", u.OfficeLocation)
+	}
+	if u.MobilePhone != "" {
+		fmt.Printf("电话:   %s (移动)\n", u.MobilePhone)
+	}
+	for _, p := range u.BusinessPhones {
+		fmt.Printf("电话:   %s (工作)\n", p)
+	}
+	fmt.Printf("ID:     %s\n", graph.FormatID(u.ID))
+}
+
+// userSelectFields 是资料查询的$select投影。
+const userSelectFields = "id,displayName,mail,userPrincipalName,jobTitle,department,officeLocation,mobilePhone,businessPhones"
+
+// MeProfileCmd 显示我的资料。
+type MeProfileCmd struct{}
+
+// Run 执行me命令。
+func (c *MeProfileCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	query := applySelect(nil, root, userSelectFields)
+	data, err := client.Get(ctx, "/me", query)
+	if err != nil {
+		return err
+	}
+
+	var u UserProfile
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(u)
+	}
+
+	printUserProfile(u)
+	return nil
+}
+
+// MePhotoCmd 下载我的头像。
+type MePhotoCmd struct {
+	Out string `help:"输出路径" default:"photo.jpg"`
+}
+
+// Run 执行me photo命令。
+func (c *MePhotoCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/photo/$value", nil)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFileAtomic(c.Out, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 头像已保存到 %s\n", c.Out)
+	return nil
+}
+
+// MeManagerCmd 显示我的经理。
+type MeManagerCmd struct{}
+
+// Run 执行me manager命令。
+func (c *MeManagerCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/manager", nil)
+	if err != nil {
+		return err
+	}
+
+	var u UserProfile
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(u)
+	}
+
+	printUserProfile(u)
+	return nil
+}
+
+// MeReportsCmd 列出我的直接下属。
+type MeReportsCmd struct{}
+
+// Run 执行me reports命令。
+func (c *MeReportsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/directReports", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []UserProfile `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("没有直接下属")
+		return nil
+	}
+
+	for _, u := range resp.Value {
+		fmt.Printf("%-30s %-30s %s\n", u.DisplayName, u.Mail, u.JobTitle)
+	}
+	return nil
+}
+
+// UsersCmd 处理组织用户查询。
+type UsersCmd struct {
+	Get UsersGetCmd `cmd:"" default:"withargs" help:"获取用户资料"`
+}
+
+// UsersGetCmd 获取用户资料, 含经理和直接下属。
+type UsersGetCmd struct {
+	User string `arg:"" help:"用户邮箱或ID"`
+}
+
+// Run 执行users get命令。
+func (c *UsersGetCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	base := fmt.Sprintf("/users/%s", graph.ResolveID(c.User))
+
+	query := applySelect(nil, root, userSelectFields)
+	data, err := client.Get(ctx, base, query)
+	if err != nil {
+		return err
+	}
+
+	var u UserProfile
+	if err := json.Unmarshal(data, &u); err != nil {
+		return err
+	}
+
+	// 经理和下属查不到时不算错误 (顶层或无下属)
+	var manager *UserProfile
+	if data, err := client.Get(ctx, base+"/manager", nil); err == nil {
+		var m UserProfile
+		if json.Unmarshal(data, &m) == nil && m.ID != "" {
+			manager = &m
+		}
+	}
+	var reports []UserProfile
+	if data, err := client.Get(ctx, base+"/directReports", nil); err == nil {
+		var resp struct {
+			Value []UserProfile `json:"value"`
+		}
+		if json.Unmarshal(data, &resp) == nil {
+			reports = resp.Value
+		}
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]interface{}{
+			"user":          u,
+			"manager":       manager,
+			"directReports": reports,
+		})
+	}
+
+	printUserProfile(u)
+	if manager != nil {
+		fmt.Printf("经理:   %s (%s)\n", manager.DisplayName, manager.Mail)
+	}
+	if len(reports) > 0 {
+		fmt.Println("直接下属:")
+		for _, r := range reports {
+			fmt.Printf("  %-30s %s\n", r.DisplayName, r.Mail)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestMeProfileCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me", path)
+			return mustJSON(map[string]interface{}{
+				"id": "user-1", "displayName": "张三", "mail": "zhang@example.com",
+				"jobTitle": "工程师", "department": "研发",
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&MeProfileCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "张三")
+	assert.Contains(t, output, "zhang@example.com")
+	assert.Contains(t, output, "工程师")
+}
+
+func TestMePhotoCmd_Run(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "photo.jpg")
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/photo/$value", path)
+			return []byte("jpeg-bytes"), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&MePhotoCmd{Out: out}).Run(root) })
+
+	require.NoError(t, err)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("jpeg-bytes"), data)
+}
+
+func TestMeReportsCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/directReports", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "user-2", "displayName": "李四", "mail": "li@example.com"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&MeReportsCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "李四")
+}
+
+func TestUsersGetCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch path {
+			case "/users/alice@example.com":
+				return mustJSON(map[string]string{"id": "user-1", "displayName": "Alice", "mail": "alice@example.com"}), nil
+			case "/users/alice@example.com/manager":
+				return mustJSON(map[string]string{"id": "user-2", "displayName": "Bob", "mail": "bob@example.com"}), nil
+			case "/users/alice@example.com/directReports":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "user-3", "displayName": "Carol", "mail": "carol@example.com"},
+					},
+				}), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&UsersGetCmd{User: "alice@example.com"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Alice")
+	assert.Contains(t, output, "Bob")
+	assert.Contains(t, output, "Carol")
+}
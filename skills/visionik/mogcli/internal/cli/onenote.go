@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path/filepath"
 	"strings"
 
 	"github.com/visionik/mogcli/internal/graph"
@@ -19,6 +21,8 @@ type OneNoteCmd struct {
 	CreateNotebook OneNoteCreateNotebookCmd `cmd:"" name:"create-notebook" help:"创建新笔记本"`
 	CreateSection  OneNoteCreateSectionCmd  `cmd:"" name:"create-section" help:"创建新分区"`
 	CreatePage     OneNoteCreatePageCmd     `cmd:"" name:"create-page" help:"创建新页面"`
+	Append         OneNoteAppendCmd         `cmd:"" help:"向现有页面追加内容"`
+	Export         OneNoteExportCmd         `cmd:"" help:"导出笔记本/分区为Markdown"`
 	Delete         OneNoteDeleteCmd         `cmd:"" help:"删除页面"`
 }
 
@@ -132,7 +136,8 @@ func (c *OneNotePagesCmd) Run(root *Root) error {
 // OneNoteGetCmd 获取页面内容。
 type OneNoteGetCmd struct {
 	PageID string `arg:"" help:"页面ID"`
-	HTML   bool   `help:"输出原始HTML"`
+	HTML   bool   `help:"输出原始HTML (等同 --format html)"`
+	Format string `help:"页面渲染格式" enum:"text,markdown,html" default:"text"`
 }
 
 // Run 执行onenote get命令。
@@ -150,19 +155,40 @@ func (c *OneNoteGetCmd) Run(root *Root) error {
 		return err
 	}
 
-	if c.HTML || root.JSON {
+	format := c.Format
+	if c.HTML {
+		format = "html"
+	}
+	if format == "html" || root.JSON {
 		fmt.Println(string(data))
 		return nil
 	}
 
+	if format == "markdown" {
+		fmt.Println(htmlToMarkdown(string(data)))
+		return nil
+	}
+
 	// 剥离HTML以输出文本
 	fmt.Println(stripHTML(string(data)))
 	return nil
 }
 
-// OneNoteSearchCmd 搜索OneNote。
+// OneNoteSearchCmd 搜索OneNote。标题在客户端过滤; --content 额外抓取
+// 页面内容进行全文匹配 (v1.0 没有全文搜索端点)。
 type OneNoteSearchCmd struct {
-	Query string `arg:"" help:"搜索查询"`
+	Query    string `arg:"" help:"搜索查询"`
+	Notebook string `help:"限定笔记本ID"`
+	Section  string `help:"限定分区ID"`
+	Content  bool   `help:"同时在页面内容中搜索 (较慢)"`
+	Max      int    `help:"最大结果数" default:"25"`
+}
+
+// oneNoteSearchResult 是单条搜索结果。
+type oneNoteSearchResult struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Snippet string `json:"snippet,omitempty"`
 }
 
 // Run 执行onenote search命令。
@@ -174,31 +200,119 @@ func (c *OneNoteSearchCmd) Run(root *Root) error {
 
 	ctx := context.Background()
 
-	// 搜索页面
-	data, err := client.Get(ctx, "/me/onenote/pages", nil)
+	pages, err := c.collectPages(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	var resp struct {
-		Value []Page `json:"value"`
-	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return err
+	query := strings.ToLower(c.Query)
+	var results []oneNoteSearchResult
+	for _, p := range pages {
+		if c.Max > 0 && len(results) >= c.Max {
+			break
+		}
+		if strings.Contains(strings.ToLower(p.Title), query) {
+			results = append(results, oneNoteSearchResult{ID: p.ID, Title: p.Title})
+			continue
+		}
+		if !c.Content {
+			continue
+		}
+		data, err := client.Get(ctx, fmt.Sprintf("/me/onenote/pages/%s/content", p.ID), nil)
+		if err != nil {
+			continue
+		}
+		text := stripHTML(string(data))
+		if i := strings.Index(strings.ToLower(text), query); i >= 0 {
+			results = append(results, oneNoteSearchResult{ID: p.ID, Title: p.Title, Snippet: searchSnippet(text, i, len(c.Query))})
+		}
 	}
 
 	if root.JSON {
-		return outputJSON(resp.Value)
+		return outputJSON(results)
 	}
 
-	fmt.Println("注意: 全文搜索需要Graph beta API")
-	fmt.Println("改为列出所有页面:")
-	for _, p := range resp.Value {
-		fmt.Printf("%-40s %s\n", p.Title, graph.FormatID(p.ID))
+	if len(results) == 0 {
+		fmt.Println("未找到匹配的页面")
+		return nil
+	}
+
+	for _, r := range results {
+		fmt.Printf("%-40s %s\n", r.Title, graph.FormatID(r.ID))
+		if r.Snippet != "" {
+			fmt.Printf("  …%s…\n", r.Snippet)
+		}
 	}
+	fmt.Printf("\n%d 条结果\n", len(results))
 	return nil
 }
 
+// collectPages 按范围列出页面, 跟随分页直到没有nextLink。
+func (c *OneNoteSearchCmd) collectPages(ctx context.Context, client graph.Client) ([]Page, error) {
+	var paths []string
+	switch {
+	case c.Section != "":
+		paths = []string{fmt.Sprintf("/me/onenote/sections/%s/pages", graph.ResolveID(c.Section))}
+	case c.Notebook != "":
+		data, err := client.Get(ctx, fmt.Sprintf("/me/onenote/notebooks/%s/sections", graph.ResolveID(c.Notebook)), nil)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			Value []Section `json:"value"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, err
+		}
+		for _, s := range resp.Value {
+			paths = append(paths, fmt.Sprintf("/me/onenote/sections/%s/pages", s.ID))
+		}
+	default:
+		paths = []string{"/me/onenote/pages"}
+	}
+
+	var pages []Page
+	for _, path := range paths {
+		query := url.Values{}
+		query.Set("$top", "100")
+		for path != "" {
+			data, err := client.Get(ctx, path, query)
+			if err != nil {
+				return nil, err
+			}
+			var resp struct {
+				Value    []Page `json:"value"`
+				NextLink string `json:"@odata.nextLink"`
+			}
+			if err := json.Unmarshal(data, &resp); err != nil {
+				return nil, err
+			}
+			pages = append(pages, resp.Value...)
+			if resp.NextLink == "" {
+				break
+			}
+			path, query, err = splitGraphLink(resp.NextLink)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return pages, nil
+}
+
+// searchSnippet 返回匹配位置前后的一小段上下文。
+func searchSnippet(text string, pos, matchLen int) string {
+	start := pos - 40
+	if start < 0 {
+		start = 0
+	}
+	end := pos + matchLen + 40
+	if end > len(text) {
+		end = len(text)
+	}
+	return strings.Join(strings.Fields(text[start:end]), " ")
+}
+
 // Notebook 表示OneNote笔记本。
 type Notebook struct {
 	ID          string `json:"id"`
@@ -299,17 +413,34 @@ type OneNoteCreatePageCmd struct {
 	SectionID string `arg:"" help:"分区ID"`
 	Title     string `arg:"" help:"页面标题"`
 	Content   string `arg:"" optional:"" help:"页面内容（可选）"`
+	File      string `help:"从Markdown或HTML文件创建 (本地图片作为部件上传)" type:"existingfile"`
 }
 
 // Run 执行onenote create-page命令。
 func (c *OneNoteCreatePageCmd) Run(root *Root) error {
+	if c.File != "" && c.Content != "" {
+		return fmt.Errorf("内容参数和 --file 不能同时使用")
+	}
+
 	client, err := root.GetClient()
 	if err != nil {
 		return err
 	}
 
 	// OneNote需要HTML表示格式
-	htmlContent := fmt.Sprintf(`<!DOCTYPE html>
+	var htmlContent string
+	var images []oneNoteImagePart
+	if c.File != "" {
+		htmlContent, err = buildPageHTML(c.Title, c.File)
+		if err != nil {
+			return err
+		}
+		htmlContent, images, err = loadPageImages(htmlContent, filepath.Dir(c.File))
+		if err != nil {
+			return err
+		}
+	} else {
+		htmlContent = fmt.Sprintf(`<!DOCTYPE html>
 <html>
   <head>
     <title>%s</title>
@@ -318,13 +449,26 @@ func (c *OneNoteCreatePageCmd) Run(root *Root) error {
     <p>%s</p>
   </body>
 </html>`, escapeHTML(c.Title), escapeHTML(c.Content))
+	}
 
 	ctx := context.Background()
 	path := fmt.Sprintf("/me/onenote/sections/%s/pages", graph.ResolveID(c.SectionID))
 
-	data, err := client.PostHTML(ctx, path, htmlContent)
-	if err != nil {
-		return err
+	var data []byte
+	if len(images) > 0 {
+		body, contentType, err := buildOneNoteMultipart(htmlContent, images)
+		if err != nil {
+			return err
+		}
+		data, err = client.PostRaw(ctx, path, body, contentType)
+		if err != nil {
+			return err
+		}
+	} else {
+		data, err = client.PostHTML(ctx, path, htmlContent)
+		if err != nil {
+			return err
+		}
 	}
 
 	var page Page
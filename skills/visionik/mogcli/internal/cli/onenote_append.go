@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// OneNoteAppendCmd 向现有页面追加内容, 使用PATCH content端点的插入
+// 操作, 适合日志式页面持续追加而不是每次新建页面。
+type OneNoteAppendCmd struct {
+	PageID       string `arg:"" help:"页面ID"`
+	HTML         string `help:"要追加的HTML片段"`
+	MarkdownFile string `help:"要追加的Markdown文件" name:"markdown-file" type:"existingfile"`
+	Target       string `help:"目标元素 (body=追加正文, title=替换标题)" default:"body" enum:"body,title"`
+}
+
+// Run 执行onenote append命令。
+func (c *OneNoteAppendCmd) Run(root *Root) error {
+	if (c.HTML == "") == (c.MarkdownFile == "") {
+		return fmt.Errorf("需要 --html 或 --markdown-file 其中之一")
+	}
+
+	content := c.HTML
+	if c.MarkdownFile != "" {
+		md, err := os.ReadFile(c.MarkdownFile)
+		if err != nil {
+			return err
+		}
+		content = markdownBodyHTML(string(md))
+	}
+
+	// title只支持replace, body用append追加到末尾
+	action := map[string]interface{}{
+		"target":  c.Target,
+		"content": content,
+	}
+	if c.Target == "title" {
+		action["action"] = "replace"
+	} else {
+		action["action"] = "append"
+		action["position"] = "after"
+	}
+
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/onenote/pages/%s/content", graph.ResolveID(c.PageID))
+
+	if _, err := client.Patch(ctx, path, []interface{}{action}); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]interface{}{"success": true, "page": c.PageID, "target": c.Target})
+	}
+
+	fmt.Println("✓ 页面更新成功")
+	return nil
+}
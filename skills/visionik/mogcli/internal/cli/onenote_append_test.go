@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestOneNoteAppendCmd_HTML(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteAppendCmd{PageID: "page-1", HTML: "<p>新条目</p>", Target: "body"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/onenote/pages/page-1/content", gotPath)
+	assert.Contains(t, output, "页面更新成功")
+
+	actions := gotBody.([]interface{})
+	require.Len(t, actions, 1)
+	action := actions[0].(map[string]interface{})
+	assert.Equal(t, "body", action["target"])
+	assert.Equal(t, "append", action["action"])
+	assert.Equal(t, "after", action["position"])
+	assert.Equal(t, "<p>新条目</p>", action["content"])
+}
+
+func TestOneNoteAppendCmd_MarkdownFileTitle(t *testing.T) {
+	mdFile := filepath.Join(t.TempDir(), "entry.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("- 今日进展"), 0644))
+
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteAppendCmd{PageID: "page-1", MarkdownFile: mdFile, Target: "body"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	action := gotBody.([]interface{})[0].(map[string]interface{})
+	assert.Contains(t, action["content"], "<li>今日进展</li>")
+}
+
+func TestOneNoteAppendCmd_TitleReplace(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteAppendCmd{PageID: "page-1", HTML: "新标题", Target: "title"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	action := gotBody.([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "title", action["target"])
+	assert.Equal(t, "replace", action["action"])
+	assert.NotContains(t, action, "position")
+}
+
+func TestOneNoteAppendCmd_RequiresOneSource(t *testing.T) {
+	root := &Root{}
+	err := (&OneNoteAppendCmd{PageID: "page-1", Target: "body"}).Run(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--html 或 --markdown-file")
+}
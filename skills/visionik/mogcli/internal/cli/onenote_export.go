@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// OneNoteExportCmd 把笔记本或分区导出为Markdown文件夹树, 内嵌图片
+// 下载到images子目录 — 完整的OneNote备份路径。
+type OneNoteExportCmd struct {
+	ID  string `arg:"" help:"笔记本或分区ID"`
+	Out string `help:"输出目录" required:""`
+}
+
+// Run 执行onenote export命令。
+func (c *OneNoteExportCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	id := graph.ResolveID(c.ID)
+
+	// 先按笔记本处理, 失败则按单个分区导出
+	total := 0
+	data, err := client.Get(ctx, fmt.Sprintf("/me/onenote/notebooks/%s/sections", id), nil)
+	if err == nil {
+		var resp struct {
+			Value []Section `json:"value"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+		for _, s := range resp.Value {
+			n, err := exportOneNoteSection(ctx, client, s.ID, filepath.Join(c.Out, sanitizeFileName(s.DisplayName)))
+			if err != nil {
+				return err
+			}
+			total += n
+		}
+	} else {
+		total, err = exportOneNoteSection(ctx, client, id, c.Out)
+		if err != nil {
+			return err
+		}
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]interface{}{"success": true, "pages": total, "out": c.Out})
+	}
+
+	fmt.Printf("✓ 导出完成: %d 个页面 → %s\n", total, c.Out)
+	return nil
+}
+
+// exportOneNoteSection 把分区中的每个页面写为Markdown文件, 返回导出
+// 的页面数。
+func exportOneNoteSection(ctx context.Context, client graph.Client, sectionID, dir string) (int, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return 0, err
+	}
+
+	pages, err := listOneNotePages(ctx, client, fmt.Sprintf("/me/onenote/sections/%s/pages", sectionID))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range pages {
+		data, err := client.Get(ctx, fmt.Sprintf("/me/onenote/pages/%s/content", p.ID), nil)
+		if err != nil {
+			return 0, err
+		}
+
+		htmlContent, err := downloadOneNoteImages(ctx, client, string(data), dir)
+		if err != nil {
+			return 0, err
+		}
+
+		md := "# " + p.Title + "\n\n" + htmlToMarkdown(htmlContent)
+		name := sanitizeFileName(p.Title)
+		if name == "" {
+			name = p.ID
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+".md"), []byte(md), 0644); err != nil {
+			return 0, err
+		}
+	}
+	return len(pages), nil
+}
+
+// listOneNotePages 列出路径下的所有页面, 跟随分页。
+func listOneNotePages(ctx context.Context, client graph.Client, path string) ([]Page, error) {
+	query := url.Values{}
+	query.Set("$top", "100")
+
+	var pages []Page
+	for path != "" {
+		data, err := client.Get(ctx, path, query)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			Value    []Page `json:"value"`
+			NextLink string `json:"@odata.nextLink"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, err
+		}
+		pages = append(pages, resp.Value...)
+		if resp.NextLink == "" {
+			break
+		}
+		path, query, err = splitGraphLink(resp.NextLink)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pages, nil
+}
+
+// downloadOneNoteImages 下载HTML中引用的OneNote资源图片到images子
+// 目录, 并把src改写为相对路径。
+func downloadOneNoteImages(ctx context.Context, client graph.Client, htmlContent, dir string) (string, error) {
+	var loadErr error
+	n := 0
+
+	rewritten := imgSrcRe.ReplaceAllStringFunc(htmlContent, func(match string) string {
+		m := imgSrcRe.FindStringSubmatch(match)
+		src := m[2]
+		if !strings.Contains(src, "/onenote/resources/") {
+			return match
+		}
+
+		path, query, err := splitGraphLink(src)
+		if err != nil {
+			return match
+		}
+		data, err := client.Get(ctx, path, query)
+		if err != nil {
+			if loadErr == nil {
+				loadErr = fmt.Errorf("下载图片失败 %s: %w", src, err)
+			}
+			return match
+		}
+
+		if err := os.MkdirAll(filepath.Join(dir, "images"), 0755); err != nil {
+			if loadErr == nil {
+				loadErr = err
+			}
+			return match
+		}
+		n++
+		rel := filepath.Join("images", fmt.Sprintf("image%d%s", n, imageExtension(data)))
+		if err := os.WriteFile(filepath.Join(dir, rel), data, 0644); err != nil {
+			if loadErr == nil {
+				loadErr = err
+			}
+			return match
+		}
+		return m[1] + rel + m[3]
+	})
+	if loadErr != nil {
+		return "", loadErr
+	}
+	return rewritten, nil
+}
+
+// imageExtension 根据文件头识别图片格式。
+func imageExtension(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG")):
+		return ".png"
+	case bytes.HasPrefix(data, []byte("\xFF\xD8")):
+		return ".jpg"
+	case bytes.HasPrefix(data, []byte("GIF8")):
+		return ".gif"
+	default:
+		return ".bin"
+	}
+}
+
+// sanitizeFileName 把标题转换为安全的文件名。
+func sanitizeFileName(name string) string {
+	name = strings.TrimSpace(name)
+	for _, ch := range []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|"} {
+		name = strings.ReplaceAll(name, ch, "-")
+	}
+	return name
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestHTMLToMarkdown(t *testing.T) {
+	h := `<html><head><title>x</title></head><body>
+<h2>小节</h2>
+<p>正文 <b>加粗</b> 和 <a href="https://example.com">链接</a></p>
+<ul><li>第一</li><li>第二</li></ul>
+<img src="images/image1.png"/>
+</body></html>`
+
+	md := htmlToMarkdown(h)
+
+	assert.Contains(t, md, "## 小节")
+	assert.Contains(t, md, "**加粗**")
+	assert.Contains(t, md, "[链接](https://example.com)")
+	assert.Contains(t, md, "- 第一")
+	assert.Contains(t, md, "- 第二")
+	assert.Contains(t, md, "![](images/image1.png)")
+	assert.NotContains(t, md, "<")
+}
+
+func TestOneNoteExportCmd_Section(t *testing.T) {
+	dir := t.TempDir()
+	pngBytes := append([]byte("\x89PNG"), []byte("fake")...)
+
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch {
+			case strings.Contains(path, "/notebooks/"):
+				return nil, assert.AnError
+			case path == "/me/onenote/sections/sec-1/pages":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "page-1", "title": "会议记录"},
+					},
+				}), nil
+			case path == "/me/onenote/pages/page-1/content":
+				return []byte(`<html><body><p>要点</p><img src="https://graph.microsoft.com/v1.0/me/onenote/resources/res-1/$value"/></body></html>`), nil
+			case path == "/me/onenote/resources/res-1/$value":
+				return pngBytes, nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteExportCmd{ID: "sec-1", Out: dir}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "1 个页面")
+
+	md, err := os.ReadFile(filepath.Join(dir, "会议记录.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(md), "# 会议记录")
+	assert.Contains(t, string(md), "要点")
+	assert.Contains(t, string(md), "![](images/image1.png)")
+
+	img, err := os.ReadFile(filepath.Join(dir, "images", "image1.png"))
+	require.NoError(t, err)
+	assert.Equal(t, pngBytes, img)
+}
+
+func TestOneNoteExportCmd_Notebook(t *testing.T) {
+	dir := t.TempDir()
+
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch {
+			case path == "/me/onenote/notebooks/nb-1/sections":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "sec-1", "displayName": "工作"},
+					},
+				}), nil
+			case path == "/me/onenote/sections/sec-1/pages":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "page-1", "title": "待办"},
+					},
+				}), nil
+			case path == "/me/onenote/pages/page-1/content":
+				return []byte("<html><body><p>内容</p></body></html>"), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteExportCmd{ID: "nb-1", Out: dir}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	md, err := os.ReadFile(filepath.Join(dir, "工作", "待办.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(md), "内容")
+}
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	mdImageRe  = regexp.MustCompile(`!\[([^\]]*)\]\(([^)]+)\)`)
+	imgSrcRe   = regexp.MustCompile(`(<img[^>]*?src=")([^"]+)("[^>]*?/?>)`)
+	htmlRootRe = regexp.MustCompile(`(?i)<html[\s>]`)
+)
+
+// markdownToOneNoteHTML 把Markdown转换为OneNote接受的HTML页面,
+// 支持 #/##/### 标题、- 列表和 ![](路径) 图片。
+func markdownToOneNoteHTML(title, md string) string {
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>%s</title>
+  </head>
+  <body>
+%s  </body>
+</html>`, escapeHTML(title), markdownBodyHTML(md))
+}
+
+// markdownBodyHTML 把Markdown转换为HTML正文片段。
+func markdownBodyHTML(md string) string {
+	var body strings.Builder
+	inList := false
+	closeList := func() {
+		if inList {
+			body.WriteString("    </ul>\n")
+			inList = false
+		}
+	}
+
+	for _, line := range strings.Split(md, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			closeList()
+		case strings.HasPrefix(trimmed, "### "):
+			closeList()
+			body.WriteString("    <h3>" + inlineMarkdown(strings.TrimPrefix(trimmed, "### ")) + "</h3>\n")
+		case strings.HasPrefix(trimmed, "## "):
+			closeList()
+			body.WriteString("    <h2>" + inlineMarkdown(strings.TrimPrefix(trimmed, "## ")) + "</h2>\n")
+		case strings.HasPrefix(trimmed, "# "):
+			closeList()
+			body.WriteString("    <h1>" + inlineMarkdown(strings.TrimPrefix(trimmed, "# ")) + "</h1>\n")
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			if !inList {
+				body.WriteString("    <ul>\n")
+				inList = true
+			}
+			body.WriteString("      <li>" + inlineMarkdown(trimmed[2:]) + "</li>\n")
+		default:
+			closeList()
+			body.WriteString("    <p>" + inlineMarkdown(trimmed) + "</p>\n")
+		}
+	}
+	closeList()
+	return body.String()
+}
+
+// inlineMarkdown 转义一行文本, 把 ![alt](src) 图片转换为img标签。
+func inlineMarkdown(s string) string {
+	var out strings.Builder
+	for {
+		m := mdImageRe.FindStringSubmatchIndex(s)
+		if m == nil {
+			out.WriteString(escapeHTML(s))
+			break
+		}
+		out.WriteString(escapeHTML(s[:m[0]]))
+		alt, src := s[m[2]:m[3]], s[m[4]:m[5]]
+		out.WriteString(fmt.Sprintf(`<img src="%s" alt="%s"/>`, src, escapeHTML(alt)))
+		s = s[m[1]:]
+	}
+	return out.String()
+}
+
+// oneNoteImagePart 是多部分页面请求中的一个图片部件。
+type oneNoteImagePart struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// loadPageImages 收集HTML中引用的本地图片文件, 把src改写为
+// name:imageN 部件引用, 返回改写后的HTML和图片部件。
+func loadPageImages(html, baseDir string) (string, []oneNoteImagePart, error) {
+	var parts []oneNoteImagePart
+	var loadErr error
+
+	rewritten := imgSrcRe.ReplaceAllStringFunc(html, func(match string) string {
+		m := imgSrcRe.FindStringSubmatch(match)
+		src := m[2]
+		if strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") ||
+			strings.HasPrefix(src, "name:") || strings.HasPrefix(src, "data:") {
+			return match
+		}
+
+		imgPath := src
+		if !filepath.IsAbs(imgPath) {
+			imgPath = filepath.Join(baseDir, imgPath)
+		}
+		data, err := os.ReadFile(imgPath)
+		if err != nil {
+			if loadErr == nil {
+				loadErr = fmt.Errorf("无法读取图片 %s: %w", src, err)
+			}
+			return match
+		}
+
+		name := fmt.Sprintf("image%d", len(parts)+1)
+		parts = append(parts, oneNoteImagePart{
+			Name:        name,
+			ContentType: imageContentType(imgPath),
+			Data:        data,
+		})
+		return m[1] + "name:" + name + m[3]
+	})
+	if loadErr != nil {
+		return "", nil, loadErr
+	}
+	return rewritten, parts, nil
+}
+
+// imageContentType 根据扩展名返回图片的内容类型。
+func imageContentType(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".png":
+		return "image/png"
+	case ".jpg", ".jpeg":
+		return "image/jpeg"
+	case ".gif":
+		return "image/gif"
+	case ".svg":
+		return "image/svg+xml"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// buildOneNoteMultipart 把HTML表示部件和图片部件编码为多部分请求体。
+func buildOneNoteMultipart(html string, images []oneNoteImagePart) ([]byte, string, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="Presentation"`)
+	header.Set("Content-Type", "text/html")
+	w, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := w.Write([]byte(html)); err != nil {
+		return nil, "", err
+	}
+
+	for _, img := range images {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, img.Name))
+		header.Set("Content-Type", img.ContentType)
+		w, err := mw.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := w.Write(img.Data); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), "multipart/form-data; boundary=" + mw.Boundary(), nil
+}
+
+// buildPageHTML 把--file的内容转换为完整的OneNote HTML页面。
+func buildPageHTML(title, file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	if ext := strings.ToLower(filepath.Ext(file)); ext == ".html" || ext == ".htm" {
+		html := string(content)
+		if !htmlRootRe.MatchString(html) {
+			html = fmt.Sprintf(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>%s</title>
+  </head>
+  <body>
+%s
+  </body>
+</html>`, escapeHTML(title), html)
+		}
+		return html, nil
+	}
+	return markdownToOneNoteHTML(title, string(content)), nil
+}
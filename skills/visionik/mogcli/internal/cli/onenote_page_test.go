@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"mime"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestMarkdownToOneNoteHTML(t *testing.T) {
+	md := "# 标题\n\n第一段 & 文本\n\n- 项目一\n- 项目二\n\n![图](shot.png)"
+	html := markdownToOneNoteHTML("我的笔记", md)
+
+	assert.Contains(t, html, "<title>我的笔记</title>")
+	assert.Contains(t, html, "<h1>标题</h1>")
+	assert.Contains(t, html, "<p>第一段 &amp; 文本</p>")
+	assert.Contains(t, html, "<li>项目一</li>")
+	assert.Contains(t, html, "<li>项目二</li>")
+	assert.Contains(t, html, `<img src="shot.png" alt="图"/>`)
+	// 列表必须闭合
+	assert.Equal(t, strings.Count(html, "<ul>"), strings.Count(html, "</ul>"))
+}
+
+func TestLoadPageImages(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "shot.png"), []byte("png-bytes"), 0644))
+
+	html := `<p>见图</p><img src="shot.png" alt="图"/><img src="https://example.com/x.png"/>`
+	rewritten, parts, err := loadPageImages(html, dir)
+
+	require.NoError(t, err)
+	require.Len(t, parts, 1)
+	assert.Equal(t, "image1", parts[0].Name)
+	assert.Equal(t, "image/png", parts[0].ContentType)
+	assert.Equal(t, []byte("png-bytes"), parts[0].Data)
+	assert.Contains(t, rewritten, `src="name:image1"`)
+	// 远程图片保持不变
+	assert.Contains(t, rewritten, `src="https://example.com/x.png"`)
+}
+
+func TestLoadPageImages_MissingFile(t *testing.T) {
+	_, _, err := loadPageImages(`<img src="missing.png"/>`, t.TempDir())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing.png")
+}
+
+func TestOneNoteCreatePageCmd_FromMarkdownWithImage(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "shot.png"), []byte("png-bytes"), 0644))
+	mdFile := filepath.Join(dir, "note.md")
+	require.NoError(t, os.WriteFile(mdFile, []byte("# 会议\n\n![截图](shot.png)"), 0644))
+
+	var gotPath, gotContentType string
+	var gotBody []byte
+	mock := &testutil.MockClient{
+		PostRawFunc: func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+			gotPath = path
+			gotBody = data
+			gotContentType = contentType
+			return mustJSON(map[string]string{"id": "page-1", "title": "会议记录"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteCreatePageCmd{SectionID: "sec-1", Title: "会议记录", File: mdFile}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/onenote/sections/sec-1/pages", gotPath)
+	assert.Contains(t, output, "页面创建成功")
+
+	// 验证多部分请求体包含HTML部件和图片部件
+	mediaType, params, err := mime.ParseMediaType(gotContentType)
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	mr := multipart.NewReader(strings.NewReader(string(gotBody)), params["boundary"])
+	form, err := mr.ReadForm(1 << 20)
+	require.NoError(t, err)
+	defer form.RemoveAll()
+	require.Contains(t, form.Value, "Presentation")
+	assert.Contains(t, form.Value["Presentation"][0], `src="name:image1"`)
+	require.Contains(t, form.Value, "image1")
+	assert.Equal(t, "png-bytes", form.Value["image1"][0])
+}
+
+func TestOneNoteCreatePageCmd_FromHTMLFile(t *testing.T) {
+	dir := t.TempDir()
+	htmlFile := filepath.Join(dir, "note.html")
+	require.NoError(t, os.WriteFile(htmlFile, []byte("<p>正文</p>"), 0644))
+
+	var gotHTML string
+	mock := &testutil.MockClient{
+		PostHTMLFunc: func(ctx context.Context, path string, html string) ([]byte, error) {
+			gotHTML = html
+			return mustJSON(map[string]string{"id": "page-1", "title": "笔记"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteCreatePageCmd{SectionID: "sec-1", Title: "笔记", File: htmlFile}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, gotHTML, "<title>笔记</title>")
+	assert.Contains(t, gotHTML, "<p>正文</p>")
+}
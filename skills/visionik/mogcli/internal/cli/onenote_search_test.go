@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestOneNoteSearchCmd_SectionScopeAndPaging(t *testing.T) {
+	var gotPaths []string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPaths = append(gotPaths, path)
+			if len(gotPaths) == 1 {
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "page-1", "title": "Standup 周一"},
+					},
+					"@odata.nextLink": "https://graph.microsoft.com/v1.0/me/onenote/sections/sec-1/pages?$skip=100",
+				}), nil
+			}
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "page-2", "title": "Standup 周二"},
+					{"id": "page-3", "title": "购物清单"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteSearchCmd{Query: "standup", Section: "sec-1", Max: 25}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/me/onenote/sections/sec-1/pages",
+		"/me/onenote/sections/sec-1/pages",
+	}, gotPaths)
+	assert.Contains(t, output, "Standup 周一")
+	assert.Contains(t, output, "Standup 周二")
+	assert.NotContains(t, output, "购物清单")
+	assert.Contains(t, output, "2 条结果")
+}
+
+func TestOneNoteSearchCmd_ContentMatch(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			switch path {
+			case "/me/onenote/pages":
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "page-1", "title": "无关标题"},
+					},
+				}), nil
+			case "/me/onenote/pages/page-1/content":
+				return []byte("<html><body><p>这里提到了 deadline 两个字</p></body></html>"), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteSearchCmd{Query: "deadline", Content: true, Max: 25}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "无关标题")
+	assert.Contains(t, output, "deadline")
+}
+
+func TestOneNoteSearchCmd_NotebookScope(t *testing.T) {
+	var gotPaths []string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPaths = append(gotPaths, path)
+			if path == "/me/onenote/notebooks/nb-1/sections" {
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "sec-1", "displayName": "工作"},
+						{"id": "sec-2", "displayName": "个人"},
+					},
+				}), nil
+			}
+			return mustJSON(map[string]interface{}{"value": []map[string]interface{}{}}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &OneNoteSearchCmd{Query: "x", Notebook: "nb-1", Max: 25}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/me/onenote/notebooks/nb-1/sections",
+		"/me/onenote/sections/sec-1/pages",
+		"/me/onenote/sections/sec-2/pages",
+	}, gotPaths)
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// PeopleCmd 处理People API操作。
+type PeopleCmd struct {
+	Search PeopleSearchCmd `cmd:"" default:"withargs" help:"模糊搜索人员"`
+}
+
+// PeopleSearchCmd 通过 /me/people 模糊搜索人员, 结果按与当前用户的
+// 沟通相关性排序 — 比 displayName 的 contains() 过滤更适合
+// "John是谁" 这类查询。
+type PeopleSearchCmd struct {
+	Query string `arg:"" help:"搜索查询"`
+	Max   int    `help:"最大结果数" default:"10"`
+}
+
+// Person 表示People API返回的人员。
+type Person struct {
+	ID                   string `json:"id"`
+	DisplayName          string `json:"displayName"`
+	JobTitle             string `json:"jobTitle"`
+	Department           string `json:"department"`
+	CompanyName          string `json:"companyName"`
+	ScoredEmailAddresses []struct {
+		Address        string  `json:"address"`
+		RelevanceScore float64 `json:"relevanceScore"`
+	} `json:"scoredEmailAddresses"`
+	PersonType struct {
+		Class    string `json:"class"`
+		Subclass string `json:"subclass"`
+	} `json:"personType"`
+}
+
+// Run 执行people search命令。
+func (c *PeopleSearchCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$search", c.Query)
+	query.Set("$top", fmt.Sprintf("%d", c.Max))
+
+	data, err := client.Get(ctx, "/me/people", query)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []Person `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("未找到人员")
+		return nil
+	}
+
+	for _, p := range resp.Value {
+		email := ""
+		if len(p.ScoredEmailAddresses) > 0 {
+			email = p.ScoredEmailAddresses[0].Address
+		}
+		fmt.Printf("%-30s %-30s %s\n", p.DisplayName, email, graph.FormatID(p.ID))
+		if root.Verbose {
+			if p.JobTitle != "" || p.Department != "" {
+				fmt.Printf("   %s  %s\n", p.JobTitle, p.Department)
+			}
+			if p.CompanyName != "" {
+				fmt.Printf("   %s\n", p.CompanyName)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestPeopleSearchCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/people", path)
+			assert.Equal(t, "john", query.Get("$search"))
+			assert.Equal(t, "10", query.Get("$top"))
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id": "person-1", "displayName": "John Smith", "jobTitle": "Engineer",
+						"scoredEmailAddresses": []map[string]interface{}{
+							{"address": "john@example.com", "relevanceScore": 12.5},
+						},
+					},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &PeopleSearchCmd{Query: "john", Max: 10}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "John Smith")
+	assert.Contains(t, output, "john@example.com")
+}
+
+func TestPeopleSearchCmd_NoResults(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]interface{}{"value": []map[string]interface{}{}}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&PeopleSearchCmd{Query: "nobody", Max: 10}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "未找到人员")
+}
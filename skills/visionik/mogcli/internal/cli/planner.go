@@ -0,0 +1,337 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// PlannerCmd 处理Planner团队任务板操作。
+type PlannerCmd struct {
+	Plans    PlannerPlansCmd    `cmd:"" default:"1" help:"列出我的计划"`
+	Buckets  PlannerBucketsCmd  `cmd:"" help:"列出计划中的存储桶"`
+	Tasks    PlannerTasksCmd    `cmd:"" help:"列出计划中的任务"`
+	Create   PlannerCreateCmd   `cmd:"" help:"创建任务"`
+	Assign   PlannerAssignCmd   `cmd:"" help:"分配任务给用户"`
+	Progress PlannerProgressCmd `cmd:"" help:"更新任务进度"`
+}
+
+// PlannerPlan 表示Planner计划。
+type PlannerPlan struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// PlannerBucket 表示Planner存储桶。
+type PlannerBucket struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	PlanID string `json:"planId"`
+}
+
+// PlannerTask 表示Planner任务。
+type PlannerTask struct {
+	ETag            string                 `json:"@odata.etag"`
+	ID              string                 `json:"id"`
+	Title           string                 `json:"title"`
+	PercentComplete int                    `json:"percentComplete"`
+	BucketID        string                 `json:"bucketId"`
+	PlanID          string                 `json:"planId"`
+	DueDateTime     string                 `json:"dueDateTime"`
+	Assignments     map[string]interface{} `json:"assignments"`
+}
+
+// PlannerPlansCmd 列出我的计划。
+type PlannerPlansCmd struct{}
+
+// Run 执行planner plans命令。
+func (c *PlannerPlansCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/planner/plans", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []PlannerPlan `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("未找到计划")
+		return nil
+	}
+
+	for _, p := range resp.Value {
+		fmt.Printf("%-40s %s\n", p.Title, graph.FormatID(p.ID))
+	}
+	return nil
+}
+
+// PlannerBucketsCmd 列出计划中的存储桶。
+type PlannerBucketsCmd struct {
+	PlanID string `arg:"" help:"计划ID"`
+}
+
+// Run 执行planner buckets命令。
+func (c *PlannerBucketsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/planner/plans/%s/buckets", graph.ResolveID(c.PlanID))
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []PlannerBucket `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, b := range resp.Value {
+		fmt.Printf("%-40s %s\n", b.Name, graph.FormatID(b.ID))
+	}
+	return nil
+}
+
+// PlannerTasksCmd 列出计划中的任务 (省略计划ID时列出我的所有任务)。
+type PlannerTasksCmd struct {
+	PlanID string `arg:"" optional:"" help:"计划ID (默认: 我的任务)"`
+	Bucket string `help:"限定存储桶ID"`
+}
+
+// Run 执行planner tasks命令。
+func (c *PlannerTasksCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	var path string
+	switch {
+	case c.Bucket != "":
+		path = fmt.Sprintf("/planner/buckets/%s/tasks", graph.ResolveID(c.Bucket))
+	case c.PlanID != "":
+		path = fmt.Sprintf("/planner/plans/%s/tasks", graph.ResolveID(c.PlanID))
+	default:
+		path = "/me/planner/tasks"
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []PlannerTask `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("未找到任务")
+		return nil
+	}
+
+	for _, t := range resp.Value {
+		status := "○"
+		switch {
+		case t.PercentComplete >= 100:
+			status = "✓"
+		case t.PercentComplete > 0:
+			status = "◐"
+		}
+		due := ""
+		if len(t.DueDateTime) >= 10 {
+			due = t.DueDateTime[:10]
+		}
+		fmt.Printf("%s %3d%% %-10s %-40s %s\n", status, t.PercentComplete, due, t.Title, graph.FormatID(t.ID))
+	}
+	return nil
+}
+
+// PlannerCreateCmd 创建任务。
+type PlannerCreateCmd struct {
+	PlanID string `arg:"" help:"计划ID"`
+	Title  string `arg:"" help:"任务标题"`
+	Bucket string `help:"存储桶ID"`
+	Assign string `help:"分配给 (用户ID或邮箱)"`
+	Due    string `help:"截止日期 (YYYY-MM-DD)"`
+}
+
+// Run 执行planner create命令。
+func (c *PlannerCreateCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	body := map[string]interface{}{
+		"planId": graph.ResolveID(c.PlanID),
+		"title":  c.Title,
+	}
+	if c.Bucket != "" {
+		body["bucketId"] = graph.ResolveID(c.Bucket)
+	}
+	if c.Due != "" {
+		body["dueDateTime"] = c.Due + "T00:00:00Z"
+	}
+	if c.Assign != "" {
+		userID, err := resolvePlannerUser(ctx, client, c.Assign)
+		if err != nil {
+			return err
+		}
+		body["assignments"] = plannerAssignment(userID)
+	}
+
+	data, err := client.Post(ctx, "/planner/tasks", body)
+	if err != nil {
+		return err
+	}
+
+	var task PlannerTask
+	if err := json.Unmarshal(data, &task); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(task)
+	}
+
+	fmt.Println("✓ 任务创建成功")
+	fmt.Printf("  标题: %s\n", task.Title)
+	fmt.Printf("  ID: %s\n", graph.FormatID(task.ID))
+	return nil
+}
+
+// PlannerAssignCmd 分配任务给用户。
+type PlannerAssignCmd struct {
+	TaskID string `arg:"" help:"任务ID"`
+	User   string `arg:"" help:"用户ID或邮箱"`
+}
+
+// Run 执行planner assign命令。
+func (c *PlannerAssignCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	userID, err := resolvePlannerUser(ctx, client, c.User)
+	if err != nil {
+		return err
+	}
+
+	if err := patchPlannerTask(ctx, client, c.TaskID, map[string]interface{}{
+		"assignments": plannerAssignment(userID),
+	}); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 任务已分配")
+	return nil
+}
+
+// PlannerProgressCmd 更新任务进度。
+type PlannerProgressCmd struct {
+	TaskID  string `arg:"" help:"任务ID"`
+	Percent int    `arg:"" help:"完成百分比 (0/50/100)"`
+}
+
+// Run 执行planner progress命令。
+func (c *PlannerProgressCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := patchPlannerTask(ctx, client, c.TaskID, map[string]interface{}{
+		"percentComplete": c.Percent,
+	}); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 进度已更新为 %d%%\n", c.Percent)
+	return nil
+}
+
+// patchPlannerTask 读取任务的当前ETag后发送带If-Match的PATCH —
+// Planner要求每次更新都携带前置条件。
+func patchPlannerTask(ctx context.Context, client graph.Client, taskID string, updates map[string]interface{}) error {
+	path := fmt.Sprintf("/planner/tasks/%s", graph.ResolveID(taskID))
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	var task PlannerTask
+	if err := json.Unmarshal(data, &task); err != nil {
+		return err
+	}
+
+	_, err = client.PatchMatch(ctx, path, updates, task.ETag)
+	return err
+}
+
+// plannerAssignment 构造单个用户的assignments片段。
+func plannerAssignment(userID string) map[string]interface{} {
+	return map[string]interface{}{
+		userID: map[string]string{
+			"@odata.type": "#microsoft.graph.plannerAssignment",
+			"orderHint":   " !",
+		},
+	}
+}
+
+// resolvePlannerUser 把邮箱解析为用户ID, 已是ID的输入原样返回。
+func resolvePlannerUser(ctx context.Context, client graph.Client, user string) (string, error) {
+	if !strings.Contains(user, "@") {
+		return graph.ResolveID(user), nil
+	}
+
+	data, err := client.Get(ctx, fmt.Sprintf("/users/%s", user), nil)
+	if err != nil {
+		return "", fmt.Errorf("无法解析用户 %s: %w", user, err)
+	}
+	var u struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return "", err
+	}
+	return u.ID, nil
+}
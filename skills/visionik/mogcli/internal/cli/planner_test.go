@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestPlannerPlansCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/planner/plans", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "plan-1", "title": "发布计划"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&PlannerPlansCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "发布计划")
+}
+
+func TestPlannerTasksCmd_Scoping(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			gotPath = path
+			return mustJSON(map[string]interface{}{"value": []map[string]interface{}{}}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&PlannerTasksCmd{}).Run(root) })
+	require.NoError(t, err)
+	assert.Equal(t, "/me/planner/tasks", gotPath)
+
+	captureOutput(func() { err = (&PlannerTasksCmd{PlanID: "plan-1"}).Run(root) })
+	require.NoError(t, err)
+	assert.Equal(t, "/planner/plans/plan-1/tasks", gotPath)
+
+	captureOutput(func() { err = (&PlannerTasksCmd{Bucket: "bucket-1"}).Run(root) })
+	require.NoError(t, err)
+	assert.Equal(t, "/planner/buckets/bucket-1/tasks", gotPath)
+}
+
+func TestPlannerCreateCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/users/alice@example.com", path)
+			return mustJSON(map[string]string{"id": "user-1"}), nil
+		},
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/planner/tasks", path)
+			gotBody = body
+			return mustJSON(map[string]string{"id": "task-1", "title": "写文档"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &PlannerCreateCmd{PlanID: "plan-1", Title: "写文档", Bucket: "bucket-1", Assign: "alice@example.com", Due: "2026-09-01"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "任务创建成功")
+
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, "plan-1", body["planId"])
+	assert.Equal(t, "bucket-1", body["bucketId"])
+	assert.Equal(t, "2026-09-01T00:00:00Z", body["dueDateTime"])
+	assignments := body["assignments"].(map[string]interface{})
+	require.Contains(t, assignments, "user-1")
+}
+
+func TestPlannerProgressCmd_UsesETag(t *testing.T) {
+	var gotEtag string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/planner/tasks/task-1", path)
+			return mustJSON(map[string]string{"@odata.etag": "W/\"etag-1\"", "id": "task-1"}), nil
+		},
+		PatchMatchFunc: func(ctx context.Context, path string, body interface{}, etag string) ([]byte, error) {
+			assert.Equal(t, "/planner/tasks/task-1", path)
+			gotEtag = etag
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&PlannerProgressCmd{TaskID: "task-1", Percent: 50}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "W/\"etag-1\"", gotEtag)
+	assert.Equal(t, map[string]interface{}{"percentComplete": 50}, gotBody)
+}
+
+func TestPlannerAssignCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]string{"@odata.etag": "W/\"etag-2\"", "id": "task-1"}), nil
+		},
+		PatchMatchFunc: func(ctx context.Context, path string, body interface{}, etag string) ([]byte, error) {
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&PlannerAssignCmd{TaskID: "task-1", User: "user-9"}).Run(root) })
+
+	require.NoError(t, err)
+	assignments := gotBody.(map[string]interface{})["assignments"].(map[string]interface{})
+	require.Contains(t, assignments, "user-9")
+}
@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// PPTReadCmd 读取演示文稿内容, 解析.pptx并输出幻灯片标题和正文,
+// 无需Graph beta API。
+type PPTReadCmd struct {
+	ID string `arg:"" help:"演示文稿ID"`
+}
+
+// Run 执行ppt read命令。
+func (c *PPTReadCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/drive/items/%s/content", graph.ResolveID(c.ID))
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	slides, err := parsePptxSlides(data)
+	if err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(slides)
+	}
+
+	if len(slides) == 0 {
+		fmt.Println("未找到幻灯片")
+		return nil
+	}
+
+	for i, slide := range slides {
+		if i > 0 {
+			fmt.Println()
+		}
+		if slide.Title != "" {
+			fmt.Printf("## 幻灯片 %d: %s\n", slide.Index, slide.Title)
+		} else {
+			fmt.Printf("## 幻灯片 %d\n", slide.Index)
+		}
+		for _, bullet := range slide.Bullets {
+			fmt.Printf("- %s\n", bullet)
+		}
+	}
+	return nil
+}
+
+// pptSlide 是从.pptx中提取的单张幻灯片内容。
+type pptSlide struct {
+	Index   int      `json:"index"`
+	Title   string   `json:"title"`
+	Bullets []string `json:"bullets"`
+}
+
+var slidePathRe = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+
+// parsePptxSlides 解析.pptx包, 按顺序返回每张幻灯片的标题和正文段落。
+func parsePptxSlides(pptx []byte) ([]pptSlide, error) {
+	zr, err := zip.NewReader(bytes.NewReader(pptx), int64(len(pptx)))
+	if err != nil {
+		return nil, fmt.Errorf("无法读取pptx: %w", err)
+	}
+
+	type numberedSlide struct {
+		num  int
+		file *zip.File
+	}
+	var files []numberedSlide
+	for _, f := range zr.File {
+		m := slidePathRe.FindStringSubmatch(f.Name)
+		if m == nil {
+			continue
+		}
+		num, _ := strconv.Atoi(m[1])
+		files = append(files, numberedSlide{num: num, file: f})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].num < files[j].num })
+
+	var slides []pptSlide
+	for i, f := range files {
+		r, err := f.file.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		slide, err := parseSlideXML(content)
+		if err != nil {
+			return nil, fmt.Errorf("解析幻灯片 %d 失败: %w", f.num, err)
+		}
+		slide.Index = i + 1
+		slides = append(slides, slide)
+	}
+	return slides, nil
+}
+
+// parseSlideXML 从单张幻灯片XML中提取标题 (ph type=title/ctrTitle 的形状)
+// 和其余文本段落。
+func parseSlideXML(content []byte) (pptSlide, error) {
+	var slide pptSlide
+	dec := xml.NewDecoder(bytes.NewReader(content))
+
+	inShape := false
+	isTitle := false
+	var shapeParas []string
+	var para strings.Builder
+	inPara := false
+	inText := false
+
+	flushShape := func() {
+		if isTitle && slide.Title == "" {
+			slide.Title = strings.Join(shapeParas, " ")
+		} else {
+			slide.Bullets = append(slide.Bullets, shapeParas...)
+		}
+		shapeParas = nil
+	}
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return pptSlide{}, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "sp":
+				inShape = true
+				isTitle = false
+				shapeParas = nil
+			case "ph":
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "type" && (attr.Value == "title" || attr.Value == "ctrTitle") {
+						isTitle = true
+					}
+				}
+			case "p":
+				if inShape {
+					inPara = true
+					para.Reset()
+				}
+			case "t":
+				inText = inPara
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "sp":
+				if inShape {
+					flushShape()
+					inShape = false
+				}
+			case "p":
+				if inPara {
+					if text := strings.TrimSpace(para.String()); text != "" {
+						shapeParas = append(shapeParas, text)
+					}
+					inPara = false
+				}
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				para.Write(t)
+			}
+		}
+	}
+	return slide, nil
+}
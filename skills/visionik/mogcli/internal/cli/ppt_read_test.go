@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+const testSlideXML = `<p:sld xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+<p:cSld><p:spTree>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="title"/></p:nvPr></p:nvSpPr>
+<p:txBody><a:p><a:r><a:t>%s</a:t></a:r></a:p></p:txBody></p:sp>
+<p:sp><p:nvSpPr><p:nvPr><p:ph type="body"/></p:nvPr></p:nvSpPr>
+<p:txBody><a:p><a:r><a:t>First </a:t></a:r><a:r><a:t>point</a:t></a:r></a:p>
+<a:p><a:r><a:t>Second point</a:t></a:r></a:p></p:txBody></p:sp>
+</p:spTree></p:cSld></p:sld>`
+
+func makeTestPptx(t *testing.T, slides map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	parts := map[string]string{"[Content_Types].xml": `<?xml version="1.0"?><Types/>`}
+	for name, content := range slides {
+		parts[name] = content
+	}
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestParsePptxSlides(t *testing.T) {
+	pptx := makeTestPptx(t, map[string]string{
+		"ppt/slides/slide2.xml":  `<p:sld xmlns:a="a" xmlns:p="p"><p:sp><p:txBody><a:p><a:r><a:t>Closing</a:t></a:r></a:p></p:txBody></p:sp></p:sld>`,
+		"ppt/slides/slide10.xml": `<p:sld xmlns:a="a" xmlns:p="p"></p:sld>`,
+		"ppt/slides/slide1.xml":  fmt.Sprintf(testSlideXML, "Intro"),
+	})
+
+	slides, err := parsePptxSlides(pptx)
+	require.NoError(t, err)
+	require.Len(t, slides, 3)
+
+	// 按编号排序: slide1, slide2, slide10
+	assert.Equal(t, "Intro", slides[0].Title)
+	assert.Equal(t, []string{"First point", "Second point"}, slides[0].Bullets)
+	assert.Equal(t, "", slides[1].Title)
+	assert.Equal(t, []string{"Closing"}, slides[1].Bullets)
+	assert.Equal(t, 3, slides[2].Index)
+}
+
+func TestPPTReadCmd_Run(t *testing.T) {
+	pptx := makeTestPptx(t, map[string]string{
+		"ppt/slides/slide1.xml": fmt.Sprintf(testSlideXML, "季度回顾"),
+	})
+
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/deck-1/content", path)
+			return pptx, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&PPTReadCmd{ID: "deck-1"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "## 幻灯片 1: 季度回顾")
+	assert.Contains(t, output, "- First point")
+	assert.Contains(t, output, "- Second point")
+}
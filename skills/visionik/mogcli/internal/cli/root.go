@@ -2,9 +2,15 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/visionik/mogcli/internal/config"
 	"github.com/visionik/mogcli/internal/graph"
 )
 
@@ -15,29 +21,75 @@ type ClientFactory func() (graph.Client, error)
 // Root 是命令行工具的顶层结构体。
 type Root struct {
 	// 全局标志
-	AIHelp  bool        `name:"ai-help" help:"显示 AI/LLM 智能体的详细帮助信息"`
-	JSON    bool        `help:"输出 JSON 到标准输出 (最适合脚本处理)" xor:"format"`
-	Plain   bool        `help:"输出稳定的、可解析的文本到标准输出 (TSV格式; 无颜色)" xor:"format"`
-	Verbose bool        `help:"显示完整 ID 和额外详细信息" short:"v"`
-	Force   bool        `help:"跳过破坏性命令的确认提示"`
-	NoInput bool        `help:"从不提示; 直接失败 (适用于 CI 环境)" name:"no-input"`
-	Version VersionFlag `name:"version" help:"打印版本信息并退出"`
+	AIHelp  bool          `name:"ai-help" help:"显示 AI/LLM 智能体的详细帮助信息"`
+	JSON    bool          `help:"输出 JSON 到标准输出 (最适合脚本处理)" xor:"format"`
+	Plain   bool          `help:"输出稳定的、可解析的文本到标准输出 (TSV格式; 无颜色)" xor:"format"`
+	Verbose bool          `help:"显示完整 ID 和额外详细信息" short:"v"`
+	Fields  string        `help:"覆盖 $select 投影的字段列表（逗号分隔）"`
+	Force   bool          `help:"跳过破坏性命令的确认提示"`
+	NoInput bool          `help:"从不提示; 直接失败 (适用于 CI 环境)" name:"no-input"`
+	Profile string        `help:"使用指定的账户配置档案 (个人/工作租户等)" short:"p" env:"MOG_PROFILE"`
+	Timeout time.Duration `help:"所有 Graph 请求的总超时 (如 30s, 2m; 0 表示不限制)" default:"0"`
+	DryRun  bool          `name:"dry-run" help:"打印将要发出的写请求而不执行 (读请求照常)"`
+	Stats   bool          `help:"命令结束后向标准错误输出请求统计"`
+	Version VersionFlag   `name:"version" help:"打印版本信息并退出"`
 
 	// 子命令
-	Auth     AuthCmd     `cmd:"" help:"身份验证"`
-	Mail     MailCmd     `cmd:"" aliases:"email" help:"邮件操作"`
-	Calendar CalendarCmd `cmd:"" aliases:"cal" help:"日历操作"`
-	Drive    DriveCmd    `cmd:"" help:"OneDrive 文件操作"`
-	Contacts ContactsCmd `cmd:"" help:"联系人操作"`
-	Tasks    TasksCmd    `cmd:"" aliases:"todo" help:"Microsoft To-Do 任务"`
-	Excel    ExcelCmd    `cmd:"" help:"Excel 电子表格操作"`
-	OneNote  OneNoteCmd  `cmd:"" aliases:"onenote" help:"OneNote 操作"`
-	Word     WordCmd     `cmd:"" help:"Word 文档操作"`
-	PPT      PPTCmd      `cmd:"" aliases:"ppt,powerpoint" help:"PowerPoint 操作"`
+	Auth      AuthCmd      `cmd:"" help:"身份验证"`
+	Mail      MailCmd      `cmd:"" aliases:"email" help:"邮件操作"`
+	Calendar  CalendarCmd  `cmd:"" aliases:"cal" help:"日历操作"`
+	Drive     DriveCmd     `cmd:"" help:"OneDrive 文件操作"`
+	Contacts  ContactsCmd  `cmd:"" help:"联系人操作"`
+	Search    SearchCmd    `cmd:"" help:"跨邮件/日历/文件统一搜索"`
+	People    PeopleCmd    `cmd:"" help:"按沟通相关性搜索人员"`
+	Me        MeCmd        `cmd:"" help:"我的资料和组织结构"`
+	Users     UsersCmd     `cmd:"" help:"组织用户查询"`
+	Tasks     TasksCmd     `cmd:"" aliases:"todo" help:"Microsoft To-Do 任务"`
+	Teams     TeamsCmd     `cmd:"" help:"Teams 聊天和频道消息"`
+	Planner   PlannerCmd   `cmd:"" help:"Planner 团队任务板"`
+	Subscribe SubscribeCmd `cmd:"" help:"Graph 变更通知订阅"`
+	Excel     ExcelCmd     `cmd:"" help:"Excel 电子表格操作"`
+	OneNote   OneNoteCmd   `cmd:"" aliases:"onenote" help:"OneNote 操作"`
+	Word      WordCmd      `cmd:"" help:"Word 文档操作"`
+	PPT       PPTCmd       `cmd:"" aliases:"ppt,powerpoint" help:"PowerPoint 操作"`
+	Ids       IdsCmd       `cmd:"" help:"短 ID 缓存维护"`
+
+	SelfUpdate SelfUpdateCmd `cmd:"" name:"self-update" help:"更新 mog 到最新版本"`
 
 	// ClientFactory 允许注入自定义客户端工厂以用于测试。
 	// 如果为 nil，则使用 graph.NewClient。
 	ClientFactory ClientFactory `kong:"-"`
+
+	// cancelRequests 释放 AfterApply 安装的信号处理器和超时。
+	cancelRequests context.CancelFunc `kong:"-"`
+}
+
+// AfterApply 在参数解析后激活所选的账户配置档案, 并安装进程级
+// 请求上下文: Ctrl-C 取消进行中的请求, --timeout 约束总耗时。
+func (r *Root) AfterApply() error {
+	if r.Profile != "" {
+		if strings.ContainsAny(r.Profile, `/\`) {
+			return fmt.Errorf("无效的 profile 名称: %s", r.Profile)
+		}
+		config.SetProfile(r.Profile)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	cancel := context.CancelFunc(stop)
+	if r.Timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, r.Timeout)
+		prev := cancel
+		cancel = func() {
+			cancelTimeout()
+			prev()
+		}
+	}
+	graph.SetRootContext(ctx)
+	r.cancelRequests = cancel
+
+	graph.SetDryRun(r.DryRun)
+	return nil
 }
 
 // GetClient 使用配置的工厂或默认值返回 Graph 客户端。
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// SearchCmd 通过 Microsoft Search API 跨工作负载统一搜索。
+type SearchCmd struct {
+	Query string `arg:"" help:"搜索查询"`
+	Types string `help:"实体类型 (逗号分隔: message,event,driveItem,person)" default:"message,event,driveItem"`
+	Max   int    `help:"每种类型的最大结果数" default:"25"`
+}
+
+// searchHit 表示一条统一搜索结果。
+type searchHit struct {
+	Type    string  `json:"type"`
+	ID      string  `json:"id"`
+	Title   string  `json:"title"`
+	Summary string  `json:"summary,omitempty"`
+	Rank    float64 `json:"rank"`
+}
+
+// Run 执行search命令。
+func (c *SearchCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var hits []searchHit
+
+	// Search API 不允许在同一请求中混用大多数实体类型,
+	// 因此每种类型单独查询一次。
+	for _, entityType := range strings.Split(c.Types, ",") {
+		entityType = strings.TrimSpace(entityType)
+		if entityType == "" {
+			continue
+		}
+
+		typeHits, err := searchEntityType(ctx, client, entityType, c.Query, c.Max)
+		if err != nil {
+			return fmt.Errorf("搜索 %s 失败: %w", entityType, err)
+		}
+		hits = append(hits, typeHits...)
+	}
+
+	if root.JSON {
+		return outputJSON(hits)
+	}
+
+	if len(hits) == 0 {
+		fmt.Println("未找到结果")
+		return nil
+	}
+
+	for _, h := range hits {
+		fmt.Printf("%-10s %-50s %s\n", h.Type, h.Title, graph.FormatID(h.ID))
+		if root.Verbose && h.Summary != "" {
+			fmt.Printf("           %s\n", h.Summary)
+		}
+	}
+	return nil
+}
+
+// searchEntityType 对单一实体类型执行一次 /search/query 请求。
+func searchEntityType(ctx context.Context, client graph.Client, entityType, query string, max int) ([]searchHit, error) {
+	body := map[string]interface{}{
+		"requests": []map[string]interface{}{
+			{
+				"entityTypes": []string{entityType},
+				"query":       map[string]string{"queryString": query},
+				"from":        0,
+				"size":        max,
+			},
+		},
+	}
+
+	data, err := client.Post(ctx, "/search/query", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Value []struct {
+			HitsContainers []struct {
+				Hits []struct {
+					HitID    string          `json:"hitId"`
+					Rank     float64         `json:"rank"`
+					Summary  string          `json:"summary"`
+					Resource json.RawMessage `json:"resource"`
+				} `json:"hits"`
+			} `json:"hitsContainers"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+
+	var hits []searchHit
+	for _, v := range resp.Value {
+		for _, container := range v.HitsContainers {
+			for _, hit := range container.Hits {
+				hits = append(hits, searchHit{
+					Type:    entityType,
+					ID:      hit.HitID,
+					Title:   resourceTitle(hit.Resource),
+					Summary: strings.TrimSpace(stripHTML(hit.Summary)),
+					Rank:    hit.Rank,
+				})
+			}
+		}
+	}
+	return hits, nil
+}
+
+// resourceTitle 从搜索结果的资源对象中提取标题字段 —
+// 不同实体类型用不同的字段名。
+func resourceTitle(resource json.RawMessage) string {
+	var fields struct {
+		Subject     string `json:"subject"`     // message, event
+		Name        string `json:"name"`        // driveItem
+		DisplayName string `json:"displayName"` // person
+	}
+	if json.Unmarshal(resource, &fields) != nil {
+		return ""
+	}
+	switch {
+	case fields.Subject != "":
+		return fields.Subject
+	case fields.Name != "":
+		return fields.Name
+	default:
+		return fields.DisplayName
+	}
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func searchResponse(entityType, id, title string) []byte {
+	titleField := "subject"
+	if entityType == "driveItem" {
+		titleField = "name"
+	}
+	return mustJSON(map[string]interface{}{
+		"value": []map[string]interface{}{
+			{
+				"hitsContainers": []map[string]interface{}{
+					{
+						"hits": []map[string]interface{}{
+							{
+								"hitId":    id,
+								"rank":     1,
+								"summary":  "<b>片段</b>文本",
+								"resource": map[string]string{titleField: title},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestSearchCmd_Run(t *testing.T) {
+	var gotTypes []string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/search/query", path)
+			req := body.(map[string]interface{})["requests"].([]map[string]interface{})[0]
+			entityType := req["entityTypes"].([]string)[0]
+			gotTypes = append(gotTypes, entityType)
+			assert.Equal(t, "季度报告", req["query"].(map[string]string)["queryString"])
+
+			if entityType == "message" {
+				return searchResponse("message", "msg-1", "季度报告草稿"), nil
+			}
+			return searchResponse("driveItem", "file-1", "季度报告.docx"), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &SearchCmd{Query: "季度报告", Types: "message,driveItem", Max: 10}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"message", "driveItem"}, gotTypes)
+	assert.Contains(t, output, "季度报告草稿")
+	assert.Contains(t, output, "季度报告.docx")
+}
+
+func TestResourceTitle(t *testing.T) {
+	assert.Equal(t, "主题", resourceTitle([]byte(`{"subject":"主题"}`)))
+	assert.Equal(t, "a.txt", resourceTitle([]byte(`{"name":"a.txt"}`)))
+	assert.Equal(t, "张三", resourceTitle([]byte(`{"displayName":"张三"}`)))
+	assert.Equal(t, "", resourceTitle([]byte(`{}`)))
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"net/url"
+	"strings"
+)
+
+// applySelect 将 $select 投影应用到查询参数上。
+// 默认投影只包含命令展示所需的字段，从而减小响应体积；
+// 全局 --fields 标志可以用自定义的逗号分隔列表覆盖默认投影。
+func applySelect(query url.Values, root *Root, defaults string) url.Values {
+	if query == nil {
+		query = url.Values{}
+	}
+	fields := defaults
+	if root.Fields != "" {
+		fields = normalizeFields(root.Fields)
+	}
+	if fields != "" {
+		query.Set("$select", fields)
+	}
+	return query
+}
+
+// normalizeFields 清理逗号分隔的字段列表（去除空白和空项）。
+func normalizeFields(s string) string {
+	parts := strings.Split(s, ",")
+	fields := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			fields = append(fields, p)
+		}
+	}
+	return strings.Join(fields, ",")
+}
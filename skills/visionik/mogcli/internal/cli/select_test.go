@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplySelect(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    url.Values
+		root     *Root
+		defaults string
+		want     string
+	}{
+		{
+			name:     "defaults applied",
+			root:     &Root{},
+			defaults: "id,name",
+			want:     "id,name",
+		},
+		{
+			name:     "fields override defaults",
+			root:     &Root{Fields: "id,webUrl"},
+			defaults: "id,name",
+			want:     "id,webUrl",
+		},
+		{
+			name:     "fields normalized",
+			root:     &Root{Fields: " id , name ,, size "},
+			defaults: "id",
+			want:     "id,name,size",
+		},
+		{
+			name:     "empty defaults leave query untouched",
+			root:     &Root{},
+			defaults: "",
+			want:     "",
+		},
+		{
+			name:     "existing query preserved",
+			query:    url.Values{"$top": []string{"10"}},
+			root:     &Root{},
+			defaults: "id",
+			want:     "id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := applySelect(tt.query, tt.root, tt.defaults)
+			assert.Equal(t, tt.want, got.Get("$select"))
+			if tt.query != nil {
+				assert.Equal(t, tt.query.Get("$top"), got.Get("$top"))
+			}
+		})
+	}
+}
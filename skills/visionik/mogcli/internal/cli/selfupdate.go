@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/visionik/mogcli/internal/selfupdate"
+)
+
+// BuildVersion 是构建时注入的当前版本，由 main 设置。
+var BuildVersion = "dev"
+
+// SelfUpdateCmd 检查发布源并原地更新 mog 二进制文件。
+// 下载内容经过签名校验和验证后原子地替换当前可执行文件。
+// --check 仅报告是否有可用更新 (适用于 CI 镜像)。
+type SelfUpdateCmd struct {
+	Channel string `help:"发布通道" default:"stable" enum:"stable,edge"`
+	Check   bool   `help:"仅检查更新，不安装 (有更新时退出码为 1)"`
+}
+
+// Run 执行 self-update 命令。
+func (c *SelfUpdateCmd) Run(root *Root) error {
+	feedURL := selfupdate.FeedURL(selfupdate.DefaultFeedBase, "mog", c.Channel)
+
+	rel, err := selfupdate.Check(feedURL)
+	if err != nil {
+		return fmt.Errorf("检查更新失败: %w", err)
+	}
+
+	hasUpdate := selfupdate.IsNewer(BuildVersion, rel.Version)
+
+	if c.Check {
+		if root.JSON {
+			return outputJSON(map[string]interface{}{
+				"current":   BuildVersion,
+				"latest":    rel.Version,
+				"hasUpdate": hasUpdate,
+			})
+		}
+		if hasUpdate {
+			fmt.Printf("有可用更新: %s -> %s\n", BuildVersion, rel.Version)
+			os.Exit(1)
+		}
+		fmt.Printf("已是最新版本 (%s)\n", BuildVersion)
+		return nil
+	}
+
+	if !hasUpdate {
+		fmt.Printf("已是最新版本 (%s)\n", BuildVersion)
+		return nil
+	}
+
+	fmt.Printf("发现新版本: %s -> %s\n", BuildVersion, rel.Version)
+
+	binary, err := selfupdate.Download(rel)
+	if err != nil {
+		return fmt.Errorf("下载更新失败: %w", err)
+	}
+
+	execPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("无法确定可执行文件路径: %w", err)
+	}
+
+	if err := selfupdate.Apply(binary, execPath); err != nil {
+		return fmt.Errorf("应用更新失败: %w", err)
+	}
+
+	fmt.Printf("✓ 已更新到 %s\n", rel.Version)
+	return nil
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// SubscribeCmd 管理 Graph 变更通知订阅。
+type SubscribeCmd struct {
+	List   SubscribeListCmd   `cmd:"" default:"1" help:"列出活动订阅"`
+	Create SubscribeCreateCmd `cmd:"" help:"创建订阅"`
+	Renew  SubscribeRenewCmd  `cmd:"" help:"续订订阅"`
+	Delete SubscribeDeleteCmd `cmd:"" aliases:"rm" help:"删除订阅"`
+	Listen SubscribeListenCmd `cmd:"" help:"运行本地通知接收端点"`
+}
+
+// Subscription 表示 Graph 变更通知订阅。
+type Subscription struct {
+	ID                 string `json:"id"`
+	Resource           string `json:"resource"`
+	ChangeType         string `json:"changeType"`
+	NotificationURL    string `json:"notificationUrl"`
+	ExpirationDateTime string `json:"expirationDateTime"`
+	ClientState        string `json:"clientState,omitempty"`
+}
+
+// SubscribeListCmd 列出活动订阅。
+type SubscribeListCmd struct{}
+
+// Run 执行subscribe list命令。
+func (c *SubscribeListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/subscriptions", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []Subscription `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("没有活动订阅")
+		return nil
+	}
+
+	for _, s := range resp.Value {
+		fmt.Printf("%-38s %-30s %-20s 到期 %s\n", s.ID, s.Resource, s.ChangeType, s.ExpirationDateTime)
+	}
+	return nil
+}
+
+// SubscribeCreateCmd 创建订阅。
+type SubscribeCreateCmd struct {
+	Resource string `arg:"" help:"资源路径 (如 /me/messages, /me/events, /me/drive/root)"`
+	URL      string `help:"通知端点 URL (必须是可公开访问的 HTTPS)" required:""`
+	Changes  string `help:"变更类型 (逗号分隔)" default:"created,updated"`
+	Minutes  int    `help:"有效期 (分钟; 邮件最长约 4230)" default:"4230"`
+	State    string `help:"clientState 校验密钥 (默认随机生成)"`
+}
+
+// Run 执行subscribe create命令。
+func (c *SubscribeCreateCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	state := c.State
+	if state == "" {
+		buf := make([]byte, 16)
+		if _, err := rand.Read(buf); err != nil {
+			return err
+		}
+		state = hex.EncodeToString(buf)
+	}
+
+	body := map[string]interface{}{
+		"resource":           c.Resource,
+		"changeType":         c.Changes,
+		"notificationUrl":    c.URL,
+		"expirationDateTime": subscriptionExpiry(c.Minutes),
+		"clientState":        state,
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, "/subscriptions", body)
+	if err != nil {
+		return err
+	}
+
+	var sub Subscription
+	if err := json.Unmarshal(data, &sub); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(sub)
+	}
+
+	fmt.Println("✓ 订阅创建成功")
+	fmt.Printf("  ID: %s\n", sub.ID)
+	fmt.Printf("  资源: %s\n", sub.Resource)
+	fmt.Printf("  到期: %s\n", sub.ExpirationDateTime)
+	fmt.Printf("  clientState: %s\n", state)
+	return nil
+}
+
+// SubscribeRenewCmd 续订订阅。
+type SubscribeRenewCmd struct {
+	ID      string `arg:"" help:"订阅 ID"`
+	Minutes int    `help:"新有效期 (分钟)" default:"4230"`
+}
+
+// Run 执行subscribe renew命令。
+func (c *SubscribeRenewCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	body := map[string]interface{}{
+		"expirationDateTime": subscriptionExpiry(c.Minutes),
+	}
+	data, err := client.Patch(ctx, fmt.Sprintf("/subscriptions/%s", c.ID), body)
+	if err != nil {
+		return err
+	}
+
+	var sub Subscription
+	if err := json.Unmarshal(data, &sub); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已续订到 %s\n", sub.ExpirationDateTime)
+	return nil
+}
+
+// SubscribeDeleteCmd 删除订阅。
+type SubscribeDeleteCmd struct {
+	ID string `arg:"" help:"订阅 ID"`
+}
+
+// Run 执行subscribe delete命令。
+func (c *SubscribeDeleteCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.Delete(ctx, fmt.Sprintf("/subscriptions/%s", c.ID)); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 订阅已删除")
+	return nil
+}
+
+// SubscribeListenCmd 运行本地通知接收端点。
+type SubscribeListenCmd struct {
+	Port int    `help:"监听端口" default:"8443"`
+	Cert string `help:"TLS 证书文件 (省略时用纯 HTTP, 适合 TLS 终结的隧道后面)" type:"existingfile"`
+	Key  string `help:"TLS 私钥文件" type:"existingfile"`
+}
+
+// Run 执行subscribe listen命令。
+func (c *SubscribeListenCmd) Run(root *Root) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		handleNotification(w, r, os.Stdout)
+	})
+
+	addr := fmt.Sprintf(":%d", c.Port)
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	if c.Cert != "" {
+		fmt.Fprintf(os.Stderr, "在 https://0.0.0.0%s 上监听通知 (Ctrl-C 停止)\n", addr)
+		return server.ListenAndServeTLS(c.Cert, c.Key)
+	}
+	fmt.Fprintf(os.Stderr, "在 http://0.0.0.0%s 上监听通知 (Ctrl-C 停止)\n", addr)
+	return server.ListenAndServe()
+}
+
+// handleNotification 处理单个通知请求: 回答 Graph 的端点验证握手,
+// 并把收到的每条通知作为一行 JSON 写到 out。
+func handleNotification(w http.ResponseWriter, r *http.Request, out io.Writer) {
+	// 订阅创建时 Graph 用 validationToken 查询参数验证端点
+	if token := r.URL.Query().Get("validationToken"); token != "" {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, token)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var payload struct {
+		Value []json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	for _, n := range payload.Value {
+		fmt.Fprintln(out, string(n))
+	}
+
+	// Graph 要求快速应答, 否则会重试投递
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// subscriptionExpiry 计算从现在起 minutes 分钟后的到期时间戳。
+func subscriptionExpiry(minutes int) string {
+	return time.Now().Add(time.Duration(minutes) * time.Minute).UTC().Format(time.RFC3339)
+}
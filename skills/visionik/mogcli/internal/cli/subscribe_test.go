@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestSubscribeListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/subscriptions", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "sub-1", "resource": "/me/messages", "changeType": "created", "expirationDateTime": "2026-09-01T00:00:00Z"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&SubscribeListCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "sub-1")
+	assert.Contains(t, output, "/me/messages")
+}
+
+func TestSubscribeCreateCmd_Run(t *testing.T) {
+	var gotBody map[string]interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/subscriptions", path)
+			gotBody = body.(map[string]interface{})
+			return mustJSON(map[string]string{
+				"id": "sub-1", "resource": "/me/messages", "expirationDateTime": "2026-09-01T00:00:00Z",
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &SubscribeCreateCmd{Resource: "/me/messages", URL: "https://example.com/hook", Changes: "created", Minutes: 60}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "订阅创建成功")
+	assert.Equal(t, "/me/messages", gotBody["resource"])
+	assert.Equal(t, "created", gotBody["changeType"])
+	assert.Equal(t, "https://example.com/hook", gotBody["notificationUrl"])
+	// 未指定时自动生成 clientState
+	assert.NotEmpty(t, gotBody["clientState"])
+}
+
+func TestSubscribeRenewCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			return mustJSON(map[string]string{"id": "sub-1", "expirationDateTime": "2026-09-02T00:00:00Z"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&SubscribeRenewCmd{ID: "sub-1", Minutes: 60}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/subscriptions/sub-1", gotPath)
+	assert.Contains(t, output, "已续订")
+}
+
+func TestHandleNotification_Validation(t *testing.T) {
+	var out strings.Builder
+	r := httptest.NewRequest("POST", "/?validationToken=token-123", nil)
+	w := httptest.NewRecorder()
+
+	handleNotification(w, r, &out)
+
+	assert.Equal(t, 200, w.Code)
+	assert.Equal(t, "token-123", w.Body.String())
+	assert.Empty(t, out.String())
+}
+
+func TestHandleNotification_PrintsJSONL(t *testing.T) {
+	var out strings.Builder
+	payload := `{"value":[{"subscriptionId":"sub-1","changeType":"created"},{"subscriptionId":"sub-1","changeType":"updated"}]}`
+	r := httptest.NewRequest("POST", "/", strings.NewReader(payload))
+	w := httptest.NewRecorder()
+
+	handleNotification(w, r, &out)
+
+	assert.Equal(t, 202, w.Code)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"changeType":"created"`)
+	assert.Contains(t, lines[1], `"changeType":"updated"`)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/visionik/mogcli/internal/graph"
@@ -12,7 +13,7 @@ import (
 
 // TasksCmd handles Microsoft To-Do operations.
 type TasksCmd struct {
-	Lists  TasksListsCmd  `cmd:"" help:"List task lists"`
+	Lists  TasksListsCmd  `cmd:"" help:"Manage task lists"`
 	List   TasksListCmd   `cmd:"" help:"List tasks in a list"`
 	Add    TasksAddCmd    `cmd:"" aliases:"create" help:"Add a task"`
 	Update TasksUpdateCmd `cmd:"" help:"Update a task"`
@@ -20,13 +21,27 @@ type TasksCmd struct {
 	Undo   TasksUndoCmd   `cmd:"" help:"Mark task as not done"`
 	Delete TasksDeleteCmd `cmd:"" aliases:"rm" help:"Delete a task"`
 	Clear  TasksClearCmd  `cmd:"" help:"Clear completed tasks"`
+
+	Checklist TasksChecklistCmd `cmd:"" help:"Manage checklist items (subtasks)"`
+	Today     TasksTodayCmd     `cmd:"" help:"Tasks due today or earlier, across all lists"`
+	Overdue   TasksOverdueCmd   `cmd:"" help:"Overdue tasks across all lists"`
+
+	SyncMd TasksSyncMdCmd `cmd:"" name:"sync-md" help:"Sync a Markdown checklist with a task list"`
 }
 
-// TasksListsCmd lists task lists.
-type TasksListsCmd struct{}
+// TasksListsCmd manages task lists.
+type TasksListsCmd struct {
+	List   TasksListsListCmd   `cmd:"" default:"1" help:"List task lists"`
+	Create TasksListsCreateCmd `cmd:"" help:"Create a task list"`
+	Rename TasksListsRenameCmd `cmd:"" help:"Rename a task list"`
+	Delete TasksListsDeleteCmd `cmd:"" aliases:"rm" help:"Delete a task list"`
+}
+
+// TasksListsListCmd lists task lists.
+type TasksListsListCmd struct{}
 
 // Run executes tasks lists.
-func (c *TasksListsCmd) Run(root *Root) error {
+func (c *TasksListsListCmd) Run(root *Root) error {
 	client, err := root.GetClient()
 	if err != nil {
 		return err
@@ -54,7 +69,7 @@ func (c *TasksListsCmd) Run(root *Root) error {
 		if list.IsOwner {
 			marker = "*"
 		}
-		fmt.Printf("%s %-30s %s\n", marker, list.DisplayName, graph.FormatID(list.ID))
+		fmt.Printf("%s %-30s %s\n", marker, list.DisplayName, graph.FormatTypedID("list", list.ID))
 	}
 	return nil
 }
@@ -97,6 +112,7 @@ func (c *TasksListCmd) Run(root *Root) error {
 	}
 
 	query := url.Values{}
+	query.Set("$expand", "checklistItems")
 	if !c.All {
 		query.Set("$filter", "status ne 'completed'")
 	}
@@ -136,18 +152,25 @@ func (c *TasksListCmd) Run(root *Root) error {
 		if task.DueDateTime != nil {
 			due = task.DueDateTime.DateTime[:10]
 		}
-		fmt.Printf("%s%s %-10s %s  %s\n", status, importance, due, task.Title, graph.FormatID(task.ID))
+		fmt.Printf("%s%s %-10s %s  %s\n", status, importance, due, task.Title, graph.FormatTypedID("task", task.ID))
+		for _, item := range task.ChecklistItems {
+			itemStatus := "○"
+			if item.IsChecked {
+				itemStatus = "✓"
+			}
+			fmt.Printf("     %s %s  %s\n", itemStatus, item.DisplayName, graph.FormatID(item.ID))
+		}
 	}
 	return nil
 }
 
-// TasksAddCmd adds a task.
+// TasksAddCmd adds one or more tasks.
 type TasksAddCmd struct {
-	Title     string `arg:"" help:"Task title"`
-	ListID    string `help:"Task list ID" name:"list"`
-	Due       string `help:"Due date (YYYY-MM-DD or 'tomorrow')"`
-	Notes     string `help:"Task notes"`
-	Important bool   `help:"Mark as important"`
+	Titles    []string `arg:"" help:"Task titles (one task per title)"`
+	ListID    string   `help:"Task list ID" name:"list"`
+	Due       string   `help:"Due date (YYYY-MM-DD or 'tomorrow')"`
+	Notes     string   `help:"Task notes"`
+	Important bool     `help:"Mark as important"`
 }
 
 // Run executes tasks add.
@@ -180,46 +203,82 @@ func (c *TasksAddCmd) Run(root *Root) error {
 		listID = graph.ResolveID(listID)
 	}
 
-	task := map[string]interface{}{
-		"title": c.Title,
-	}
+	newTask := func(title string) map[string]interface{} {
+		task := map[string]interface{}{
+			"title": title,
+		}
 
-	if c.Due != "" {
-		dueDate := c.Due
-		if c.Due == "tomorrow" {
-			dueDate = time.Now().AddDate(0, 0, 1).Format("2006-01-02")
-		} else if c.Due == "today" {
-			dueDate = time.Now().Format("2006-01-02")
+		if c.Due != "" {
+			dueDate := c.Due
+			if c.Due == "tomorrow" {
+				dueDate = time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+			} else if c.Due == "today" {
+				dueDate = time.Now().Format("2006-01-02")
+			}
+			task["dueDateTime"] = map[string]string{
+				"dateTime": dueDate + "T00:00:00",
+				"timeZone": "UTC",
+			}
 		}
-		task["dueDateTime"] = map[string]string{
-			"dateTime": dueDate + "T00:00:00",
-			"timeZone": "UTC",
+
+		if c.Notes != "" {
+			task["body"] = map[string]string{
+				"content":     c.Notes,
+				"contentType": "text",
+			}
+		}
+
+		if c.Important {
+			task["importance"] = "high"
 		}
+		return task
 	}
 
-	if c.Notes != "" {
-		task["body"] = map[string]string{
-			"content":     c.Notes,
-			"contentType": "text",
+	path := fmt.Sprintf("/me/todo/lists/%s/tasks", listID)
+
+	// Single task goes directly; multiple tasks are created in one
+	// $batch round trip.
+	if len(c.Titles) == 1 {
+		data, err := client.Post(ctx, path, newTask(c.Titles[0]))
+		if err != nil {
+			return err
+		}
+
+		var created Task
+		if err := json.Unmarshal(data, &created); err != nil {
+			return err
 		}
+
+		fmt.Printf("✓ Task created: %s (%s)\n", created.Title, graph.FormatTypedID("task", created.ID))
+		return nil
 	}
 
-	if c.Important {
-		task["importance"] = "high"
+	reqs := make([]graph.BatchRequest, len(c.Titles))
+	for i, title := range c.Titles {
+		reqs[i] = graph.BatchRequest{Method: "POST", URL: path, Body: newTask(title)}
 	}
 
-	path := fmt.Sprintf("/me/todo/lists/%s/tasks", listID)
-	data, err := client.Post(ctx, path, task)
+	resps, err := graph.Batch(ctx, client, reqs)
 	if err != nil {
 		return err
 	}
 
-	var created Task
-	if err := json.Unmarshal(data, &created); err != nil {
-		return err
+	var failed []string
+	for i, resp := range resps {
+		if err := resp.Err(); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", c.Titles[i], err))
+			continue
+		}
+		var created Task
+		if err := json.Unmarshal(resp.Body, &created); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Task created: %s (%s)\n", created.Title, graph.FormatTypedID("task", created.ID))
 	}
 
-	fmt.Printf("✓ Task created: %s (%s)\n", created.Title, graph.FormatID(created.ID))
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to create tasks:\n%s", strings.Join(failed, "\n"))
+	}
 	return nil
 }
 
@@ -461,6 +520,8 @@ type Task struct {
 	Importance  string    `json:"importance"`
 	DueDateTime *DateTime `json:"dueDateTime"`
 	Body        *TaskBody `json:"body"`
+
+	ChecklistItems []ChecklistItem `json:"checklistItems,omitempty"`
 }
 
 // DateTime represents a datetime.
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// TasksChecklistCmd manages checklist items (subtasks) on a task.
+type TasksChecklistCmd struct {
+	List    TasksChecklistListCmd    `cmd:"" default:"withargs" help:"List checklist items"`
+	Add     TasksChecklistAddCmd     `cmd:"" help:"Add a checklist item"`
+	Check   TasksChecklistCheckCmd   `cmd:"" help:"Mark a checklist item as checked"`
+	Uncheck TasksChecklistUncheckCmd `cmd:"" help:"Mark a checklist item as unchecked"`
+	Delete  TasksChecklistDeleteCmd  `cmd:"" aliases:"rm" help:"Delete a checklist item"`
+}
+
+// ChecklistItem represents a checklist item on a task.
+type ChecklistItem struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	IsChecked   bool   `json:"isChecked"`
+}
+
+// checklistItemsPath builds the checklistItems collection path for a task.
+func checklistItemsPath(listID, taskID string) (string, error) {
+	if listID == "" {
+		return "", fmt.Errorf("--list is required")
+	}
+	return fmt.Sprintf("/me/todo/lists/%s/tasks/%s/checklistItems",
+		graph.ResolveID(listID), graph.ResolveID(taskID)), nil
+}
+
+// TasksChecklistListCmd lists checklist items.
+type TasksChecklistListCmd struct {
+	TaskID string `arg:"" help:"Task ID"`
+	ListID string `help:"Task list ID" name:"list"`
+}
+
+// Run executes tasks checklist list.
+func (c *TasksChecklistListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	path, err := checklistItemsPath(c.ListID, c.TaskID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []ChecklistItem `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("No checklist items")
+		return nil
+	}
+
+	for _, item := range resp.Value {
+		status := "○"
+		if item.IsChecked {
+			status = "✓"
+		}
+		fmt.Printf("%s %-40s %s\n", status, item.DisplayName, graph.FormatID(item.ID))
+	}
+	return nil
+}
+
+// TasksChecklistAddCmd adds a checklist item.
+type TasksChecklistAddCmd struct {
+	TaskID string `arg:"" help:"Task ID"`
+	Title  string `arg:"" help:"Checklist item title"`
+	ListID string `help:"Task list ID" name:"list"`
+}
+
+// Run executes tasks checklist add.
+func (c *TasksChecklistAddCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	path, err := checklistItemsPath(c.ListID, c.TaskID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, path, map[string]interface{}{
+		"displayName": c.Title,
+	})
+	if err != nil {
+		return err
+	}
+
+	var item ChecklistItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(item)
+	}
+
+	fmt.Println("✓ Checklist item added")
+	fmt.Printf("  Title: %s\n", item.DisplayName)
+	fmt.Printf("  ID: %s\n", graph.FormatID(item.ID))
+	return nil
+}
+
+// setChecklistItemChecked updates the isChecked state of a checklist item.
+func setChecklistItemChecked(root *Root, listID, taskID, itemID string, checked bool) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	base, err := checklistItemsPath(listID, taskID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("%s/%s", base, graph.ResolveID(itemID))
+	_, err = client.Patch(ctx, path, map[string]interface{}{
+		"isChecked": checked,
+	})
+	return err
+}
+
+// TasksChecklistCheckCmd marks a checklist item as checked.
+type TasksChecklistCheckCmd struct {
+	TaskID string `arg:"" help:"Task ID"`
+	ItemID string `arg:"" help:"Checklist item ID"`
+	ListID string `help:"Task list ID" name:"list"`
+}
+
+// Run executes tasks checklist check.
+func (c *TasksChecklistCheckCmd) Run(root *Root) error {
+	if err := setChecklistItemChecked(root, c.ListID, c.TaskID, c.ItemID, true); err != nil {
+		return err
+	}
+	fmt.Println("✓ Checklist item checked")
+	return nil
+}
+
+// TasksChecklistUncheckCmd marks a checklist item as unchecked.
+type TasksChecklistUncheckCmd struct {
+	TaskID string `arg:"" help:"Task ID"`
+	ItemID string `arg:"" help:"Checklist item ID"`
+	ListID string `help:"Task list ID" name:"list"`
+}
+
+// Run executes tasks checklist uncheck.
+func (c *TasksChecklistUncheckCmd) Run(root *Root) error {
+	if err := setChecklistItemChecked(root, c.ListID, c.TaskID, c.ItemID, false); err != nil {
+		return err
+	}
+	fmt.Println("✓ Checklist item unchecked")
+	return nil
+}
+
+// TasksChecklistDeleteCmd deletes a checklist item.
+type TasksChecklistDeleteCmd struct {
+	TaskID string `arg:"" help:"Task ID"`
+	ItemID string `arg:"" help:"Checklist item ID"`
+	ListID string `help:"Task list ID" name:"list"`
+}
+
+// Run executes tasks checklist delete.
+func (c *TasksChecklistDeleteCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	base, err := checklistItemsPath(c.ListID, c.TaskID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.Delete(ctx, fmt.Sprintf("%s/%s", base, graph.ResolveID(c.ItemID))); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ Checklist item deleted")
+	return nil
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestTasksChecklistListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/todo/lists/list-1/tasks/task-1/checklistItems", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "item-1", "displayName": "Buy milk", "isChecked": true},
+					{"id": "item-2", "displayName": "Buy eggs", "isChecked": false},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TasksChecklistListCmd{TaskID: "task-1", ListID: "list-1"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "✓ Buy milk")
+	assert.Contains(t, output, "○ Buy eggs")
+}
+
+func TestTasksChecklistListCmd_RequiresList(t *testing.T) {
+	root := &Root{ClientFactory: mockClientFactory(&testutil.MockClient{})}
+	err := (&TasksChecklistListCmd{TaskID: "task-1"}).Run(root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--list is required")
+}
+
+func TestTasksChecklistAddCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/todo/lists/list-1/tasks/task-1/checklistItems", path)
+			gotBody = body
+			return mustJSON(map[string]interface{}{"id": "item-1", "displayName": "Buy milk"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TasksChecklistAddCmd{TaskID: "task-1", Title: "Buy milk", ListID: "list-1"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"displayName": "Buy milk"}, gotBody)
+	assert.Contains(t, output, "Checklist item added")
+}
+
+func TestTasksChecklistCheckCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TasksChecklistCheckCmd{TaskID: "task-1", ItemID: "item-1", ListID: "list-1"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/todo/lists/list-1/tasks/task-1/checklistItems/item-1", gotPath)
+	assert.Equal(t, map[string]interface{}{"isChecked": true}, gotBody)
+}
+
+func TestTasksChecklistDeleteCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		DeleteFunc: func(ctx context.Context, path string) error {
+			gotPath = path
+			return nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TasksChecklistDeleteCmd{TaskID: "task-1", ItemID: "item-1", ListID: "list-1"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/todo/lists/list-1/tasks/task-1/checklistItems/item-1", gotPath)
+}
+
+func TestTasksListCmd_RendersChecklistItems(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			if path == "/me/todo/lists/list-1/tasks" {
+				assert.Equal(t, "checklistItems", query.Get("$expand"))
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{
+							"id": "task-1", "title": "Groceries", "status": "notStarted",
+							"checklistItems": []map[string]interface{}{
+								{"id": "item-1", "displayName": "Milk", "isChecked": true},
+							},
+						},
+					},
+				}), nil
+			}
+			t.Fatalf("unexpected path: %s", path)
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TasksListCmd{ListID: "list-1"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Groceries")
+	assert.Contains(t, output, "     ✓ Milk")
+}
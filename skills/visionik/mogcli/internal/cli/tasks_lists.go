@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// TasksListsCreateCmd creates a task list.
+type TasksListsCreateCmd struct {
+	Name string `arg:"" help:"Task list name"`
+}
+
+// Run executes tasks lists create.
+func (c *TasksListsCreateCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Post(ctx, "/me/todo/lists", map[string]interface{}{
+		"displayName": c.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	var list TaskList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(list)
+	}
+
+	fmt.Println("✓ Task list created")
+	fmt.Printf("  Name: %s\n", list.DisplayName)
+	fmt.Printf("  ID: %s\n", graph.FormatID(list.ID))
+	return nil
+}
+
+// TasksListsRenameCmd renames a task list.
+type TasksListsRenameCmd struct {
+	ListID string `arg:"" help:"Task list ID"`
+	Name   string `arg:"" help:"New name"`
+}
+
+// Run executes tasks lists rename.
+func (c *TasksListsRenameCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/todo/lists/%s", graph.ResolveID(c.ListID))
+	if _, err := client.Patch(ctx, path, map[string]interface{}{
+		"displayName": c.Name,
+	}); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ Task list renamed")
+	return nil
+}
+
+// TasksListsDeleteCmd deletes a task list.
+type TasksListsDeleteCmd struct {
+	ListID string `arg:"" help:"Task list ID"`
+}
+
+// Run executes tasks lists delete.
+func (c *TasksListsDeleteCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if err := client.Delete(ctx, fmt.Sprintf("/me/todo/lists/%s", graph.ResolveID(c.ListID))); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ Task list deleted")
+	return nil
+}
+
+// taskWithList is a task annotated with its containing list.
+type taskWithList struct {
+	Task
+	ListName string `json:"listName"`
+	ListID   string `json:"listId"`
+}
+
+// collectTasksAcrossLists fetches incomplete tasks from every task list
+// concurrently and merges the results.
+func collectTasksAcrossLists(ctx context.Context, client graph.Client) ([]taskWithList, error) {
+	data, err := client.Get(ctx, "/me/todo/lists", nil)
+	if err != nil {
+		return nil, err
+	}
+	var listsResp struct {
+		Value []TaskList `json:"value"`
+	}
+	if err := json.Unmarshal(data, &listsResp); err != nil {
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		merged   []taskWithList
+		firstErr error
+	)
+	for _, list := range listsResp.Value {
+		wg.Add(1)
+		go func(list TaskList) {
+			defer wg.Done()
+
+			query := url.Values{}
+			query.Set("$filter", "status ne 'completed'")
+			data, err := client.Get(ctx, fmt.Sprintf("/me/todo/lists/%s/tasks", list.ID), query)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("list %s: %w", list.DisplayName, err)
+				}
+				return
+			}
+			var resp struct {
+				Value []Task `json:"value"`
+			}
+			if err := json.Unmarshal(data, &resp); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			for _, task := range resp.Value {
+				merged = append(merged, taskWithList{Task: task, ListName: list.DisplayName, ListID: list.ID})
+			}
+		}(list)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		di, dj := taskDueDate(merged[i].Task), taskDueDate(merged[j].Task)
+		if di != dj {
+			return di < dj
+		}
+		return merged[i].Title < merged[j].Title
+	})
+	return merged, nil
+}
+
+// taskDueDate returns the task due date as YYYY-MM-DD, or empty if none.
+func taskDueDate(t Task) string {
+	if t.DueDateTime == nil || len(t.DueDateTime.DateTime) < 10 {
+		return ""
+	}
+	return t.DueDateTime.DateTime[:10]
+}
+
+// printTasksWithLists renders merged cross-list tasks.
+func printTasksWithLists(root *Root, tasks []taskWithList) {
+	for _, task := range tasks {
+		importance := " "
+		if task.Importance == "high" {
+			importance = "!"
+		}
+		fmt.Printf("○%s %-10s %-30s [%s]  %s\n", importance, taskDueDate(task.Task), task.Title, task.ListName, graph.FormatID(task.ID))
+	}
+	fmt.Printf("\n%d tasks\n", len(tasks))
+}
+
+// TasksTodayCmd lists tasks due today or earlier across all lists.
+type TasksTodayCmd struct{}
+
+// Run executes tasks today.
+func (c *TasksTodayCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	all, err := collectTasksAcrossLists(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	today := time.Now().Format("2006-01-02")
+	var tasks []taskWithList
+	for _, task := range all {
+		if due := taskDueDate(task.Task); due != "" && due <= today {
+			tasks = append(tasks, task)
+		}
+	}
+
+	if root.JSON {
+		return outputJSON(tasks)
+	}
+
+	if len(tasks) == 0 {
+		fmt.Println("No tasks due today")
+		return nil
+	}
+	printTasksWithLists(root, tasks)
+	return nil
+}
+
+// TasksOverdueCmd lists overdue tasks across all lists.
+type TasksOverdueCmd struct{}
+
+// Run executes tasks overdue.
+func (c *TasksOverdueCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	all, err := collectTasksAcrossLists(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	today := time.Now().Format("2006-01-02")
+	var tasks []taskWithList
+	for _, task := range all {
+		if due := taskDueDate(task.Task); due != "" && due < today {
+			tasks = append(tasks, task)
+		}
+	}
+
+	if root.JSON {
+		return outputJSON(tasks)
+	}
+
+	if len(tasks) == 0 {
+		fmt.Println("No overdue tasks")
+		return nil
+	}
+	printTasksWithLists(root, tasks)
+	return nil
+}
@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestTasksListsCreateCmd_Run(t *testing.T) {
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/me/todo/lists", path)
+			gotBody = body
+			return mustJSON(map[string]string{"id": "list-1", "displayName": "Errands"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&TasksListsCreateCmd{Name: "Errands"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"displayName": "Errands"}, gotBody)
+	assert.Contains(t, output, "Task list created")
+}
+
+func TestTasksListsRenameCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&TasksListsRenameCmd{ListID: "list-1", Name: "Chores"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/todo/lists/list-1", gotPath)
+	assert.Equal(t, map[string]interface{}{"displayName": "Chores"}, gotBody)
+}
+
+func TestTasksListsDeleteCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		DeleteFunc: func(ctx context.Context, path string) error {
+			gotPath = path
+			return nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&TasksListsDeleteCmd{ListID: "list-1"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/todo/lists/list-1", gotPath)
+}
+
+func crossListMock(t *testing.T, dueByList map[string]string) *testutil.MockClient {
+	t.Helper()
+	return &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			if path == "/me/todo/lists" {
+				return mustJSON(map[string]interface{}{
+					"value": []map[string]interface{}{
+						{"id": "list-1", "displayName": "Work"},
+						{"id": "list-2", "displayName": "Home"},
+					},
+				}), nil
+			}
+			var listID, title string
+			switch path {
+			case "/me/todo/lists/list-1/tasks":
+				listID, title = "list-1", "Work task"
+			case "/me/todo/lists/list-2/tasks":
+				listID, title = "list-2", "Home task"
+			default:
+				t.Errorf("unexpected path: %s", path)
+				return nil, nil
+			}
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id": listID + "-task", "title": title, "status": "notStarted",
+						"dueDateTime": map[string]string{"dateTime": dueByList[listID] + "T00:00:00", "timeZone": "UTC"},
+					},
+				},
+			}), nil
+		},
+	}
+}
+
+func TestTasksOverdueCmd_Run(t *testing.T) {
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	mock := crossListMock(t, map[string]string{"list-1": yesterday, "list-2": tomorrow})
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&TasksOverdueCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Work task")
+	assert.Contains(t, output, "[Work]")
+	assert.NotContains(t, output, "Home task")
+}
+
+func TestTasksTodayCmd_Run(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	mock := crossListMock(t, map[string]string{"list-1": today, "list-2": yesterday})
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&TasksTodayCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Work task")
+	assert.Contains(t, output, "Home task")
+	assert.Contains(t, output, "2 tasks")
+	// Earliest due date sorts first
+	assert.Less(t, indexOfLine(output, "Home task"), indexOfLine(output, "Work task"))
+}
+
+func indexOfLine(output, substr string) int {
+	for i, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, substr) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// TasksSyncMdCmd reconciles a Markdown checklist with a To-Do task list.
+// Checkbox items map to tasks; each synced line carries a stable task ID
+// in an HTML comment so repeated syncs match items reliably:
+//
+//   - [ ] Review the design doc <!-- mog:AAMkADc... -->
+//
+// Creation and completion flow in both directions; non-checklist lines in
+// the file are preserved untouched.
+type TasksSyncMdCmd struct {
+	File   string `arg:"" help:"Path to the Markdown file"`
+	ListID string `help:"Task list ID" name:"list" required:""`
+}
+
+// mdChecklistRe matches a Markdown checkbox line: indent, marker, state, text.
+var mdChecklistRe = regexp.MustCompile(`^(\s*[-*]\s+)\[([ xX])\]\s+(.*)$`)
+
+// mdTaskIDRe matches the trailing task ID comment on a synced line.
+var mdTaskIDRe = regexp.MustCompile(`\s*<!--\s*mog:(\S+)\s*-->\s*$`)
+
+// mdItem is a parsed checklist line.
+type mdItem struct {
+	lineNo  int
+	prefix  string
+	checked bool
+	title   string
+	taskID  string
+}
+
+// Run executes tasks sync-md.
+func (c *TasksSyncMdCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(c.File)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", c.File, err)
+	}
+	lines := strings.Split(string(data), "\n")
+
+	items := parseMdChecklist(lines)
+
+	ctx := context.Background()
+	listID := graph.ResolveID(c.ListID)
+
+	// Fetch all tasks including completed ones
+	path := fmt.Sprintf("/me/todo/lists/%s/tasks", listID)
+	taskData, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		Value []Task `json:"value"`
+	}
+	if err := json.Unmarshal(taskData, &resp); err != nil {
+		return err
+	}
+
+	byID := make(map[string]*Task, len(resp.Value))
+	for i := range resp.Value {
+		byID[resp.Value[i].ID] = &resp.Value[i]
+	}
+
+	var created, completed, checked, appended int
+	inFile := make(map[string]bool, len(items))
+
+	for i := range items {
+		item := &items[i]
+
+		// New items are created on the server and tagged with their ID
+		if item.taskID == "" {
+			body := map[string]interface{}{"title": item.title}
+			if item.checked {
+				body["status"] = "completed"
+			}
+			data, err := client.Post(ctx, path, body)
+			if err != nil {
+				return fmt.Errorf("failed to create task %q: %w", item.title, err)
+			}
+			var task Task
+			if err := json.Unmarshal(data, &task); err != nil {
+				return err
+			}
+			item.taskID = task.ID
+			lines[item.lineNo] = formatMdChecklistLine(*item)
+			inFile[task.ID] = true
+			created++
+			continue
+		}
+
+		inFile[item.taskID] = true
+		task, ok := byID[item.taskID]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case item.checked && task.Status != "completed":
+			// Completion flows file -> server
+			taskPath := fmt.Sprintf("%s/%s", path, task.ID)
+			if _, err := client.Patch(ctx, taskPath, map[string]interface{}{"status": "completed"}); err != nil {
+				return fmt.Errorf("failed to complete task %q: %w", task.Title, err)
+			}
+			completed++
+		case !item.checked && task.Status == "completed":
+			// Completion flows server -> file
+			item.checked = true
+			lines[item.lineNo] = formatMdChecklistLine(*item)
+			checked++
+		}
+	}
+
+	// Server tasks not present in the file are appended as new checklist items
+	var extra []string
+	for _, task := range resp.Value {
+		if inFile[task.ID] {
+			continue
+		}
+		extra = append(extra, formatMdChecklistLine(mdItem{
+			prefix:  "- ",
+			checked: task.Status == "completed",
+			title:   task.Title,
+			taskID:  task.ID,
+		}))
+		appended++
+	}
+	if len(extra) > 0 {
+		// Drop trailing empty lines before appending so items join the list
+		for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+			lines = lines[:len(lines)-1]
+		}
+		lines = append(lines, extra...)
+		lines = append(lines, "")
+	}
+
+	if err := os.WriteFile(c.File, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", c.File, err)
+	}
+
+	if root.JSON {
+		return outputJSON(map[string]int{
+			"created":   created,
+			"completed": completed,
+			"checked":   checked,
+			"appended":  appended,
+		})
+	}
+
+	fmt.Printf("✓ Synced %s: %d created, %d completed on server, %d checked in file, %d appended\n",
+		c.File, created, completed, checked, appended)
+	return nil
+}
+
+// parseMdChecklist extracts checkbox items from Markdown lines.
+func parseMdChecklist(lines []string) []mdItem {
+	var items []mdItem
+	for i, line := range lines {
+		m := mdChecklistRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		item := mdItem{
+			lineNo:  i,
+			prefix:  m[1],
+			checked: m[2] != " ",
+			title:   strings.TrimSpace(m[3]),
+		}
+		if idm := mdTaskIDRe.FindStringSubmatch(item.title); idm != nil {
+			item.taskID = idm[1]
+			item.title = strings.TrimSpace(mdTaskIDRe.ReplaceAllString(item.title, ""))
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// formatMdChecklistLine renders a checklist line with its ID comment.
+func formatMdChecklistLine(item mdItem) string {
+	box := " "
+	if item.checked {
+		box = "x"
+	}
+	line := fmt.Sprintf("%s[%s] %s", item.prefix, box, item.title)
+	if item.taskID != "" {
+		line += fmt.Sprintf(" <!-- mog:%s -->", item.taskID)
+	}
+	return line
+}
@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestParseMdChecklist(t *testing.T) {
+	lines := []string{
+		"# TODO",
+		"",
+		"- [ ] New task",
+		"- [x] Done task <!-- mog:task-1 -->",
+		"* [X] Star item <!-- mog:task-2 -->",
+		"not a checkbox",
+	}
+
+	items := parseMdChecklist(lines)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, 2, items[0].lineNo)
+	assert.False(t, items[0].checked)
+	assert.Equal(t, "New task", items[0].title)
+	assert.Empty(t, items[0].taskID)
+
+	assert.True(t, items[1].checked)
+	assert.Equal(t, "Done task", items[1].title)
+	assert.Equal(t, "task-1", items[1].taskID)
+
+	assert.True(t, items[2].checked)
+	assert.Equal(t, "task-2", items[2].taskID)
+}
+
+func TestFormatMdChecklistLine(t *testing.T) {
+	line := formatMdChecklistLine(mdItem{prefix: "- ", checked: true, title: "Ship it", taskID: "abc"})
+	assert.Equal(t, "- [x] Ship it <!-- mog:abc -->", line)
+
+	// Round trip
+	items := parseMdChecklist([]string{line})
+	require.Len(t, items, 1)
+	assert.Equal(t, "Ship it", items[0].title)
+	assert.Equal(t, "abc", items[0].taskID)
+}
+
+func TestTasksSyncMdCmd_Run(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "TODO.md")
+	content := "# TODO\n\n- [ ] Brand new\n- [x] Finish locally <!-- mog:task-1 -->\n- [ ] Done remotely <!-- mog:task-2 -->\n"
+	require.NoError(t, os.WriteFile(file, []byte(content), 0o644))
+
+	var patched []string
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "task-1", "title": "Finish locally", "status": "notStarted"},
+					{"id": "task-2", "title": "Done remotely", "status": "completed"},
+					{"id": "task-3", "title": "Server only", "status": "notStarted"},
+				},
+			}), nil
+		},
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			return mustJSON(map[string]interface{}{"id": "task-new", "title": "Brand new"}), nil
+		},
+		PatchFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			patched = append(patched, path)
+			return []byte(`{}`), nil
+		},
+	}
+
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+	cmd := &TasksSyncMdCmd{File: file, ListID: "list-1"}
+	require.NoError(t, cmd.Run(root))
+
+	// Local completion pushed to the server
+	require.Len(t, patched, 1)
+	assert.Contains(t, patched[0], "task-1")
+
+	out, err := os.ReadFile(file)
+	require.NoError(t, err)
+	text := string(out)
+
+	// New item tagged with its server ID
+	assert.Contains(t, text, "- [ ] Brand new <!-- mog:task-new -->")
+	// Remote completion reflected in the file
+	assert.Contains(t, text, "- [x] Done remotely <!-- mog:task-2 -->")
+	// Server-only task appended
+	assert.Contains(t, text, "- [ ] Server only <!-- mog:task-3 -->")
+	// Non-checklist lines preserved
+	assert.Contains(t, text, "# TODO")
+}
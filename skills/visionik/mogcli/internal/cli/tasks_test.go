@@ -9,10 +9,11 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/graph"
 	"github.com/visionik/mogcli/internal/testutil"
 )
 
-func TestTasksListsCmd_Run(t *testing.T) {
+func TestTasksListsListCmd_Run(t *testing.T) {
 	tests := []struct {
 		name      string
 		root      *Root
@@ -59,7 +60,7 @@ func TestTasksListsCmd_Run(t *testing.T) {
 			}
 			tt.root.ClientFactory = mockClientFactory(mock)
 
-			cmd := &TasksListsCmd{}
+			cmd := &TasksListsListCmd{}
 			var output string
 			err := error(nil)
 			output = captureOutput(func() {
@@ -203,7 +204,7 @@ func TestTasksAddCmd_Run(t *testing.T) {
 	}{
 		{
 			name: "successful add task",
-			cmd:  &TasksAddCmd{Title: "New Task"},
+			cmd:  &TasksAddCmd{Titles: []string{"New Task"}},
 			mockResp: mustJSON(map[string]interface{}{
 				"id":    "task-new-123",
 				"title": "New Task",
@@ -213,7 +214,7 @@ func TestTasksAddCmd_Run(t *testing.T) {
 		{
 			name: "add task with all options",
 			cmd: &TasksAddCmd{
-				Title:     "Full Task",
+				Titles:    []string{"Full Task"},
 				ListID:    "list-123",
 				Due:       "2024-01-20",
 				Notes:     "Task notes",
@@ -227,7 +228,7 @@ func TestTasksAddCmd_Run(t *testing.T) {
 		},
 		{
 			name: "add task with tomorrow due",
-			cmd:  &TasksAddCmd{Title: "Tomorrow Task", Due: "tomorrow"},
+			cmd:  &TasksAddCmd{Titles: []string{"Tomorrow Task"}, Due: "tomorrow"},
 			mockResp: mustJSON(map[string]interface{}{
 				"id":    "task-new-789",
 				"title": "Tomorrow Task",
@@ -236,7 +237,7 @@ func TestTasksAddCmd_Run(t *testing.T) {
 		},
 		{
 			name: "add task with today due",
-			cmd:  &TasksAddCmd{Title: "Today Task", Due: "today"},
+			cmd:  &TasksAddCmd{Titles: []string{"Today Task"}, Due: "today"},
 			mockResp: mustJSON(map[string]interface{}{
 				"id":    "task-new-789",
 				"title": "Today Task",
@@ -245,7 +246,7 @@ func TestTasksAddCmd_Run(t *testing.T) {
 		},
 		{
 			name:    "API error",
-			cmd:     &TasksAddCmd{Title: "Task"},
+			cmd:     &TasksAddCmd{Titles: []string{"Task"}},
 			mockErr: errors.New("API error"),
 			wantErr: true,
 		},
@@ -655,3 +656,31 @@ func TestTask_Unmarshal(t *testing.T) {
 	assert.NotNil(t, task.Body)
 	assert.Equal(t, "Task notes", task.Body.Content)
 }
+
+func TestTasksAddCmd_BulkUsesBatch(t *testing.T) {
+	var gotReqs []graph.BatchRequest
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			assert.Equal(t, "/$batch", path)
+			gotReqs = batchRequestsOf(t, body)
+			return mustJSON(map[string]interface{}{
+				"responses": []map[string]interface{}{
+					{"id": "1", "status": 201, "body": map[string]string{"id": "task-1", "title": "Write docs"}},
+					{"id": "2", "status": 201, "body": map[string]string{"id": "task-2", "title": "Review PR"}},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TasksAddCmd{Titles: []string{"Write docs", "Review PR"}, ListID: "list-1"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	require.Len(t, gotReqs, 2)
+	assert.Equal(t, "POST", gotReqs[0].Method)
+	assert.Equal(t, "/me/todo/lists/list-1/tasks", gotReqs[0].URL)
+	assert.Contains(t, output, "Write docs")
+	assert.Contains(t, output, "Review PR")
+}
@@ -0,0 +1,407 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// TeamsCmd 处理Teams聊天和频道消息操作。
+type TeamsCmd struct {
+	List     TeamsListCmd     `cmd:"" default:"1" help:"列出已加入的团队"`
+	Channels TeamsChannelsCmd `cmd:"" help:"列出团队中的频道"`
+	Messages TeamsMessagesCmd `cmd:"" help:"列出频道消息"`
+	Post     TeamsPostCmd     `cmd:"" help:"向频道发送消息"`
+	Reply    TeamsReplyCmd    `cmd:"" help:"回复频道消息线程"`
+	Chats    TeamsChatsCmd    `cmd:"" help:"一对一/群组聊天"`
+}
+
+// Team 表示已加入的团队。
+type Team struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// Channel 表示团队频道。
+type Channel struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+}
+
+// ChatMessage 表示频道或聊天中的消息。
+type ChatMessage struct {
+	ID              string `json:"id"`
+	CreatedDateTime string `json:"createdDateTime"`
+	Body            struct {
+		Content     string `json:"content"`
+		ContentType string `json:"contentType"`
+	} `json:"body"`
+	From *struct {
+		User *struct {
+			DisplayName string `json:"displayName"`
+		} `json:"user"`
+	} `json:"from"`
+}
+
+// messageSender 返回消息发送者的显示名称。
+func messageSender(m ChatMessage) string {
+	if m.From != nil && m.From.User != nil {
+		return m.From.User.DisplayName
+	}
+	return "(未知)"
+}
+
+// chatMessageBody 构造消息请求体, HTML开关控制contentType。
+func chatMessageBody(text string, html bool) map[string]interface{} {
+	contentType := "text"
+	if html {
+		contentType = "html"
+	}
+	return map[string]interface{}{
+		"body": map[string]string{
+			"content":     text,
+			"contentType": contentType,
+		},
+	}
+}
+
+// TeamsListCmd 列出已加入的团队。
+type TeamsListCmd struct{}
+
+// Run 执行teams list命令。
+func (c *TeamsListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	data, err := client.Get(ctx, "/me/joinedTeams", nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []Team `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	if len(resp.Value) == 0 {
+		fmt.Println("未加入任何团队")
+		return nil
+	}
+
+	for _, t := range resp.Value {
+		fmt.Printf("%-40s %s\n", t.DisplayName, graph.FormatID(t.ID))
+	}
+	return nil
+}
+
+// TeamsChannelsCmd 列出团队中的频道。
+type TeamsChannelsCmd struct {
+	TeamID string `arg:"" help:"团队ID"`
+}
+
+// Run 执行teams channels命令。
+func (c *TeamsChannelsCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/teams/%s/channels", graph.ResolveID(c.TeamID))
+
+	data, err := client.Get(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []Channel `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, ch := range resp.Value {
+		fmt.Printf("%-40s %s\n", ch.DisplayName, graph.FormatID(ch.ID))
+	}
+	return nil
+}
+
+// TeamsMessagesCmd 列出频道消息。
+type TeamsMessagesCmd struct {
+	TeamID    string `arg:"" help:"团队ID"`
+	ChannelID string `arg:"" help:"频道ID"`
+	Max       int    `help:"最大结果数" default:"20"`
+}
+
+// Run 执行teams messages命令。
+func (c *TeamsMessagesCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/teams/%s/channels/%s/messages", graph.ResolveID(c.TeamID), graph.ResolveID(c.ChannelID))
+	query := url.Values{}
+	query.Set("$top", fmt.Sprintf("%d", c.Max))
+
+	data, err := client.Get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []ChatMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, m := range resp.Value {
+		content := m.Body.Content
+		if m.Body.ContentType == "html" {
+			content = stripHTML(content)
+		}
+		content = strings.TrimSpace(content)
+		when := m.CreatedDateTime
+		if len(when) >= 16 {
+			when = strings.Replace(when[:16], "T", " ", 1)
+		}
+		fmt.Printf("[%s] %s: %s\n", when, messageSender(m), content)
+		if root.Verbose {
+			fmt.Printf("   ID: %s\n", graph.FormatID(m.ID))
+		}
+	}
+	return nil
+}
+
+// TeamsPostCmd 向频道发送消息。
+type TeamsPostCmd struct {
+	TeamID    string `arg:"" help:"团队ID"`
+	ChannelID string `arg:"" help:"频道ID"`
+	Message   string `arg:"" help:"消息内容"`
+	HTML      bool   `help:"按HTML发送内容"`
+}
+
+// Run 执行teams post命令。
+func (c *TeamsPostCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/teams/%s/channels/%s/messages", graph.ResolveID(c.TeamID), graph.ResolveID(c.ChannelID))
+
+	data, err := client.Post(ctx, path, chatMessageBody(c.Message, c.HTML))
+	if err != nil {
+		return err
+	}
+
+	var msg ChatMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(msg)
+	}
+
+	fmt.Println("✓ 消息已发送")
+	fmt.Printf("  ID: %s\n", graph.FormatID(msg.ID))
+	return nil
+}
+
+// TeamsReplyCmd 回复频道消息线程。
+type TeamsReplyCmd struct {
+	TeamID    string `arg:"" help:"团队ID"`
+	ChannelID string `arg:"" help:"频道ID"`
+	MessageID string `arg:"" help:"要回复的消息ID"`
+	Message   string `arg:"" help:"回复内容"`
+	HTML      bool   `help:"按HTML发送内容"`
+}
+
+// Run 执行teams reply命令。
+func (c *TeamsReplyCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/teams/%s/channels/%s/messages/%s/replies",
+		graph.ResolveID(c.TeamID), graph.ResolveID(c.ChannelID), graph.ResolveID(c.MessageID))
+
+	if _, err := client.Post(ctx, path, chatMessageBody(c.Message, c.HTML)); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 回复已发送")
+	return nil
+}
+
+// TeamsChatsCmd 管理一对一/群组聊天。
+type TeamsChatsCmd struct {
+	List     TeamsChatsListCmd    `cmd:"" default:"1" help:"列出聊天"`
+	Messages TeamsChatMessagesCmd `cmd:"" help:"列出聊天消息"`
+	Send     TeamsChatSendCmd     `cmd:"" help:"发送聊天消息"`
+}
+
+// Chat 表示一对一或群组聊天。
+type Chat struct {
+	ID              string `json:"id"`
+	Topic           string `json:"topic"`
+	ChatType        string `json:"chatType"`
+	LastUpdatedTime string `json:"lastUpdatedDateTime"`
+	Members         []struct {
+		DisplayName string `json:"displayName"`
+	} `json:"members"`
+}
+
+// chatLabel 返回聊天的可读名称: 主题或成员列表。
+func chatLabel(chat Chat) string {
+	if chat.Topic != "" {
+		return chat.Topic
+	}
+	var names []string
+	for _, m := range chat.Members {
+		names = append(names, m.DisplayName)
+	}
+	if len(names) == 0 {
+		return "(无主题)"
+	}
+	return strings.Join(names, ", ")
+}
+
+// TeamsChatsListCmd 列出聊天。
+type TeamsChatsListCmd struct {
+	Max int `help:"最大结果数" default:"25"`
+}
+
+// Run 执行teams chats命令。
+func (c *TeamsChatsListCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	query := url.Values{}
+	query.Set("$top", fmt.Sprintf("%d", c.Max))
+	query.Set("$expand", "members")
+	query.Set("$orderby", "lastMessagePreview/createdDateTime desc")
+
+	data, err := client.Get(ctx, "/me/chats", query)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []Chat `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, chat := range resp.Value {
+		fmt.Printf("%-10s %-40s %s\n", chat.ChatType, chatLabel(chat), graph.FormatID(chat.ID))
+	}
+	return nil
+}
+
+// TeamsChatMessagesCmd 列出聊天消息。
+type TeamsChatMessagesCmd struct {
+	ChatID string `arg:"" help:"聊天ID"`
+	Max    int    `help:"最大结果数" default:"20"`
+}
+
+// Run 执行teams chats messages命令。
+func (c *TeamsChatMessagesCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/chats/%s/messages", graph.ResolveID(c.ChatID))
+	query := url.Values{}
+	query.Set("$top", fmt.Sprintf("%d", c.Max))
+
+	data, err := client.Get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+
+	var resp struct {
+		Value []ChatMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+
+	if root.JSON {
+		return outputJSON(resp.Value)
+	}
+
+	for _, m := range resp.Value {
+		content := m.Body.Content
+		if m.Body.ContentType == "html" {
+			content = stripHTML(content)
+		}
+		fmt.Printf("%s: %s\n", messageSender(m), strings.TrimSpace(content))
+	}
+	return nil
+}
+
+// TeamsChatSendCmd 发送聊天消息。
+type TeamsChatSendCmd struct {
+	ChatID  string `arg:"" help:"聊天ID"`
+	Message string `arg:"" help:"消息内容"`
+	HTML    bool   `help:"按HTML发送内容"`
+}
+
+// Run 执行teams chats send命令。
+func (c *TeamsChatSendCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	path := fmt.Sprintf("/me/chats/%s/messages", graph.ResolveID(c.ChatID))
+
+	if _, err := client.Post(ctx, path, chatMessageBody(c.Message, c.HTML)); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 消息已发送")
+	return nil
+}
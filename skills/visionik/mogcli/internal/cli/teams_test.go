@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func TestTeamsListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/joinedTeams", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "team-1", "displayName": "工程团队"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&TeamsListCmd{}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "工程团队")
+}
+
+func TestTeamsChannelsCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/teams/team-1/channels", path)
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{"id": "channel-1", "displayName": "General"},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&TeamsChannelsCmd{TeamID: "team-1"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "General")
+}
+
+func TestTeamsPostCmd_Run(t *testing.T) {
+	var gotPath string
+	var gotBody interface{}
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			gotBody = body
+			return mustJSON(map[string]string{"id": "msg-1"}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TeamsPostCmd{TeamID: "team-1", ChannelID: "channel-1", Message: "部署完成"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/teams/team-1/channels/channel-1/messages", gotPath)
+	assert.Contains(t, output, "消息已发送")
+
+	body := gotBody.(map[string]interface{})
+	assert.Equal(t, map[string]string{"content": "部署完成", "contentType": "text"}, body["body"])
+}
+
+func TestTeamsReplyCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TeamsReplyCmd{TeamID: "team-1", ChannelID: "channel-1", MessageID: "msg-1", Message: "收到", HTML: true}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/teams/team-1/channels/channel-1/messages/msg-1/replies", gotPath)
+}
+
+func TestTeamsChatsListCmd_Run(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			assert.Equal(t, "/me/chats", path)
+			assert.Equal(t, "members", query.Get("$expand"))
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id": "chat-1", "chatType": "oneOnOne",
+						"members": []map[string]interface{}{
+							{"displayName": "Alice"}, {"displayName": "Bob"},
+						},
+					},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	output := captureOutput(func() { err = (&TeamsChatsListCmd{Max: 25}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Alice, Bob")
+}
+
+func TestTeamsChatSendCmd_Run(t *testing.T) {
+	var gotPath string
+	mock := &testutil.MockClient{
+		PostFunc: func(ctx context.Context, path string, body interface{}) ([]byte, error) {
+			gotPath = path
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	var err error
+	captureOutput(func() { err = (&TeamsChatSendCmd{ChatID: "chat-1", Message: "hi"}).Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "/me/chats/chat-1/messages", gotPath)
+}
+
+func TestTeamsMessagesCmd_StripsHTML(t *testing.T) {
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			return mustJSON(map[string]interface{}{
+				"value": []map[string]interface{}{
+					{
+						"id": "msg-1", "createdDateTime": "2026-08-28T10:00:00Z",
+						"body": map[string]string{"content": "<p>你好</p>", "contentType": "html"},
+						"from": map[string]interface{}{"user": map[string]string{"displayName": "Alice"}},
+					},
+				},
+			}), nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &TeamsMessagesCmd{TeamID: "team-1", ChannelID: "channel-1", Max: 20}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Contains(t, output, "Alice: 你好")
+	assert.NotContains(t, output, "<p>")
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 
 	"github.com/visionik/mogcli/internal/graph"
@@ -13,16 +12,21 @@ import (
 
 // WordCmd 处理Word文档操作。
 type WordCmd struct {
-	List   WordListCmd   `cmd:"" help:"列出Word文档"`
-	Get    WordGetCmd    `cmd:"" help:"获取文档元数据"`
-	Export WordExportCmd `cmd:"" help:"导出文档"`
-	Copy   WordCopyCmd   `cmd:"" help:"复制文档"`
-	Create WordCreateCmd `cmd:"" help:"创建新文档"`
+	List    WordListCmd    `cmd:"" help:"列出Word文档"`
+	Get     WordGetCmd     `cmd:"" help:"获取文档元数据"`
+	Export  WordExportCmd  `cmd:"" help:"导出文档"`
+	Copy    WordCopyCmd    `cmd:"" help:"复制文档"`
+	Create  WordCreateCmd  `cmd:"" help:"创建新文档"`
+	Append  WordAppendCmd  `cmd:"" help:"向文档追加内容"`
+	Replace WordReplaceCmd `cmd:"" help:"替换文档中的文本"`
 }
 
 // WordListCmd 列出文档。
 type WordListCmd struct {
-	Max int `help:"最大结果数" default:"50"`
+	Max    int    `help:"最大结果数" default:"50"`
+	Folder string `help:"限定到文件夹 (ID 或路径)"`
+	Mine   bool   `help:"仅自己的驱动器 (默认)" xor:"scope"`
+	Shared bool   `name:"shared-with-me" help:"仅他人共享给我的文件" xor:"scope"`
 }
 
 // Run 执行word list命令。
@@ -33,25 +37,38 @@ func (c *WordListCmd) Run(root *Root) error {
 	}
 
 	ctx := context.Background()
-	query := url.Values{}
-	query.Set("$top", fmt.Sprintf("%d", c.Max))
-	query.Set("$orderby", "lastModifiedDateTime desc")
 
-	data, err := client.Get(ctx, "/me/drive/root/search(q='.docx')", query)
-	if err != nil {
-		return err
-	}
+	var items []DriveItem
+	if c.Shared {
+		if c.Folder != "" {
+			return fmt.Errorf("--folder 不能与 --shared-with-me 同时使用")
+		}
+		items, err = sharedWithMeItems(ctx, client, c.Max)
+		if err != nil {
+			return err
+		}
+	} else {
+		query := url.Values{}
+		query.Set("$top", fmt.Sprintf("%d", c.Max))
+		query.Set("$orderby", "lastModifiedDateTime desc")
 
-	var resp struct {
-		Value []DriveItem `json:"value"`
-	}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return err
+		data, err := client.Get(ctx, driveSearchPath(c.Folder, ".docx"), query)
+		if err != nil {
+			return err
+		}
+
+		var resp struct {
+			Value []DriveItem `json:"value"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+		items = resp.Value
 	}
 
 	// 过滤出仅.docx文件
 	var docs []DriveItem
-	for _, item := range resp.Value {
+	for _, item := range items {
 		if strings.HasSuffix(strings.ToLower(item.Name), ".docx") {
 			docs = append(docs, item)
 		}
@@ -150,7 +167,7 @@ func (c *WordExportCmd) Run(root *Root) error {
 		return err
 	}
 
-	if err := os.WriteFile(c.Out, data, 0644); err != nil {
+	if err := writeFileAtomic(c.Out, data, 0644); err != nil {
 		return err
 	}
 
@@ -169,6 +186,7 @@ type WordCopyCmd struct {
 	ID     string `arg:"" help:"文档ID"`
 	Name   string `arg:"" help:"新名称"`
 	Folder string `help:"目标文件夹ID"`
+	Wait   bool   `help:"等待复制完成并输出新文件 ID"`
 }
 
 // Run 执行word copy命令。
@@ -187,16 +205,25 @@ func (c *WordCopyCmd) Run(root *Root) error {
 		}
 	}
 
-	ctx := context.Background()
 	path := fmt.Sprintf("/me/drive/items/%s/copy", graph.ResolveID(c.ID))
-
-	_, err = client.Post(ctx, path, body)
+	newID, err := runCopy(client, path, body, c.Wait)
 	if err != nil {
 		return err
 	}
 
 	if root.JSON {
-		return outputJSON(map[string]interface{}{"success": true, "name": c.Name})
+		out := map[string]interface{}{"success": true, "name": c.Name}
+		if c.Wait {
+			out["id"] = newID
+		}
+		return outputJSON(out)
+	}
+
+	if c.Wait {
+		fmt.Println("✓ 复制完成")
+		fmt.Printf("  名称: %s\n", c.Name)
+		fmt.Printf("  ID: %s\n", graph.FormatID(newID))
+		return nil
 	}
 
 	fmt.Println("✓ 复制已启动")
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/visionik/mogcli/internal/graph"
+)
+
+// WordAppendCmd 向现有文档末尾追加内容。下载文档、修改OOXML后
+// 带 If-Match ETag 重新上传, 避免覆盖并发编辑。
+type WordAppendCmd struct {
+	ID           string `arg:"" help:"文档ID"`
+	Text         string `help:"要追加的纯文本 (按行分段)"`
+	MarkdownFile string `help:"要追加的Markdown文件 (支持 # 标题)" name:"markdown-file" type:"existingfile"`
+}
+
+// Run 执行word append命令。
+func (c *WordAppendCmd) Run(root *Root) error {
+	if (c.Text == "") == (c.MarkdownFile == "") {
+		return fmt.Errorf("需要 --text 或 --markdown-file 其中之一")
+	}
+
+	var paras []docxParagraph
+	if c.Text != "" {
+		for _, line := range strings.Split(c.Text, "\n") {
+			paras = append(paras, docxParagraph{Text: line})
+		}
+	} else {
+		content, err := os.ReadFile(c.MarkdownFile)
+		if err != nil {
+			return err
+		}
+		paras = markdownParagraphs(string(content))
+	}
+
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	return updateDocx(client, c.ID, func(document string) (string, error) {
+		return docxAppendParagraphs(document, paras), nil
+	})
+}
+
+// WordReplaceCmd 替换文档中的文本。
+type WordReplaceCmd struct {
+	ID      string `arg:"" help:"文档ID"`
+	Find    string `help:"要查找的文本" required:""`
+	Replace string `help:"替换为的文本" required:""`
+}
+
+// Run 执行word replace命令。
+func (c *WordReplaceCmd) Run(root *Root) error {
+	client, err := root.GetClient()
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	err = updateDocx(client, c.ID, func(document string) (string, error) {
+		var updated string
+		updated, count = docxReplaceText(document, c.Find, c.Replace)
+		if count == 0 {
+			return "", fmt.Errorf("未找到匹配文本: %s (跨运行块的文本无法匹配)", c.Find)
+		}
+		return updated, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ 已替换 %d 处\n", count)
+	return nil
+}
+
+// updateDocx 下载文档、通过 transform 修改 word/document.xml, 然后带
+// If-Match ETag 上传, 并发修改时返回错误而不是覆盖。
+func updateDocx(client graph.Client, id string, transform func(string) (string, error)) error {
+	ctx := context.Background()
+	itemID := graph.ResolveID(id)
+
+	metaData, err := client.Get(ctx, fmt.Sprintf("/me/drive/items/%s?$select=eTag", itemID), nil)
+	if err != nil {
+		return err
+	}
+	var meta struct {
+		ETag string `json:"eTag"`
+	}
+	if err := json.Unmarshal(metaData, &meta); err != nil {
+		return err
+	}
+
+	docx, err := client.Get(ctx, fmt.Sprintf("/me/drive/items/%s/content", itemID), nil)
+	if err != nil {
+		return err
+	}
+
+	updated, err := docxTransformDocument(docx, transform)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/me/drive/items/%s/content", itemID)
+	contentType := "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	if _, err := client.PutMatch(ctx, path, updated, contentType, meta.ETag); err != nil {
+		return err
+	}
+
+	fmt.Println("✓ 文档更新成功")
+	return nil
+}
+
+// docxTransformDocument 重写docx包中的 word/document.xml, 其余部件
+// 原样复制。
+func docxTransformDocument(docx []byte, transform func(string) (string, error)) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(docx), int64(len(docx)))
+	if err != nil {
+		return nil, fmt.Errorf("无法读取docx: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	found := false
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if f.Name == "word/document.xml" {
+			found = true
+			updated, err := transform(string(content))
+			if err != nil {
+				return nil, err
+			}
+			content = []byte(updated)
+		}
+
+		w, err := zw.Create(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("docx中缺少 word/document.xml")
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// docxParagraph 是要追加的单个段落。
+type docxParagraph struct {
+	Style string
+	Text  string
+}
+
+// docxAppendParagraphs 把段落插入到文档正文末尾 (sectPr 之前)。
+func docxAppendParagraphs(document string, paras []docxParagraph) string {
+	var sb strings.Builder
+	for _, p := range paras {
+		sb.WriteString("<w:p>")
+		if p.Style != "" {
+			sb.WriteString(`<w:pPr><w:pStyle w:val="` + p.Style + `"/></w:pPr>`)
+		}
+		sb.WriteString(`<w:r><w:t xml:space="preserve">` + escapeXML(p.Text) + `</w:t></w:r></w:p>`)
+	}
+	inserted := sb.String()
+
+	if i := strings.Index(document, "<w:sectPr"); i >= 0 {
+		return document[:i] + inserted + document[i:]
+	}
+	if i := strings.Index(document, "</w:body>"); i >= 0 {
+		return document[:i] + inserted + document[i:]
+	}
+	return document + inserted
+}
+
+// docxReplaceText 替换文档XML中的文本并返回替换次数。只能匹配未被
+// 格式化拆分到多个运行块的文本。
+func docxReplaceText(document, find, replace string) (string, int) {
+	escapedFind := escapeXML(find)
+	count := strings.Count(document, escapedFind)
+	if count == 0 {
+		return document, 0
+	}
+	return strings.ReplaceAll(document, escapedFind, escapeXML(replace)), count
+}
+
+// markdownParagraphs 把Markdown文本转换为段落, 支持 #/##/### 标题,
+// 其余行作为普通段落。
+func markdownParagraphs(md string) []docxParagraph {
+	var paras []docxParagraph
+	for _, line := range strings.Split(md, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(trimmed, "### "):
+			paras = append(paras, docxParagraph{Style: "Heading3", Text: strings.TrimPrefix(trimmed, "### ")})
+		case strings.HasPrefix(trimmed, "## "):
+			paras = append(paras, docxParagraph{Style: "Heading2", Text: strings.TrimPrefix(trimmed, "## ")})
+		case strings.HasPrefix(trimmed, "# "):
+			paras = append(paras, docxParagraph{Style: "Heading1", Text: strings.TrimPrefix(trimmed, "# ")})
+		default:
+			paras = append(paras, docxParagraph{Text: trimmed})
+		}
+	}
+	return paras
+}
+
+// escapeXML 转义XML文本节点中的特殊字符。
+func escapeXML(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	s = strings.ReplaceAll(s, ">", "&gt;")
+	return s
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/testutil"
+)
+
+func makeTestDocx(t *testing.T, documentXML string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"[Content_Types].xml": `<?xml version="1.0"?><Types/>`,
+		"word/document.xml":   documentXML,
+	} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func extractDocumentXML(t *testing.T, docx []byte) string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(docx), int64(len(docx)))
+	require.NoError(t, err)
+	for _, f := range zr.File {
+		if f.Name == "word/document.xml" {
+			r, err := f.Open()
+			require.NoError(t, err)
+			defer r.Close()
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			return string(content)
+		}
+	}
+	t.Fatal("word/document.xml not found")
+	return ""
+}
+
+func TestDocxAppendParagraphs(t *testing.T) {
+	doc := `<w:document><w:body><w:p/><w:sectPr/></w:body></w:document>`
+	out := docxAppendParagraphs(doc, []docxParagraph{
+		{Text: "hello & <world>"},
+		{Style: "Heading1", Text: "标题"},
+	})
+
+	assert.Contains(t, out, `<w:t xml:space="preserve">hello &amp; &lt;world&gt;</w:t>`)
+	assert.Contains(t, out, `<w:pStyle w:val="Heading1"/>`)
+	// 新段落必须插入在sectPr之前
+	assert.Less(t, strings.Index(out, "hello"), strings.Index(out, "<w:sectPr"))
+}
+
+func TestDocxReplaceText(t *testing.T) {
+	doc := `<w:p><w:r><w:t>foo bar foo</w:t></w:r></w:p>`
+
+	out, count := docxReplaceText(doc, "foo", "baz")
+	assert.Equal(t, 2, count)
+	assert.Equal(t, `<w:p><w:r><w:t>baz bar baz</w:t></w:r></w:p>`, out)
+
+	_, count = docxReplaceText(doc, "missing", "x")
+	assert.Equal(t, 0, count)
+}
+
+func TestMarkdownParagraphs(t *testing.T) {
+	paras := markdownParagraphs("# 标题\n\n正文内容\n## 小节")
+
+	require.Len(t, paras, 3)
+	assert.Equal(t, docxParagraph{Style: "Heading1", Text: "标题"}, paras[0])
+	assert.Equal(t, docxParagraph{Text: "正文内容"}, paras[1])
+	assert.Equal(t, docxParagraph{Style: "Heading2", Text: "小节"}, paras[2])
+}
+
+func TestWordAppendCmd_Run(t *testing.T) {
+	docx := makeTestDocx(t, `<w:document><w:body><w:p/></w:body></w:document>`)
+
+	var gotEtag string
+	var gotUpload []byte
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			if strings.Contains(path, "$select=eTag") {
+				return mustJSON(map[string]string{"eTag": "\"etag-1\""}), nil
+			}
+			assert.Equal(t, "/me/drive/items/doc-1/content", path)
+			return docx, nil
+		},
+		PutMatchFunc: func(ctx context.Context, path string, data []byte, contentType, etag string) ([]byte, error) {
+			assert.Equal(t, "/me/drive/items/doc-1/content", path)
+			gotEtag = etag
+			gotUpload = data
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &WordAppendCmd{ID: "doc-1", Text: "新段落"}
+	var err error
+	output := captureOutput(func() { err = cmd.Run(root) })
+
+	require.NoError(t, err)
+	assert.Equal(t, "\"etag-1\"", gotEtag)
+	assert.Contains(t, output, "文档更新成功")
+	assert.Contains(t, extractDocumentXML(t, gotUpload), "新段落")
+}
+
+func TestWordReplaceCmd_NoMatch(t *testing.T) {
+	docx := makeTestDocx(t, `<w:document><w:body><w:p><w:r><w:t>hello</w:t></w:r></w:p></w:body></w:document>`)
+
+	uploaded := false
+	mock := &testutil.MockClient{
+		GetFunc: func(ctx context.Context, path string, query url.Values) ([]byte, error) {
+			if strings.Contains(path, "$select=eTag") {
+				return mustJSON(map[string]string{"eTag": "\"etag-1\""}), nil
+			}
+			return docx, nil
+		},
+		PutMatchFunc: func(ctx context.Context, path string, data []byte, contentType, etag string) ([]byte, error) {
+			uploaded = true
+			return nil, nil
+		},
+	}
+	root := &Root{ClientFactory: mockClientFactory(mock)}
+
+	cmd := &WordReplaceCmd{ID: "doc-1", Find: "missing", Replace: "x"}
+	var err error
+	captureOutput(func() { err = cmd.Run(root) })
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "未找到匹配文本")
+	assert.False(t, uploaded)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 )
 
 // Config holds mog configuration.
@@ -14,6 +15,21 @@ type Config struct {
 	ClientID   string `json:"client_id"`  // Go format
 	ClientIDv2 string `json:"clientId"`   // Node format
 	Storage    string `json:"storage"`    // Token storage: file or keychain
+
+	// Tenant pins this profile to a specific Azure AD tenant
+	// ("common" when empty).
+	Tenant string `json:"tenant,omitempty"`
+
+	// Cloud selects a national cloud environment (global, usgov,
+	// china); Authority optionally overrides the login endpoint.
+	Cloud     string `json:"cloud,omitempty"`
+	Authority string `json:"authority,omitempty"`
+
+	// App-only (client credentials) authentication for daemons.
+	Auth         string `json:"auth,omitempty"`          // "app" = client credentials grant
+	ClientSecret string `json:"client_secret,omitempty"` // app-only: secret
+	CertFile     string `json:"cert_file,omitempty"`     // app-only: PEM certificate + key
+	User         string `json:"user,omitempty"`          // app-only: default /users/{id} target
 }
 
 // GetClientID returns the client ID, handling both formats.
@@ -32,6 +48,10 @@ type Tokens struct {
 	ExpiresAt    int64  `json:"expires_at"`    // Go format
 	ExpiresIn    int64  `json:"expires_in"`    // Node format
 	SavedAt      int64  `json:"saved_at"`      // Node format (ms)
+
+	// Scope records the space-separated scopes the token was granted
+	// with, so an incremental consent login can start from them.
+	Scope string `json:"scope,omitempty"`
 }
 
 // GetExpiresAt returns the expiration time, handling both formats.
@@ -46,14 +66,31 @@ func (t *Tokens) GetExpiresAt() int64 {
 	return 0
 }
 
-// Slugs holds ID to slug mappings.
+// Slugs holds ID to slug mappings. SeenAt records when each slug was
+// last used (unix seconds) so stale entries can be pruned.
 type Slugs struct {
 	IDToSlug map[string]string `json:"id_to_slug"`
 	SlugToID map[string]string `json:"slug_to_id"`
+	SeenAt   map[string]int64  `json:"seen_at,omitempty"`
 }
 
-// ConfigDir returns the config directory path.
-func ConfigDir() (string, error) {
+// currentProfile holds the active named profile ("" = default layout).
+var currentProfile string
+
+// SetProfile selects a named account profile. All config, token and slug
+// files are then read from and written to the profile's own directory.
+func SetProfile(name string) {
+	currentProfile = name
+}
+
+// Profile returns the active profile name ("" for the default profile).
+func Profile() string {
+	return currentProfile
+}
+
+// BaseConfigDir returns the root mog config directory, ignoring the
+// active profile.
+func BaseConfigDir() (string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return "", err
@@ -61,6 +98,46 @@ func ConfigDir() (string, error) {
 	return filepath.Join(home, ".config", "mog"), nil
 }
 
+// ConfigDir returns the config directory path for the active profile.
+// The default profile keeps the original flat layout so existing
+// installations stay valid.
+func ConfigDir() (string, error) {
+	base, err := BaseConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if currentProfile == "" {
+		return base, nil
+	}
+	return filepath.Join(base, "profiles", currentProfile), nil
+}
+
+// ListProfiles returns the named profiles that exist on disk, sorted.
+// The default profile is not included.
+func ListProfiles() ([]string, error) {
+	base, err := BaseConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(filepath.Join(base, "profiles"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 // Load loads the configuration file.
 func Load() (*Config, error) {
 	dir, err := ConfigDir()
@@ -533,3 +533,67 @@ func TestSlugs_OnlySlugToID(t *testing.T) {
 	assert.NotNil(t, slugs.SlugToID)
 	assert.Equal(t, "id1", slugs.SlugToID["slug1"])
 }
+
+func TestSetProfile_ConfigDir(t *testing.T) {
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+	defer SetProfile("")
+
+	// Default profile keeps the flat layout
+	dir, err := ConfigDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tmpDir, ".config", "mog"), dir)
+
+	// Named profile gets its own directory
+	SetProfile("work")
+	assert.Equal(t, "work", Profile())
+
+	dir, err = ConfigDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tmpDir, ".config", "mog", "profiles", "work"), dir)
+}
+
+func TestSetProfile_IsolatesTokens(t *testing.T) {
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+	defer SetProfile("")
+
+	SetProfile("work")
+	require.NoError(t, SaveTokens(&Tokens{AccessToken: "work-token"}))
+
+	SetProfile("personal")
+	_, err := LoadTokens()
+	assert.Error(t, err)
+
+	SetProfile("work")
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.Equal(t, "work-token", tokens.AccessToken)
+}
+
+func TestListProfiles(t *testing.T) {
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+	defer SetProfile("")
+
+	// No profiles directory yet
+	names, err := ListProfiles()
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	for _, name := range []string{"work", "personal"} {
+		SetProfile(name)
+		require.NoError(t, Save(&Config{ClientID: "id-" + name}))
+	}
+	SetProfile("")
+
+	names, err = ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"personal", "work"}, names)
+}
@@ -31,18 +31,27 @@ func SetStorage(st StorageType) {
 	CurrentStorage = st
 }
 
+// keyringTokenKey returns the keyring entry name for the active profile,
+// so each profile keeps its own keyring credentials.
+func keyringTokenKey() string {
+	if p := Profile(); p != "" {
+		return tokenKey + ":" + p
+	}
+	return tokenKey
+}
+
 // SaveTokensKeyring saves OAuth tokens to the system keyring.
 func SaveTokensKeyring(tokens *Tokens) error {
 	data, err := json.Marshal(tokens)
 	if err != nil {
 		return fmt.Errorf("failed to marshal tokens: %w", err)
 	}
-	return keyring.Set(serviceName, tokenKey, string(data))
+	return keyring.Set(serviceName, keyringTokenKey(), string(data))
 }
 
 // LoadTokensKeyring loads OAuth tokens from the system keyring.
 func LoadTokensKeyring() (*Tokens, error) {
-	data, err := keyring.Get(serviceName, tokenKey)
+	data, err := keyring.Get(serviceName, keyringTokenKey())
 	if err != nil {
 		if err == keyring.ErrNotFound {
 			return nil, fmt.Errorf("not logged in. Run: mog auth login")
@@ -59,7 +68,7 @@ func LoadTokensKeyring() (*Tokens, error) {
 
 // DeleteTokensKeyring removes OAuth tokens from the system keyring.
 func DeleteTokensKeyring() error {
-	err := keyring.Delete(serviceName, tokenKey)
+	err := keyring.Delete(serviceName, keyringTokenKey())
 	if err != nil && err != keyring.ErrNotFound {
 		return fmt.Errorf("failed to delete tokens from keyring: %w", err)
 	}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/visionik/mogcli/internal/config"
+)
+
+// actingUser holds the /users/{id} target for app-only tokens, which
+// have no /me.
+var actingUser string
+
+// SetActingUser selects the user all /me paths are rewritten to.
+func SetActingUser(id string) {
+	actingUser = id
+}
+
+// userPath rewrites /me paths to /users/{id} when an acting user is set.
+func userPath(path string) string {
+	if actingUser == "" {
+		return path
+	}
+	if path == "/me" {
+		return "/users/" + actingUser
+	}
+	if strings.HasPrefix(path, "/me/") {
+		return "/users/" + actingUser + strings.TrimPrefix(path, "/me")
+	}
+	return path
+}
+
+// ClientCredentialsToken acquires an app-only token via the client
+// credentials grant, authenticating with either a client secret or a
+// PEM certificate (certificate + private key) used as a signed
+// assertion. SetAuthTenant must point at a real tenant first — the
+// grant is rejected on the "common" endpoint.
+func ClientCredentialsToken(clientID, clientSecret, certFile string) (*config.Tokens, error) {
+	data := url.Values{}
+	data.Set("client_id", clientID)
+	data.Set("grant_type", "client_credentials")
+	data.Set("scope", GraphScope())
+
+	switch {
+	case clientSecret != "":
+		data.Set("client_secret", clientSecret)
+	case certFile != "":
+		assertion, err := certAssertion(certFile, clientID)
+		if err != nil {
+			return nil, err
+		}
+		data.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+		data.Set("client_assertion", assertion)
+	default:
+		return nil, fmt.Errorf("client credentials flow needs a client secret or certificate")
+	}
+
+	resp, err := http.PostForm(AuthURL+"/token", data)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenResp TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, err
+	}
+
+	if tokenResp.Error != "" {
+		return nil, fmt.Errorf("%s: %s", tokenResp.Error, tokenResp.ErrorDesc)
+	}
+
+	return &config.Tokens{
+		AccessToken: tokenResp.AccessToken,
+		ExpiresAt:   time.Now().Unix() + int64(tokenResp.ExpiresIn),
+	}, nil
+}
+
+// certAssertion builds the signed JWT client assertion for
+// certificate-based authentication. The PEM file must contain both the
+// certificate and its RSA private key.
+func certAssertion(certFile, clientID string) (string, error) {
+	pemData, err := os.ReadFile(certFile)
+	if err != nil {
+		return "", err
+	}
+
+	var cert *x509.Certificate
+	var key *rsa.PrivateKey
+	for block, rest := pem.Decode(pemData); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "CERTIFICATE":
+			if cert, err = x509.ParseCertificate(block.Bytes); err != nil {
+				return "", fmt.Errorf("failed to parse certificate: %w", err)
+			}
+		case "RSA PRIVATE KEY":
+			if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+				return "", fmt.Errorf("failed to parse private key: %w", err)
+			}
+		case "PRIVATE KEY":
+			parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return "", fmt.Errorf("failed to parse private key: %w", err)
+			}
+			rsaKey, ok := parsed.(*rsa.PrivateKey)
+			if !ok {
+				return "", fmt.Errorf("only RSA private keys are supported")
+			}
+			key = rsaKey
+		}
+	}
+	if cert == nil || key == nil {
+		return "", fmt.Errorf("%s must contain a certificate and an RSA private key", certFile)
+	}
+
+	thumbprint := sha1.Sum(cert.Raw)
+	header := map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	}
+
+	now := time.Now()
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	claims := map[string]interface{}{
+		"aud": AuthURL + "/token",
+		"iss": clientID,
+		"sub": clientID,
+		"jti": hex.EncodeToString(jti),
+		"nbf": now.Unix(),
+		"exp": now.Add(10 * time.Minute).Unix(),
+	}
+
+	headerJSON, _ := json.Marshal(header)
+	claimsJSON, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) +
+		"." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign assertion: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,102 @@
+package graph
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserPath(t *testing.T) {
+	defer SetActingUser("")
+
+	// Without an acting user paths pass through unchanged
+	assert.Equal(t, "/me/messages", userPath("/me/messages"))
+
+	SetActingUser("user-1")
+	assert.Equal(t, "/users/user-1", userPath("/me"))
+	assert.Equal(t, "/users/user-1/messages", userPath("/me/messages"))
+	assert.Equal(t, "/users/other", userPath("/users/other"))
+	assert.Equal(t, "/$batch", userPath("/$batch"))
+}
+
+func TestClientCredentialsToken_Secret(t *testing.T) {
+	origAuthURL := AuthURL
+	defer func() { AuthURL = origAuthURL }()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/token", r.URL.Path)
+		assert.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		assert.Equal(t, "https://graph.microsoft.com/.default", r.Form.Get("scope"))
+		assert.Equal(t, "secret-1", r.Form.Get("client_secret"))
+		fmt.Fprint(w, `{"access_token":"app-token","expires_in":3600}`)
+	}))
+	defer server.Close()
+	AuthURL = server.URL
+
+	tokens, err := ClientCredentialsToken("client-1", "secret-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "app-token", tokens.AccessToken)
+	assert.Greater(t, tokens.ExpiresAt, time.Now().Unix())
+}
+
+func TestClientCredentialsToken_NoCredentials(t *testing.T) {
+	_, err := ClientCredentialsToken("client-1", "", "")
+	assert.Error(t, err)
+}
+
+func TestCertAssertion(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mog-test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(t.TempDir(), "cert.pem")
+	var pemData []byte
+	pemData = append(pemData, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})...)
+	pemData = append(pemData, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})...)
+	require.NoError(t, os.WriteFile(certFile, pemData, 0600))
+
+	assertion, err := certAssertion(certFile, "client-1")
+	require.NoError(t, err)
+
+	parts := strings.Split(assertion, ".")
+	require.Len(t, parts, 3)
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	var header map[string]string
+	require.NoError(t, json.Unmarshal(headerJSON, &header))
+	assert.Equal(t, "RS256", header["alg"])
+	assert.NotEmpty(t, header["x5t"])
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	assert.Equal(t, "client-1", claims["iss"])
+	assert.Equal(t, "client-1", claims["sub"])
+}
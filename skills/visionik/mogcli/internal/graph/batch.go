@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// batchLimit is the maximum number of sub-requests Graph accepts in a
+// single $batch call.
+const batchLimit = 20
+
+// BatchRequest is a single sub-request inside a $batch call. URL is
+// relative to the API root (e.g. "/me/messages/xyz") and may carry a
+// query string. When Body is non-nil a JSON Content-Type header is added
+// automatically.
+type BatchRequest struct {
+	ID      string            `json:"id"`
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Body    interface{}       `json:"body,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// BatchResponse is the outcome of one sub-request.
+type BatchResponse struct {
+	ID     string          `json:"id"`
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// Err returns nil for successful responses, otherwise an error built from
+// the sub-response's error envelope (same shape as top-level Graph errors).
+func (r BatchResponse) Err() error {
+	if r.Status < 400 {
+		return nil
+	}
+	var errResp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(r.Body, &errResp) == nil && errResp.Error.Message != "" {
+		return fmt.Errorf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+	}
+	return fmt.Errorf("API error %d: %s", r.Status, string(r.Body))
+}
+
+// Batch sends the requests through the $batch endpoint, splitting into
+// chunks of at most batchLimit. Requests without an ID are numbered by
+// position; responses are returned in request order regardless of the
+// order Graph answered them in. A non-nil error means the $batch call
+// itself failed — per-request failures are reported via BatchResponse.Err.
+func Batch(ctx context.Context, c Client, reqs []BatchRequest) ([]BatchResponse, error) {
+	numbered := make([]BatchRequest, len(reqs))
+	for i, req := range reqs {
+		if req.ID == "" {
+			req.ID = strconv.Itoa(i + 1)
+		}
+		if req.Body != nil {
+			headers := map[string]string{"Content-Type": "application/json"}
+			for k, v := range req.Headers {
+				headers[k] = v
+			}
+			req.Headers = headers
+		}
+		numbered[i] = req
+	}
+
+	order := make(map[string]int, len(numbered))
+	for i, req := range numbered {
+		order[req.ID] = i
+	}
+
+	var responses []BatchResponse
+	for start := 0; start < len(numbered); start += batchLimit {
+		end := start + batchLimit
+		if end > len(numbered) {
+			end = len(numbered)
+		}
+
+		data, err := c.Post(ctx, "/$batch", map[string]interface{}{
+			"requests": numbered[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Responses []BatchResponse `json:"responses"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse batch response: %w", err)
+		}
+		responses = append(responses, resp.Responses...)
+	}
+
+	sort.SliceStable(responses, func(i, j int) bool {
+		return order[responses[i].ID] < order[responses[j].ID]
+	})
+	return responses, nil
+}
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch_SingleChunk(t *testing.T) {
+	origBaseURL := GraphBaseURL
+	defer func() { GraphBaseURL = origBaseURL }()
+
+	var gotBody struct {
+		Requests []BatchRequest `json:"requests"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/$batch", r.URL.Path)
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &gotBody))
+
+		// Answer out of order to exercise reordering.
+		fmt.Fprint(w, `{"responses":[
+			{"id":"2","status":204},
+			{"id":"1","status":200,"body":{"id":"item-1"}}
+		]}`)
+	}))
+	defer server.Close()
+	GraphBaseURL = server.URL
+
+	client := NewClientWithToken("test-token")
+	resps, err := Batch(context.Background(), client, []BatchRequest{
+		{Method: "GET", URL: "/me/messages/msg-1"},
+		{Method: "PATCH", URL: "/me/messages/msg-2", Body: map[string]bool{"isRead": true}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resps, 2)
+	assert.Equal(t, "1", resps[0].ID)
+	assert.Equal(t, 200, resps[0].Status)
+	assert.Equal(t, "2", resps[1].ID)
+
+	require.Len(t, gotBody.Requests, 2)
+	assert.Equal(t, "1", gotBody.Requests[0].ID)
+	assert.Nil(t, gotBody.Requests[0].Headers)
+	assert.Equal(t, "application/json", gotBody.Requests[1].Headers["Content-Type"])
+}
+
+func TestBatch_SplitsLargeSets(t *testing.T) {
+	origBaseURL := GraphBaseURL
+	defer func() { GraphBaseURL = origBaseURL }()
+
+	var calls int
+	var sizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var body struct {
+			Requests []BatchRequest `json:"requests"`
+		}
+		data, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(data, &body))
+		sizes = append(sizes, len(body.Requests))
+
+		resps := make([]map[string]interface{}, len(body.Requests))
+		for i, req := range body.Requests {
+			resps[i] = map[string]interface{}{"id": req.ID, "status": 204}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"responses": resps})
+	}))
+	defer server.Close()
+	GraphBaseURL = server.URL
+
+	reqs := make([]BatchRequest, 25)
+	for i := range reqs {
+		reqs[i] = BatchRequest{Method: "DELETE", URL: fmt.Sprintf("/me/messages/msg-%d", i)}
+	}
+
+	client := NewClientWithToken("test-token")
+	resps, err := Batch(context.Background(), client, reqs)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []int{20, 5}, sizes)
+	assert.Len(t, resps, 25)
+}
+
+func TestBatchResponse_Err(t *testing.T) {
+	ok := BatchResponse{Status: 204}
+	assert.NoError(t, ok.Err())
+
+	failed := BatchResponse{
+		Status: 404,
+		Body:   []byte(`{"error":{"code":"itemNotFound","message":"not found"}}`),
+	}
+	require.Error(t, failed.Err())
+	assert.Contains(t, failed.Err().Error(), "itemNotFound")
+}
@@ -32,6 +32,10 @@ type Client interface {
 	Delete(ctx context.Context, path string) error
 	PostHTML(ctx context.Context, path string, html string) ([]byte, error)
 	Put(ctx context.Context, path string, data []byte, contentType string) ([]byte, error)
+	PostRaw(ctx context.Context, path string, data []byte, contentType string) ([]byte, error)
+	PostForLocation(ctx context.Context, path string, body interface{}) (string, error)
+	PutMatch(ctx context.Context, path string, data []byte, contentType, etag string) ([]byte, error)
+	PatchMatch(ctx context.Context, path string, body interface{}, etag string) ([]byte, error)
 }
 
 // GraphClient is the concrete implementation of the Client interface.
@@ -48,28 +52,54 @@ func NewClient() (Client, error) {
 		return nil, err
 	}
 
+	cfg, cfgErr := config.Load()
+	if cfgErr == nil {
+		if cfg.Cloud != "" || cfg.Authority != "" {
+			if err := SetCloud(cfg.Cloud, cfg.Authority); err != nil {
+				return nil, err
+			}
+		}
+		if cfg.Tenant != "" {
+			SetAuthTenant(cfg.Tenant)
+		}
+		if actingUser == "" && cfg.User != "" {
+			SetActingUser(cfg.User)
+		}
+	}
+
 	// Check if token is expired
 	expiresAt := tokens.GetExpiresAt()
 	if expiresAt > 0 && time.Now().Unix() >= expiresAt {
-		// Try to refresh
-		cfg, err := config.Load()
-		if err != nil {
+		if cfgErr != nil {
 			return nil, fmt.Errorf("token expired, please login again")
 		}
 		clientID := cfg.GetClientID()
-		if tokens.RefreshToken == "" || clientID == "" {
-			return nil, fmt.Errorf("token expired, please login again")
-		}
 
-		newTokens, err := RefreshToken(clientID, tokens.RefreshToken)
-		if err != nil {
-			return nil, fmt.Errorf("failed to refresh token: %w", err)
+		var newTokens *config.Tokens
+		if cfg.Auth == "app" {
+			// App-only tokens cannot be refreshed — re-run the
+			// client credentials grant with the stored credentials.
+			newTokens, err = ClientCredentialsToken(clientID, cfg.ClientSecret, cfg.CertFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to renew app token: %w", err)
+			}
+			if err := config.SaveTokens(newTokens); err != nil {
+				return nil, fmt.Errorf("failed to save tokens: %w", err)
+			}
+		} else {
+			if tokens.RefreshToken == "" || clientID == "" {
+				return nil, fmt.Errorf("token expired, please login again")
+			}
+			newTokens, err = RefreshToken(clientID, tokens.RefreshToken)
+			if err != nil {
+				return nil, fmt.Errorf("failed to refresh token: %w", err)
+			}
 		}
 		tokens = newTokens
 	}
 
 	return &GraphClient{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		httpClient: &http.Client{Timeout: 30 * time.Second, Transport: newStatsTransport()},
 		token:      tokens.AccessToken,
 	}, nil
 }
@@ -77,7 +107,7 @@ func NewClient() (Client, error) {
 // NewClientWithToken creates a new Graph client with a provided token (useful for testing).
 func NewClientWithToken(token string) Client {
 	return &GraphClient{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		httpClient: &http.Client{Timeout: 30 * time.Second, Transport: newStatsTransport()},
 		token:      token,
 	}
 }
@@ -105,9 +135,13 @@ func (c *GraphClient) Delete(ctx context.Context, path string) error {
 
 // PostHTML performs a POST request with HTML/XHTML content (for OneNote pages).
 func (c *GraphClient) PostHTML(ctx context.Context, path string, html string) ([]byte, error) {
-	u := GraphBaseURL + path
+	u := GraphBaseURL + userPath(path)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", u, strings.NewReader(html))
+	if dryRunMode {
+		return dryRunRequest("POST", u, html), nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), "POST", u, strings.NewReader(html))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -117,7 +151,7 @@ func (c *GraphClient) PostHTML(ctx context.Context, path string, html string) ([
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(err)
 	}
 	defer resp.Body.Close()
 
@@ -144,9 +178,13 @@ func (c *GraphClient) PostHTML(ctx context.Context, path string, html string) ([
 
 // Put performs a PUT request with raw bytes (for file uploads).
 func (c *GraphClient) Put(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
-	u := GraphBaseURL + path
+	u := GraphBaseURL + userPath(path)
 
-	req, err := http.NewRequestWithContext(ctx, "PUT", u, bytes.NewReader(data))
+	if dryRunMode {
+		return dryRunRequest("PUT", u, fmt.Sprintf("(%d bytes %s)", len(data), contentType)), nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), "PUT", u, bytes.NewReader(data))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -156,7 +194,7 @@ func (c *GraphClient) Put(ctx context.Context, path string, data []byte, content
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(err)
 	}
 	defer resp.Body.Close()
 
@@ -181,22 +219,234 @@ func (c *GraphClient) Put(ctx context.Context, path string, data []byte, content
 	return respBody, nil
 }
 
+// PostRaw performs a POST request with raw bytes and an explicit content
+// type (e.g. creating a message from base64 MIME).
+func (c *GraphClient) PostRaw(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+	u := GraphBaseURL + userPath(path)
+
+	if dryRunMode {
+		return dryRunRequest("POST", u, fmt.Sprintf("(%d bytes %s)", len(data), contentType)), nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), "POST", u, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		var errResp struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error.Message != "" {
+			return nil, fmt.Errorf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+		}
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// PutMatch performs a PUT request with raw bytes and an If-Match
+// precondition, failing with 412 if the item's ETag no longer matches.
+func (c *GraphClient) PutMatch(ctx context.Context, path string, data []byte, contentType, etag string) ([]byte, error) {
+	u := GraphBaseURL + userPath(path)
+
+	if dryRunMode {
+		return dryRunRequest("PUT", u, fmt.Sprintf("(%d bytes %s)", len(data), contentType)), nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), "PUT", u, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", contentType)
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return nil, fmt.Errorf("item was modified concurrently, retry the operation")
+	}
+	if resp.StatusCode >= 400 {
+		var errResp struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error.Message != "" {
+			return nil, fmt.Errorf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+		}
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// PatchMatch performs a PATCH request with an If-Match precondition, as
+// required by APIs such as Planner that guard every update with ETags.
+func (c *GraphClient) PatchMatch(ctx context.Context, path string, body interface{}, etag string) ([]byte, error) {
+	u := GraphBaseURL + userPath(path)
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal body: %w", err)
+	}
+
+	if dryRunMode {
+		return dryRunRequest("PATCH", u, string(data)), nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), "PATCH", u, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusPreconditionFailed {
+		return nil, fmt.Errorf("item was modified concurrently, retry the operation")
+	}
+	if resp.StatusCode >= 400 {
+		var errResp struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error.Message != "" {
+			return nil, fmt.Errorf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+		}
+		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+// PostForLocation performs a POST request and returns the Location header
+// of the response, used for async operations such as driveItem copy that
+// answer 202 Accepted with a monitor URL.
+func (c *GraphClient) PostForLocation(ctx context.Context, path string, body interface{}) (string, error) {
+	u := GraphBaseURL + userPath(path)
+
+	var data []byte
+	var bodyReader io.Reader
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal body: %w", err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	if dryRunMode {
+		dryRunRequest("POST", u, string(data))
+		return "", nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), "POST", u, bodyReader)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", requestError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		var errResp struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(respBody, &errResp) == nil && errResp.Error.Message != "" {
+			return "", fmt.Errorf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+		}
+		return "", fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return resp.Header.Get("Location"), nil
+}
+
 func (c *GraphClient) request(ctx context.Context, method, path string, query url.Values, body interface{}) ([]byte, error) {
-	u := GraphBaseURL + path
+	u := GraphBaseURL + userPath(path)
 	if query != nil && len(query) > 0 {
 		u += "?" + query.Encode()
 	}
 
+	var data []byte
 	var bodyReader io.Reader
 	if body != nil {
-		data, err := json.Marshal(body)
+		var err error
+		data, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal body: %w", err)
 		}
 		bodyReader = bytes.NewReader(data)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, u, bodyReader)
+	if dryRunMode && method != "GET" {
+		return dryRunRequest(method, u, string(data)), nil
+	}
+
+	req, err := http.NewRequestWithContext(requestContext(ctx), method, u, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -206,7 +456,7 @@ func (c *GraphClient) request(ctx context.Context, method, path string, query ur
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, requestError(err)
 	}
 	defer resp.Body.Close()
 
@@ -215,6 +465,9 @@ func (c *GraphClient) request(ctx context.Context, method, path string, query ur
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, scopeError(respBody)
+	}
 	if resp.StatusCode >= 400 {
 		var errResp struct {
 			Error struct {
@@ -231,6 +484,29 @@ func (c *GraphClient) request(ctx context.Context, method, path string, query ur
 	return respBody, nil
 }
 
+// scopeError maps a 403 to actionable advice: the token itself is valid
+// but is missing a permission scope, so point at an incremental consent
+// login instead of surfacing an opaque access-denied error.
+func scopeError(respBody []byte) error {
+	var errResp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	detail := string(respBody)
+	if json.Unmarshal(respBody, &errResp) == nil && errResp.Error.Message != "" {
+		detail = fmt.Sprintf("%s: %s", errResp.Error.Code, errResp.Error.Message)
+	}
+
+	advice := "re-run: mog auth login --scopes <granted>,<missing-scope> to consent incrementally"
+	if tokens, err := config.LoadTokensAuto(); err == nil && tokens.Scope != "" {
+		granted := strings.Join(strings.Fields(tokens.Scope), ",")
+		advice = fmt.Sprintf("granted scopes: %s — re-run: mog auth login --scopes %s,<missing-scope> to consent incrementally", tokens.Scope, granted)
+	}
+	return fmt.Errorf("%s (%s)", detail, advice)
+}
+
 // DeviceCodeResponse is the response from the device code request.
 type DeviceCodeResponse struct {
 	DeviceCode      string `json:"device_code"`
@@ -252,18 +528,28 @@ type TokenResponse struct {
 	ErrorDesc    string `json:"error_description"`
 }
 
-// RequestDeviceCode initiates the device code flow.
-func RequestDeviceCode(clientID string) (*DeviceCodeResponse, error) {
-	scopes := []string{
-		"User.Read",
-		"offline_access",
-		"Mail.ReadWrite",
-		"Mail.Send",
-		"Calendars.ReadWrite",
-		"Files.ReadWrite.All",
-		"Contacts.ReadWrite",
-		"Tasks.ReadWrite",
-		"Notes.ReadWrite",
+// DefaultScopes are the delegated scopes requested when login does not
+// specify its own set.
+var DefaultScopes = []string{
+	"User.Read",
+	"offline_access",
+	"Mail.ReadWrite",
+	"Mail.Send",
+	"Calendars.ReadWrite",
+	"Files.ReadWrite.All",
+	"Contacts.ReadWrite",
+	"Tasks.ReadWrite",
+	"Notes.ReadWrite",
+}
+
+// RequestDeviceCode initiates the device code flow. A nil scopes slice
+// requests DefaultScopes; explicit scopes always get offline_access added
+// so the token stays refreshable.
+func RequestDeviceCode(clientID string, scopes []string) (*DeviceCodeResponse, error) {
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
+	} else if !containsScope(scopes, "offline_access") {
+		scopes = append(append([]string{}, scopes...), "offline_access")
 	}
 
 	data := url.Values{}
@@ -327,10 +613,21 @@ func PollForToken(clientID, deviceCode string, interval int) (*config.Tokens, er
 			AccessToken:  tokenResp.AccessToken,
 			RefreshToken: tokenResp.RefreshToken,
 			ExpiresAt:    time.Now().Unix() + int64(tokenResp.ExpiresIn),
+			Scope:        tokenResp.Scope,
 		}, nil
 	}
 }
 
+// containsScope reports whether the scope list already includes name.
+func containsScope(scopes []string, name string) bool {
+	for _, s := range scopes {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
 // RefreshToken refreshes an access token.
 func RefreshToken(clientID, refreshToken string) (*config.Tokens, error) {
 	data := url.Values{}
@@ -362,6 +659,7 @@ func RefreshToken(clientID, refreshToken string) (*config.Tokens, error) {
 		AccessToken:  tokenResp.AccessToken,
 		RefreshToken: tokenResp.RefreshToken,
 		ExpiresAt:    time.Now().Unix() + int64(tokenResp.ExpiresIn),
+		Scope:        tokenResp.Scope,
 	}
 
 	// Save the new tokens
@@ -1089,7 +1089,7 @@ func TestRequestDeviceCode(t *testing.T) {
 
 	AuthURL = server.URL
 
-	resp, err := RequestDeviceCode("test-client-id")
+	resp, err := RequestDeviceCode("test-client-id", nil)
 	require.NoError(t, err)
 	assert.Equal(t, "device-code-123", resp.DeviceCode)
 	assert.Equal(t, "ABCD-1234", resp.UserCode)
@@ -1317,6 +1317,6 @@ func TestRequestDeviceCode_ParseError(t *testing.T) {
 	AuthURL = server.URL
 
 	// This should return an error due to JSON parsing failure
-	_, err := RequestDeviceCode("test-client-id")
+	_, err := RequestDeviceCode("test-client-id", nil)
 	assert.Error(t, err)
 }
@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cloud bundles the endpoints of a Microsoft cloud environment.
+type Cloud struct {
+	Name      string
+	GraphBase string
+	Authority string
+}
+
+// clouds are the supported national cloud environments.
+var clouds = map[string]Cloud{
+	"global": {Name: "global", GraphBase: "https://graph.microsoft.com/v1.0", Authority: "https://login.microsoftonline.com"},
+	"usgov":  {Name: "usgov", GraphBase: "https://graph.microsoft.us/v1.0", Authority: "https://login.microsoftonline.us"},
+	"china":  {Name: "china", GraphBase: "https://microsoftgraph.chinacloudapi.cn/v1.0", Authority: "https://login.chinacloudapi.cn"},
+}
+
+var (
+	// authorityBase is the login endpoint AuthURL is derived from.
+	authorityBase = "https://login.microsoftonline.com"
+	// authTenant is the tenant segment of AuthURL.
+	authTenant = "common"
+)
+
+// rebuildAuthURL recomputes AuthURL from the authority and tenant.
+func rebuildAuthURL() {
+	AuthURL = authorityBase + "/" + authTenant + "/oauth2/v2.0"
+}
+
+// SetAuthTenant points AuthURL at a specific Azure AD tenant instead of
+// the multi-tenant "common" endpoint.
+func SetAuthTenant(tenant string) {
+	if tenant == "" {
+		tenant = "common"
+	}
+	authTenant = tenant
+	rebuildAuthURL()
+}
+
+// SetCloud selects a national cloud by name ("global", "usgov" or
+// "china"), switching both the Graph base URL and the login authority.
+// A non-empty authority additionally overrides the login endpoint for
+// custom or dedicated clouds.
+func SetCloud(name, authority string) error {
+	if name != "" {
+		cloud, ok := clouds[name]
+		if !ok {
+			return fmt.Errorf("unknown cloud %q (choose global, usgov or china)", name)
+		}
+		GraphBaseURL = cloud.GraphBase
+		authorityBase = cloud.Authority
+	}
+	if authority != "" {
+		authorityBase = strings.TrimRight(authority, "/")
+	}
+	rebuildAuthURL()
+	return nil
+}
+
+// GraphScope returns the ".default" scope for the active Graph cloud,
+// used by the client credentials grant.
+func GraphScope() string {
+	return strings.TrimSuffix(GraphBaseURL, "/v1.0") + "/.default"
+}
@@ -0,0 +1,46 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetCloud(t *testing.T) {
+	t.Helper()
+	origGraphBaseURL := GraphBaseURL
+	origAuthURL := AuthURL
+	origAuthority := authorityBase
+	origTenant := authTenant
+	t.Cleanup(func() {
+		GraphBaseURL = origGraphBaseURL
+		AuthURL = origAuthURL
+		authorityBase = origAuthority
+		authTenant = origTenant
+	})
+}
+
+func TestSetCloud(t *testing.T) {
+	resetCloud(t)
+
+	require.NoError(t, SetCloud("usgov", ""))
+	assert.Equal(t, "https://graph.microsoft.us/v1.0", GraphBaseURL)
+	assert.Equal(t, "https://login.microsoftonline.us/common/oauth2/v2.0", AuthURL)
+	assert.Equal(t, "https://graph.microsoft.us/.default", GraphScope())
+
+	// Tenant survives a cloud switch and vice versa
+	SetAuthTenant("contoso.example")
+	require.NoError(t, SetCloud("china", ""))
+	assert.Equal(t, "https://login.chinacloudapi.cn/contoso.example/oauth2/v2.0", AuthURL)
+
+	// Unknown cloud is rejected
+	assert.Error(t, SetCloud("mars", ""))
+}
+
+func TestSetCloud_AuthorityOverride(t *testing.T) {
+	resetCloud(t)
+
+	require.NoError(t, SetCloud("", "https://login.example.gov/"))
+	assert.Equal(t, "https://login.example.gov/common/oauth2/v2.0", AuthURL)
+}
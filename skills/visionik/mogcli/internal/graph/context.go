@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// rootCtx is the context every Graph request derives from when the
+// caller passes a plain context.Background(). At startup it is replaced
+// with one that is cancelled on Ctrl-C and bounded by --timeout, so
+// cancellation reaches every call without each command threading a
+// context through.
+var rootCtx = context.Background()
+
+// SetRootContext installs the process-wide request context.
+func SetRootContext(ctx context.Context) {
+	rootCtx = ctx
+}
+
+// requestContext substitutes the root context for context.Background();
+// callers that bring their own cancellation or deadline keep it.
+func requestContext(ctx context.Context) context.Context {
+	if ctx == context.Background() {
+		return rootCtx
+	}
+	return ctx
+}
+
+// requestError converts context cancellation into short, actionable
+// messages instead of the wrapped transport error.
+func requestError(err error) error {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return fmt.Errorf("request timed out (increase --timeout)")
+	case errors.Is(err, context.Canceled):
+		return fmt.Errorf("request cancelled")
+	}
+	return fmt.Errorf("request failed: %w", err)
+}
@@ -0,0 +1,38 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	SetRootContext(ctx)
+	defer SetRootContext(context.Background())
+
+	// Plain Background picks up the root context
+	assert.Equal(t, ctx, requestContext(context.Background()))
+
+	// A caller-supplied context is kept as-is
+	own, ownCancel := context.WithCancel(context.Background())
+	defer ownCancel()
+	assert.Equal(t, own, requestContext(own))
+}
+
+func TestRequestError(t *testing.T) {
+	err := requestError(fmt.Errorf("dial: %w", context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "timed out")
+
+	err = requestError(fmt.Errorf("dial: %w", context.Canceled))
+	assert.Contains(t, err.Error(), "cancelled")
+
+	plain := errors.New("connection refused")
+	err = requestError(plain)
+	assert.ErrorIs(t, err, plain)
+}
@@ -0,0 +1,28 @@
+package graph
+
+import (
+	"fmt"
+	"os"
+)
+
+// dryRunMode suppresses write requests, printing what would have been
+// sent instead. Reads still execute so commands can resolve IDs and
+// show real context.
+var dryRunMode bool
+
+// SetDryRun enables or disables dry-run mode for write requests.
+func SetDryRun(v bool) {
+	dryRunMode = v
+}
+
+// dryRunRequest prints the request that would have been issued and
+// returns an empty JSON object in its place. The body argument is
+// already rendered for display (JSON text, or a size summary for raw
+// uploads).
+func dryRunRequest(method, u, body string) []byte {
+	fmt.Fprintf(os.Stderr, "DRY-RUN %s %s\n", method, u)
+	if body != "" {
+		fmt.Fprintf(os.Stderr, "%s\n", body)
+	}
+	return []byte("{}")
+}
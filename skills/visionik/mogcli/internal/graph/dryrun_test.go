@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun_SuppressesWrites(t *testing.T) {
+	var hits []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits = append(hits, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"value":[]}`))
+	}))
+	defer server.Close()
+
+	origBase := GraphBaseURL
+	GraphBaseURL = server.URL
+	defer func() { GraphBaseURL = origBase }()
+
+	SetDryRun(true)
+	defer SetDryRun(false)
+
+	client := NewClientWithToken("test-token")
+	ctx := context.Background()
+
+	// Writes are suppressed and return an empty object
+	data, err := client.Post(ctx, "/me/messages", map[string]string{"subject": "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, "{}", string(data))
+
+	require.NoError(t, client.Delete(ctx, "/me/messages/abc"))
+
+	_, err = client.Put(ctx, "/me/drive/root:/f.txt:/content", []byte("x"), "text/plain")
+	require.NoError(t, err)
+
+	loc, err := client.PostForLocation(ctx, "/me/drive/items/a/copy", nil)
+	require.NoError(t, err)
+	assert.Empty(t, loc)
+
+	assert.Empty(t, hits, "no write should reach the server in dry-run mode")
+
+	// Reads still execute
+	_, err = client.Get(ctx, "/me/messages", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /me/messages"}, hits)
+}
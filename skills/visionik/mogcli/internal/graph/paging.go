@@ -0,0 +1,65 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GetPaged performs a GET and follows @odata.nextLink until max items
+// have been collected (max <= 0 fetches all pages). It returns the raw
+// items and the nextLink left over after the last fetched page (empty
+// when there are no more pages), which callers can expose as a cursor.
+func GetPaged(ctx context.Context, c Client, path string, query url.Values, max int) ([]json.RawMessage, string, error) {
+	var items []json.RawMessage
+	nextLink := ""
+
+	for {
+		data, err := c.Get(ctx, path, query)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var resp struct {
+			Value    []json.RawMessage `json:"value"`
+			NextLink string            `json:"@odata.nextLink"`
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, "", err
+		}
+
+		items = append(items, resp.Value...)
+		nextLink = resp.NextLink
+
+		if nextLink == "" || (max > 0 && len(items) >= max) {
+			break
+		}
+
+		path, query, err = splitNextLink(nextLink)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if max > 0 && len(items) > max {
+		items = items[:max]
+	}
+	return items, nextLink, nil
+}
+
+// splitNextLink converts an absolute @odata.nextLink URL into a path
+// and query usable with Client.Get.
+func splitNextLink(link string) (string, url.Values, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid nextLink: %w", err)
+	}
+
+	path := u.Path
+	if i := strings.Index(path, "/v1.0/"); i >= 0 {
+		path = path[i+len("/v1.0"):]
+	}
+	return path, u.Query(), nil
+}
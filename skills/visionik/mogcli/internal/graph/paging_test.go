@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedClient serves canned responses keyed by request path.
+type pagedClient struct {
+	Client
+	responses map[string][]byte
+	gotPaths  []string
+}
+
+func (p *pagedClient) Get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	p.gotPaths = append(p.gotPaths, path)
+	return p.responses[path], nil
+}
+
+func TestGetPagedFollowsNextLink(t *testing.T) {
+	c := &pagedClient{responses: map[string][]byte{
+		"/me/messages": []byte(`{
+			"value": [{"id":"1"},{"id":"2"}],
+			"@odata.nextLink": "https://graph.microsoft.com/v1.0/me/messages2?$skip=2"
+		}`),
+		"/me/messages2": []byte(`{"value": [{"id":"3"}]}`),
+	}}
+
+	items, next, err := GetPaged(context.Background(), c, "/me/messages", nil, 0)
+	require.NoError(t, err)
+	assert.Len(t, items, 3)
+	assert.Empty(t, next)
+	assert.Equal(t, []string{"/me/messages", "/me/messages2"}, c.gotPaths)
+}
+
+func TestGetPagedStopsAtMax(t *testing.T) {
+	c := &pagedClient{responses: map[string][]byte{
+		"/me/messages": []byte(`{
+			"value": [{"id":"1"},{"id":"2"},{"id":"3"}],
+			"@odata.nextLink": "https://graph.microsoft.com/v1.0/me/messages?$skip=3"
+		}`),
+	}}
+
+	items, next, err := GetPaged(context.Background(), c, "/me/messages", nil, 2)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+	assert.NotEmpty(t, next, "remaining nextLink should be surfaced as a cursor")
+	assert.Equal(t, []string{"/me/messages"}, c.gotPaths)
+}
+
+func TestSplitNextLink(t *testing.T) {
+	path, query, err := splitNextLink("https://graph.microsoft.com/v1.0/me/messages?$skip=25&$top=25")
+	require.NoError(t, err)
+	assert.Equal(t, "/me/messages", path)
+	assert.Equal(t, "25", query.Get("$skip"))
+}
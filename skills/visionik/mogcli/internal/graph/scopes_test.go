@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/visionik/mogcli/internal/config"
+)
+
+func TestRequestDeviceCode_CustomScopes(t *testing.T) {
+	origAuthURL := AuthURL
+	defer func() { AuthURL = origAuthURL }()
+
+	var gotScope string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		gotScope = r.Form.Get("scope")
+		fmt.Fprint(w, `{"device_code":"dc","user_code":"uc","verification_uri":"https://example.com","interval":1}`)
+	}))
+	defer server.Close()
+	AuthURL = server.URL
+
+	// Explicit scopes get offline_access appended
+	_, err := RequestDeviceCode("client-1", []string{"User.Read", "Mail.Read"})
+	require.NoError(t, err)
+	assert.Equal(t, "User.Read Mail.Read offline_access", gotScope)
+
+	// Nil falls back to the default set
+	_, err = RequestDeviceCode("client-1", nil)
+	require.NoError(t, err)
+	assert.Contains(t, gotScope, "Tasks.ReadWrite")
+}
+
+func TestScopeError_SuggestsIncrementalConsent(t *testing.T) {
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, ".config", "mog"), 0700))
+	require.NoError(t, config.SaveTokens(&config.Tokens{
+		AccessToken: "token",
+		Scope:       "User.Read Mail.Read",
+	}))
+
+	err := scopeError([]byte(`{"error":{"code":"ErrorAccessDenied","message":"Access is denied."}}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ErrorAccessDenied")
+	assert.Contains(t, err.Error(), "User.Read Mail.Read")
+	assert.Contains(t, err.Error(), "mog auth login --scopes")
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"sync"
+	"time"
 
 	"github.com/visionik/mogcli/internal/config"
 )
@@ -16,6 +17,16 @@ var (
 
 // FormatID converts a long Microsoft Graph ID to a short slug.
 func FormatID(id string) string {
+	return FormatTypedID("", id)
+}
+
+// FormatTypedID converts a long Microsoft Graph ID to a short slug with
+// a resource-type prefix (e.g. msg_a1b2c3d4, evt_e5f6a7b8). The prefix
+// keeps slugs from different resource types out of each other's
+// namespace; an empty kind produces a bare slug. The kind only matters
+// when the slug is first created — later calls return the cached slug
+// regardless of kind.
+func FormatTypedID(kind, id string) string {
 	if id == "" {
 		return ""
 	}
@@ -23,40 +34,38 @@ func FormatID(id string) string {
 	slugMu.Lock()
 	defer slugMu.Unlock()
 
-	if slugCache == nil {
-		var err error
-		slugCache, err = config.LoadSlugs()
-		if err != nil {
-			slugCache = &config.Slugs{
-				IDToSlug: make(map[string]string),
-				SlugToID: make(map[string]string),
-			}
-		}
-	}
+	ensureSlugCache()
 
 	// Check if we already have a slug for this ID
 	if slug, ok := slugCache.IDToSlug[id]; ok {
+		touchSlug(slug)
 		return slug
 	}
 
-	// Generate a new slug
-	hash := sha256.Sum256([]byte(id))
-	slug := hex.EncodeToString(hash[:])[:8]
-
-	// Handle collisions
-	origSlug := slug
-	counter := 0
-	for {
+	// Generate a new slug, lengthening the hash prefix until it is
+	// unique within the cache
+	hash := hex.EncodeToString(sha256Sum(id))
+	var slug string
+	for n := 8; ; n += 4 {
+		if n > len(hash) {
+			n = len(hash)
+		}
+		slug = hash[:n]
+		if kind != "" {
+			slug = kind + "_" + slug
+		}
 		if existingID, ok := slugCache.SlugToID[slug]; !ok || existingID == id {
 			break
 		}
-		counter++
-		slug = origSlug[:6] + hex.EncodeToString([]byte{byte(counter)})[:2]
+		if n == len(hash) {
+			break
+		}
 	}
 
 	// Store the mapping
 	slugCache.IDToSlug[id] = slug
 	slugCache.SlugToID[slug] = id
+	touchSlug(slug)
 
 	// Save to disk (ignore errors for performance)
 	_ = config.SaveSlugs(slugCache)
@@ -64,37 +73,85 @@ func FormatID(id string) string {
 	return slug
 }
 
+// touchSlug records when a slug was last used, for TTL-based pruning.
+// The timestamp is persisted the next time the cache is saved. Must be
+// called with slugMu held.
+func touchSlug(slug string) {
+	if slugCache.SeenAt == nil {
+		slugCache.SeenAt = make(map[string]int64)
+	}
+	slugCache.SeenAt[slug] = time.Now().Unix()
+}
+
+// sha256Sum returns the SHA-256 digest of s.
+func sha256Sum(s string) []byte {
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
+
+// ensureSlugCache loads the cache from disk if not already in memory.
+// Must be called with slugMu held.
+func ensureSlugCache() {
+	if slugCache != nil {
+		return
+	}
+	var err error
+	slugCache, err = config.LoadSlugs()
+	if err != nil {
+		slugCache = &config.Slugs{
+			IDToSlug: make(map[string]string),
+			SlugToID: make(map[string]string),
+		}
+	}
+}
+
 // ResolveID converts a slug or full ID back to a full ID.
 func ResolveID(input string) string {
 	if input == "" {
 		return ""
 	}
 
-	// If it looks like a full ID (long), return as-is
-	if len(input) > 16 {
-		return input
-	}
-
 	slugMu.Lock()
 	defer slugMu.Unlock()
 
-	if slugCache == nil {
-		var err error
-		slugCache, err = config.LoadSlugs()
-		if err != nil {
-			return input
-		}
-	}
+	ensureSlugCache()
 
 	// Try to resolve as a slug
 	if fullID, ok := slugCache.SlugToID[input]; ok {
 		return fullID
 	}
 
-	// Return as-is (might be a short ID that we haven't seen)
+	// Return as-is (full ID, or a short ID that we haven't seen)
 	return input
 }
 
+// PruneSlugs removes cached slugs not used within ttl and reports how
+// many were removed. Entries from caches written before timestamps were
+// tracked have no last-used time and are pruned as well.
+func PruneSlugs(ttl time.Duration) (int, error) {
+	slugMu.Lock()
+	defer slugMu.Unlock()
+
+	ensureSlugCache()
+
+	cutoff := time.Now().Add(-ttl).Unix()
+	removed := 0
+	for slug, id := range slugCache.SlugToID {
+		if slugCache.SeenAt[slug] >= cutoff {
+			continue
+		}
+		delete(slugCache.SlugToID, slug)
+		delete(slugCache.IDToSlug, id)
+		delete(slugCache.SeenAt, slug)
+		removed++
+	}
+
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, config.SaveSlugs(slugCache)
+}
+
 // ClearSlugs clears the slug cache.
 func ClearSlugs() error {
 	slugMu.Lock()
@@ -1,9 +1,12 @@
 package graph
 
 import (
+	"encoding/hex"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -260,3 +263,81 @@ func TestFormatID_SlugLength(t *testing.T) {
 		assert.Len(t, slug, 8, "all slugs should be 8 chars for id: %s", id)
 	}
 }
+
+func TestFormatTypedID(t *testing.T) {
+	// Setup: use temp dir for config
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+
+	// Clear cache
+	slugMu.Lock()
+	slugCache = nil
+	slugMu.Unlock()
+
+	id := "AQMkADAwATMzAGZmAS04MDViLTRiNzgtMDACLTAwCgBGAAADTypedID"
+	slug := FormatTypedID("msg", id)
+
+	assert.True(t, strings.HasPrefix(slug, "msg_"), "slug should carry the type prefix: %s", slug)
+	assert.Len(t, slug, len("msg_")+8)
+
+	// Prefixed slugs resolve like any other slug
+	assert.Equal(t, id, ResolveID(slug))
+
+	// The cached slug wins over a later kind
+	assert.Equal(t, slug, FormatTypedID("evt", id))
+	assert.Equal(t, slug, FormatID(id))
+}
+
+func TestFormatTypedID_CollisionLengthening(t *testing.T) {
+	// Setup: use temp dir for config
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+
+	id := "CollisionTestID_AAAAAAAAAAAAAAAA"
+	hash := hex.EncodeToString(sha256Sum(id))
+
+	// Occupy the 8-char slug this ID would normally get
+	slugMu.Lock()
+	slugCache = &config.Slugs{
+		IDToSlug: map[string]string{"other": "msg_" + hash[:8]},
+		SlugToID: map[string]string{"msg_" + hash[:8]: "other"},
+	}
+	slugMu.Unlock()
+
+	slug := FormatTypedID("msg", id)
+	assert.Equal(t, "msg_"+hash[:12], slug, "colliding slug should lengthen the hash prefix")
+	assert.Equal(t, id, ResolveID(slug))
+}
+
+func TestPruneSlugs(t *testing.T) {
+	// Setup: use temp dir for config
+	origHome := os.Getenv("HOME")
+	tmpDir := t.TempDir()
+	os.Setenv("HOME", tmpDir)
+	defer os.Setenv("HOME", origHome)
+
+	now := time.Now().Unix()
+	slugMu.Lock()
+	slugCache = &config.Slugs{
+		IDToSlug: map[string]string{"fresh-id": "aaaa0000", "stale-id": "bbbb0000", "legacy-id": "cccc0000"},
+		SlugToID: map[string]string{"aaaa0000": "fresh-id", "bbbb0000": "stale-id", "cccc0000": "legacy-id"},
+		SeenAt: map[string]int64{
+			"aaaa0000": now,
+			"bbbb0000": now - 60*24*3600,
+			// cccc0000 predates timestamp tracking
+		},
+	}
+	slugMu.Unlock()
+
+	removed, err := PruneSlugs(30 * 24 * time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	assert.Equal(t, "fresh-id", ResolveID("aaaa0000"))
+	assert.Equal(t, "bbbb0000", ResolveID("bbbb0000"), "pruned slug should no longer resolve")
+	assert.Equal(t, "cccc0000", ResolveID("cccc0000"), "untracked slug should be pruned")
+}
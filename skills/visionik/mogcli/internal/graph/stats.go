@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// stats accumulates per-invocation request metrics. Counters are atomic
+// so concurrent requests (paged fetches) tally correctly.
+var stats struct {
+	calls     int64
+	bytes     int64
+	throttles int64
+	errors    int64
+	totalNS   int64
+}
+
+// StatsSummary returns a one-line summary of the requests made so far,
+// for printing after a command when --stats is set.
+func StatsSummary() string {
+	total := time.Duration(atomic.LoadInt64(&stats.totalNS))
+	return fmt.Sprintf("graph: %d 次请求, %d 字节, %d 次限流, %d 次错误, 总耗时 %s",
+		atomic.LoadInt64(&stats.calls),
+		atomic.LoadInt64(&stats.bytes),
+		atomic.LoadInt64(&stats.throttles),
+		atomic.LoadInt64(&stats.errors),
+		total.Round(time.Millisecond))
+}
+
+// statsTransport wraps a RoundTripper and records timing, byte counts
+// and throttle responses for every Graph request.
+type statsTransport struct {
+	base http.RoundTripper
+}
+
+// newStatsTransport returns the instrumented transport used by all
+// Graph clients.
+func newStatsTransport() http.RoundTripper {
+	return &statsTransport{base: http.DefaultTransport}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *statsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	atomic.AddInt64(&stats.calls, 1)
+	atomic.AddInt64(&stats.totalNS, int64(time.Since(start)))
+
+	if err != nil {
+		atomic.AddInt64(&stats.errors, 1)
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		atomic.AddInt64(&stats.throttles, 1)
+	}
+	if resp.StatusCode >= 400 {
+		atomic.AddInt64(&stats.errors, 1)
+	}
+
+	// Count response bytes as the caller reads them.
+	resp.Body = &countingBody{body: resp.Body}
+	return resp, nil
+}
+
+// countingBody tallies bytes read from a response body.
+type countingBody struct {
+	body io.ReadCloser
+}
+
+// Read implements io.Reader.
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	atomic.AddInt64(&stats.bytes, int64(n))
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *countingBody) Close() error {
+	return b.body.Close()
+}
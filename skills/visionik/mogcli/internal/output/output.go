@@ -0,0 +1,119 @@
+// Package output renders command results in human or machine readable
+// form: padded column tables for terminals, stable tab-separated values
+// for --plain, and JSON / Go templates for scripting.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// Table accumulates rows and renders them either as a padded table for
+// humans or as TSV with a header row for machines.
+type Table struct {
+	headers []string
+	rows    [][]string
+}
+
+// NewTable creates a table with the given column headers.
+func NewTable(headers ...string) *Table {
+	return &Table{headers: headers}
+}
+
+// Row appends a row. Missing cells render empty; extra cells are kept.
+func (t *Table) Row(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// Render writes the table. In plain mode the output is one header line
+// and one TSV line per row — no padding, no colors, no decoration — so
+// the format stays parseable by cut/awk regardless of cell contents.
+func (t *Table) Render(w io.Writer, plain bool) error {
+	if plain {
+		if _, err := fmt.Fprintln(w, strings.Join(t.headers, "\t")); err != nil {
+			return err
+		}
+		for _, row := range t.rows {
+			cells := make([]string, len(row))
+			for i, cell := range row {
+				cells[i] = sanitizeCell(cell)
+			}
+			if _, err := fmt.Fprintln(w, strings.Join(cells, "\t")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	widths := make([]int, len(t.headers))
+	for i, h := range t.headers {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+	for _, row := range t.rows {
+		for i, cell := range row {
+			if i < len(widths) && utf8.RuneCountInString(cell) > widths[i] {
+				widths[i] = utf8.RuneCountInString(cell)
+			}
+		}
+	}
+
+	if err := writePadded(w, t.headers, widths); err != nil {
+		return err
+	}
+	for _, row := range t.rows {
+		if err := writePadded(w, row, widths); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writePadded writes one row with two-space column separation.
+func writePadded(w io.Writer, cells []string, widths []int) error {
+	var b strings.Builder
+	for i, cell := range cells {
+		if i > 0 {
+			b.WriteString("  ")
+		}
+		b.WriteString(cell)
+		if i < len(cells)-1 && i < len(widths) {
+			if pad := widths[i] - utf8.RuneCountInString(cell); pad > 0 {
+				b.WriteString(strings.Repeat(" ", pad))
+			}
+		}
+	}
+	_, err := fmt.Fprintln(w, b.String())
+	return err
+}
+
+// sanitizeCell keeps TSV rows one-line-per-record by replacing tabs and
+// newlines inside cell values with spaces.
+func sanitizeCell(cell string) string {
+	cell = strings.ReplaceAll(cell, "\t", " ")
+	cell = strings.ReplaceAll(cell, "\n", " ")
+	return strings.ReplaceAll(cell, "\r", "")
+}
+
+// JSON writes v as indented JSON.
+func JSON(w io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// Template renders v through a text/template source, for callers that
+// want custom line formats.
+func Template(w io.Writer, format string, v interface{}) error {
+	tmpl, err := template.New("output").Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl.Execute(w, v)
+}
@@ -0,0 +1,55 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTable_RenderPlain(t *testing.T) {
+	table := NewTable("id", "name")
+	table.Row("item-1", "first")
+	table.Row("item-2", "has\ttab and\nnewline")
+
+	var b strings.Builder
+	require.NoError(t, table.Render(&b, true))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, "id\tname", lines[0])
+	assert.Equal(t, "item-1\tfirst", lines[1])
+	// Cell contents never break the one-line-per-record contract
+	assert.Equal(t, "item-2\thas tab and newline", lines[2])
+}
+
+func TestTable_RenderHuman(t *testing.T) {
+	table := NewTable("id", "name")
+	table.Row("item-1", "first")
+	table.Row("longer-item-2", "second")
+
+	var b strings.Builder
+	require.NoError(t, table.Render(&b, false))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	// Columns align on the widest cell
+	assert.Equal(t, "id             name", lines[0])
+	assert.Equal(t, "item-1         first", lines[1])
+	assert.Equal(t, "longer-item-2  second", lines[2])
+}
+
+func TestJSON(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, JSON(&b, map[string]string{"id": "item-1"}))
+	assert.Contains(t, b.String(), `"id": "item-1"`)
+}
+
+func TestTemplate(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, Template(&b, "{{.Name}}|{{.ID}}", struct{ Name, ID string }{"first", "item-1"}))
+	assert.Equal(t, "first|item-1", b.String())
+
+	assert.Error(t, Template(&b, "{{.Name", nil))
+}
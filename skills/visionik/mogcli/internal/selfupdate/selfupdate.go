@@ -0,0 +1,177 @@
+// Package selfupdate checks the release feed for newer builds and
+// replaces the running binary atomically after verifying the checksum
+// and, when a signing key is compiled in, the release signature.
+package selfupdate
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultFeedBase is the root of the release feed.
+var DefaultFeedBase = "https://get.visionik.dev"
+
+// PublicKey holds the base64 ed25519 release signing key, injected at
+// build time. Signature verification is skipped when it is empty.
+var PublicKey = ""
+
+// Release describes one published build.
+type Release struct {
+	Version   string `json:"version"`
+	URL       string `json:"url"`
+	SHA256    string `json:"sha256"`
+	Signature string `json:"signature,omitempty"` // base64 ed25519 over the sha256 hex
+}
+
+var httpClient = &http.Client{Timeout: 60 * time.Second}
+
+// FeedURL builds the latest-release URL for a tool and channel.
+func FeedURL(base, name, channel string) string {
+	return fmt.Sprintf("%s/%s/%s/%s-%s/latest.json",
+		strings.TrimRight(base, "/"), name, channel, runtime.GOOS, runtime.GOARCH)
+}
+
+// Check fetches the release feed and returns the latest release.
+func Check(feedURL string) (*Release, error) {
+	resp, err := httpClient.Get(feedURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch release feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("release feed returned %d", resp.StatusCode)
+	}
+
+	var rel Release
+	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
+		return nil, fmt.Errorf("failed to decode release feed: %w", err)
+	}
+	if rel.Version == "" || rel.URL == "" || rel.SHA256 == "" {
+		return nil, fmt.Errorf("release feed is missing version, url, or sha256")
+	}
+	return &rel, nil
+}
+
+// IsNewer reports whether latest is newer than current. "dev" builds
+// always update; dotted numeric versions are compared component-wise.
+func IsNewer(current, latest string) bool {
+	current = strings.TrimPrefix(current, "v")
+	latest = strings.TrimPrefix(latest, "v")
+	if current == latest {
+		return false
+	}
+	if current == "dev" || current == "" {
+		return true
+	}
+
+	cur := strings.Split(current, ".")
+	lat := strings.Split(latest, ".")
+	for i := 0; i < len(cur) || i < len(lat); i++ {
+		var c, l int
+		var cerr, lerr error
+		if i < len(cur) {
+			c, cerr = strconv.Atoi(cur[i])
+		}
+		if i < len(lat) {
+			l, lerr = strconv.Atoi(lat[i])
+		}
+		if cerr != nil || lerr != nil {
+			return true // not comparable; versions differ
+		}
+		if l != c {
+			return l > c
+		}
+	}
+	return false
+}
+
+// Download fetches the release binary and verifies it.
+func Download(rel *Release) ([]byte, error) {
+	resp, err := httpClient.Get(rel.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download release: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("release download returned %d", resp.StatusCode)
+	}
+
+	binary, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read release: %w", err)
+	}
+
+	sum := sha256.Sum256(binary)
+	sumHex := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(sumHex, rel.SHA256) {
+		return nil, fmt.Errorf("checksum mismatch: got %s, want %s", sumHex, rel.SHA256)
+	}
+
+	if PublicKey != "" {
+		if err := verifySignature(sumHex, rel.Signature); err != nil {
+			return nil, err
+		}
+	}
+
+	return binary, nil
+}
+
+// verifySignature checks the ed25519 signature over the checksum hex.
+func verifySignature(sumHex, signature string) error {
+	if signature == "" {
+		return fmt.Errorf("release is unsigned but a signing key is configured")
+	}
+	key, err := base64.StdEncoding.DecodeString(PublicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid release signing key")
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid release signature encoding")
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), []byte(sumHex), sig) {
+		return fmt.Errorf("release signature verification failed")
+	}
+	return nil
+}
+
+// Apply atomically replaces the binary at execPath via a same-directory
+// temp file and rename.
+func Apply(binary []byte, execPath string) error {
+	dir := filepath.Dir(execPath)
+	tmp, err := os.CreateTemp(dir, filepath.Base(execPath)+".update-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op after a successful rename
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write update: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close update: %w", err)
+	}
+	if err := os.Chmod(tmpPath, 0o755); err != nil {
+		return fmt.Errorf("failed to set permissions: %w", err)
+	}
+	if err := os.Rename(tmpPath, execPath); err != nil {
+		return fmt.Errorf("failed to replace binary: %w", err)
+	}
+	return nil
+}
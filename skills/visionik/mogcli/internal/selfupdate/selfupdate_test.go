@@ -0,0 +1,126 @@
+package selfupdate
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNewer(t *testing.T) {
+	tests := []struct {
+		name            string
+		current, latest string
+		want            bool
+	}{
+		{"equal", "1.2.3", "1.2.3", false},
+		{"equal with v prefix", "v1.2.3", "1.2.3", false},
+		{"dev always updates", "dev", "0.0.1", true},
+		{"empty always updates", "", "0.0.1", true},
+		{"patch bump", "1.2.3", "1.2.4", true},
+		{"minor bump", "1.2.9", "1.3.0", true},
+		{"major bump", "1.9.9", "2.0.0", true},
+		{"older latest", "1.3.0", "1.2.9", false},
+		{"shorter equal", "1.2", "1.2.0", false},
+		{"shorter older", "1.2", "1.2.1", true},
+		{"pre-release differs", "1.2.3-rc1", "1.2.3", true},
+		{"non-numeric differs", "nightly-42", "nightly-43", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsNewer(tt.current, tt.latest))
+		})
+	}
+}
+
+// withSigningKey installs a fresh release key for the test and returns a
+// signer over the checksum hex.
+func withSigningKey(t *testing.T) func(sumHex string) string {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	old := PublicKey
+	PublicKey = base64.StdEncoding.EncodeToString(pub)
+	t.Cleanup(func() { PublicKey = old })
+	return func(sumHex string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(sumHex)))
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	sign := withSigningKey(t)
+	sumHex := hex.EncodeToString([]byte("digest"))
+
+	assert.NoError(t, verifySignature(sumHex, sign(sumHex)))
+
+	err := verifySignature(sumHex, sign("something else"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature verification failed")
+
+	err = verifySignature(sumHex, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsigned")
+
+	err = verifySignature(sumHex, "%%%")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid release signature encoding")
+}
+
+func TestVerifySignatureInvalidKey(t *testing.T) {
+	old := PublicKey
+	PublicKey = base64.StdEncoding.EncodeToString([]byte("too short"))
+	defer func() { PublicKey = old }()
+
+	err := verifySignature("aa", base64.StdEncoding.EncodeToString([]byte("sig")))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid release signing key")
+}
+
+// serveBinary returns a test server handing out body and the release
+// checksum for it.
+func serveBinary(t *testing.T, body []byte) (*httptest.Server, string) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	sum := sha256.Sum256(body)
+	return srv, hex.EncodeToString(sum[:])
+}
+
+func TestDownloadVerifiesChecksum(t *testing.T) {
+	binary := []byte("mog-binary")
+	srv, sumHex := serveBinary(t, binary)
+
+	got, err := Download(&Release{Version: "1.0.0", URL: srv.URL, SHA256: sumHex})
+	require.NoError(t, err)
+	assert.Equal(t, binary, got)
+
+	badSum := sha256.Sum256([]byte("expected something else"))
+	_, err = Download(&Release{Version: "1.0.0", URL: srv.URL, SHA256: hex.EncodeToString(badSum[:])})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+}
+
+func TestDownloadEnforcesSignature(t *testing.T) {
+	sign := withSigningKey(t)
+	binary := []byte("mog-binary")
+	srv, sumHex := serveBinary(t, binary)
+
+	rel := &Release{Version: "1.0.0", URL: srv.URL, SHA256: sumHex, Signature: sign(sumHex)}
+	_, err := Download(rel)
+	require.NoError(t, err)
+
+	// With a key compiled in, an unsigned release must not be accepted.
+	rel.Signature = ""
+	_, err = Download(rel)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsigned")
+}
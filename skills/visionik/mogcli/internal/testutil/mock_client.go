@@ -8,12 +8,16 @@ import (
 
 // MockClient implements graph.Client for testing.
 type MockClient struct {
-	GetFunc     func(ctx context.Context, path string, query url.Values) ([]byte, error)
-	PostFunc    func(ctx context.Context, path string, body interface{}) ([]byte, error)
-	PatchFunc   func(ctx context.Context, path string, body interface{}) ([]byte, error)
-	DeleteFunc  func(ctx context.Context, path string) error
-	PostHTMLFunc func(ctx context.Context, path string, html string) ([]byte, error)
-	PutFunc     func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error)
+	GetFunc             func(ctx context.Context, path string, query url.Values) ([]byte, error)
+	PostFunc            func(ctx context.Context, path string, body interface{}) ([]byte, error)
+	PatchFunc           func(ctx context.Context, path string, body interface{}) ([]byte, error)
+	DeleteFunc          func(ctx context.Context, path string) error
+	PostHTMLFunc        func(ctx context.Context, path string, html string) ([]byte, error)
+	PutFunc             func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error)
+	PostRawFunc         func(ctx context.Context, path string, data []byte, contentType string) ([]byte, error)
+	PostForLocationFunc func(ctx context.Context, path string, body interface{}) (string, error)
+	PutMatchFunc        func(ctx context.Context, path string, data []byte, contentType, etag string) ([]byte, error)
+	PatchMatchFunc      func(ctx context.Context, path string, body interface{}, etag string) ([]byte, error)
 }
 
 // Get implements graph.Client.Get.
@@ -63,3 +67,35 @@ func (m *MockClient) Put(ctx context.Context, path string, data []byte, contentT
 	}
 	return nil, nil
 }
+
+// PostRaw implements graph.Client.PostRaw.
+func (m *MockClient) PostRaw(ctx context.Context, path string, data []byte, contentType string) ([]byte, error) {
+	if m.PostRawFunc != nil {
+		return m.PostRawFunc(ctx, path, data, contentType)
+	}
+	return nil, nil
+}
+
+// PutMatch implements graph.Client.PutMatch.
+func (m *MockClient) PutMatch(ctx context.Context, path string, data []byte, contentType, etag string) ([]byte, error) {
+	if m.PutMatchFunc != nil {
+		return m.PutMatchFunc(ctx, path, data, contentType, etag)
+	}
+	return nil, nil
+}
+
+// PatchMatch implements graph.Client.PatchMatch.
+func (m *MockClient) PatchMatch(ctx context.Context, path string, body interface{}, etag string) ([]byte, error) {
+	if m.PatchMatchFunc != nil {
+		return m.PatchMatchFunc(ctx, path, body, etag)
+	}
+	return nil, nil
+}
+
+// PostForLocation implements graph.Client.PostForLocation.
+func (m *MockClient) PostForLocation(ctx context.Context, path string, body interface{}) (string, error) {
+	if m.PostForLocationFunc != nil {
+		return m.PostForLocationFunc(ctx, path, body)
+	}
+	return "", nil
+}
@@ -24,11 +24,17 @@ func main() {
 		}
 	}
 
+	cli.BuildVersion = version
+
+	// 档案影响配置加载本身, 需要在解析前确定
+	cli.ApplyProfileFromArgs(os.Args[1:])
+
 	var root cli.Root
 	ctx := kong.Parse(&root,
 		kong.Name("sog"),
 		kong.Description("标准运维工具 — IMAP/SMTP/CalDAV/CardDAV/WebDAV 命令行工具"),
 		kong.UsageOnError(),
+		kong.Resolvers(cli.ConfigDefaultsResolver()),
 		kong.Vars{
 			"version": version,
 		},
@@ -4,10 +4,12 @@ package caldav
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 	"time"
 
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
+
 	"github.com/emersion/go-ical"
 	"github.com/emersion/go-webdav"
 	"github.com/emersion/go-webdav/caldav"
@@ -51,6 +53,17 @@ const (
 	TaskStatusCancelled   = "CANCELLED"
 )
 
+// Journal represents a VJOURNAL note.
+type Journal struct {
+	UID          string    `json:"uid"`
+	Summary      string    `json:"summary"`
+	Description  string    `json:"description,omitempty"`
+	Created      time.Time `json:"created,omitempty"`
+	LastModified time.Time `json:"last_modified,omitempty"`
+	Categories   []string  `json:"categories,omitempty"`
+	ETag         string    `json:"etag,omitempty"`
+}
+
 // Event represents a calendar event.
 type Event struct {
 	UID         string    `json:"uid"`
@@ -77,7 +90,7 @@ type Calendar struct {
 
 // Connect establishes a connection to a CalDAV server.
 func Connect(cfg Config) (*Client, error) {
-	httpClient := webdav.HTTPClientWithBasicAuth(http.DefaultClient, cfg.Email, cfg.Password)
+	httpClient := webdav.HTTPClientWithBasicAuth(retry.HTTPClient(log.HTTPClient(nil, "CalDAV")), cfg.Email, cfg.Password)
 
 	client, err := caldav.NewClient(httpClient, cfg.URL)
 	if err != nil {
@@ -366,6 +379,98 @@ func (c *Client) findTaskByUID(ctx context.Context, calPath, uid string) (*Task,
 	return nil, fmt.Errorf("task not found: %s", uid)
 }
 
+// Journals (notes)
+
+// ListJournals retrieves journal entries (notes) from a calendar.
+func (c *Client) ListJournals(ctx context.Context, calPath string) ([]Journal, error) {
+	query := &caldav.CalendarQuery{
+		CompRequest: caldav.CalendarCompRequest{
+			Name: "VCALENDAR",
+			Comps: []caldav.CalendarCompRequest{{
+				Name: "VJOURNAL",
+				Props: []string{
+					"UID", "SUMMARY", "DESCRIPTION", "CREATED",
+					"LAST-MODIFIED", "CATEGORIES",
+				},
+			}},
+		},
+		CompFilter: caldav.CompFilter{
+			Name: "VCALENDAR",
+			Comps: []caldav.CompFilter{{
+				Name: "VJOURNAL",
+			}},
+		},
+	}
+
+	objects, err := c.client.QueryCalendar(ctx, calPath, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query journals: %w", err)
+	}
+
+	journals := make([]Journal, 0, len(objects))
+	for _, obj := range objects {
+		journal, err := parseICalJournal(obj.Data)
+		if err != nil {
+			continue
+		}
+		journal.ETag = obj.ETag
+		journals = append(journals, *journal)
+	}
+
+	return journals, nil
+}
+
+// GetJournal retrieves a single journal entry by UID.
+func (c *Client) GetJournal(ctx context.Context, calPath, uid string) (*Journal, error) {
+	// Use findJournalByUID which iterates through all entries
+	// This is more compatible with various CalDAV servers
+	return c.findJournalByUID(ctx, calPath, uid)
+}
+
+// CreateJournal creates a new journal entry.
+func (c *Client) CreateJournal(ctx context.Context, calPath string, journal *Journal) error {
+	cal := createICalJournal(journal)
+	_, err := c.client.PutCalendarObject(ctx, calPath+"/"+journal.UID+".ics", cal)
+	if err != nil {
+		return fmt.Errorf("failed to create journal: %w", err)
+	}
+	return nil
+}
+
+// UpdateJournal updates an existing journal entry.
+func (c *Client) UpdateJournal(ctx context.Context, calPath string, journal *Journal) error {
+	cal := createICalJournal(journal)
+	_, err := c.client.PutCalendarObject(ctx, calPath+"/"+journal.UID+".ics", cal)
+	if err != nil {
+		return fmt.Errorf("failed to update journal: %w", err)
+	}
+	return nil
+}
+
+// DeleteJournal deletes a journal entry.
+func (c *Client) DeleteJournal(ctx context.Context, calPath, uid string) error {
+	err := c.client.RemoveAll(ctx, calPath+"/"+uid+".ics")
+	if err != nil {
+		return fmt.Errorf("failed to delete journal: %w", err)
+	}
+	return nil
+}
+
+// findJournalByUID finds a journal entry by iterating through all entries.
+// This is a workaround for servers that don't support PropFilter well.
+func (c *Client) findJournalByUID(ctx context.Context, calPath, uid string) (*Journal, error) {
+	journals, err := c.ListJournals(ctx, calPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, j := range journals {
+		if j.UID == uid {
+			return &j, nil
+		}
+	}
+	return nil, fmt.Errorf("journal not found: %s", uid)
+}
+
 // parseICalEvent parses an iCalendar VEVENT into an Event.
 func parseICalEvent(cal *ical.Calendar) (*Event, error) {
 	if cal == nil {
@@ -657,3 +762,97 @@ func createICalTask(task *Task) *ical.Calendar {
 	cal.Children = append(cal.Children, vtodo)
 	return cal
 }
+
+// parseICalJournal parses an iCalendar VJOURNAL into a Journal.
+func parseICalJournal(cal *ical.Calendar) (*Journal, error) {
+	if cal == nil {
+		return nil, fmt.Errorf("nil calendar data")
+	}
+
+	for _, child := range cal.Children {
+		if child.Name != ical.CompJournal {
+			continue
+		}
+
+		journal := &Journal{}
+
+		// UID
+		if prop := child.Props.Get(ical.PropUID); prop != nil {
+			journal.UID = prop.Value
+		}
+
+		// Summary
+		if prop := child.Props.Get(ical.PropSummary); prop != nil {
+			journal.Summary = prop.Value
+		}
+
+		// Description
+		if prop := child.Props.Get(ical.PropDescription); prop != nil {
+			journal.Description = prop.Value
+		}
+
+		// Created
+		if prop := child.Props.Get(ical.PropCreated); prop != nil {
+			t, err := prop.DateTime(time.Local)
+			if err == nil {
+				journal.Created = t
+			}
+		}
+
+		// Last modified
+		if prop := child.Props.Get(ical.PropLastModified); prop != nil {
+			t, err := prop.DateTime(time.Local)
+			if err == nil {
+				journal.LastModified = t
+			}
+		}
+
+		// Categories
+		if prop := child.Props.Get(ical.PropCategories); prop != nil {
+			journal.Categories = strings.Split(prop.Value, ",")
+		}
+
+		return journal, nil
+	}
+
+	return nil, fmt.Errorf("no VJOURNAL found in calendar data")
+}
+
+// createICalJournal creates an iCalendar from a Journal.
+func createICalJournal(journal *Journal) *ical.Calendar {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText(ical.PropProductID, "-//sog//CalDAV Client//EN")
+
+	vjournal := ical.NewComponent(ical.CompJournal)
+	vjournal.Props.SetText(ical.PropUID, journal.UID)
+	vjournal.Props.SetText(ical.PropSummary, journal.Summary)
+
+	if journal.Description != "" {
+		vjournal.Props.SetText(ical.PropDescription, journal.Description)
+	}
+
+	if !journal.Created.IsZero() {
+		createdProp := ical.NewProp(ical.PropCreated)
+		createdProp.SetDateTime(journal.Created.UTC())
+		vjournal.Props.Set(createdProp)
+	}
+
+	// Last modified
+	lastModProp := ical.NewProp(ical.PropLastModified)
+	lastModProp.SetDateTime(time.Now().UTC())
+	vjournal.Props.Set(lastModProp)
+
+	// Categories
+	if len(journal.Categories) > 0 {
+		vjournal.Props.SetText(ical.PropCategories, strings.Join(journal.Categories, ","))
+	}
+
+	// DTSTAMP is required
+	dtstamp := ical.NewProp(ical.PropDateTimeStamp)
+	dtstamp.SetDateTime(time.Now().UTC())
+	vjournal.Props.Set(dtstamp)
+
+	cal.Children = append(cal.Children, vjournal)
+	return cal
+}
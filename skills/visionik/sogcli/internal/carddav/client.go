@@ -3,10 +3,15 @@ package carddav
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
+
 	"github.com/emersion/go-vcard"
 	"github.com/emersion/go-webdav"
 	"github.com/emersion/go-webdav/carddav"
@@ -14,9 +19,10 @@ import (
 
 // Client wraps a CardDAV client with convenience methods.
 type Client struct {
-	client *carddav.Client
-	email  string
-	url    string
+	client     *carddav.Client
+	httpClient webdav.HTTPClient
+	email      string
+	url        string
 }
 
 // Config holds CardDAV connection configuration.
@@ -52,7 +58,7 @@ type AddressBook struct {
 
 // Connect establishes a connection to a CardDAV server.
 func Connect(cfg Config) (*Client, error) {
-	httpClient := webdav.HTTPClientWithBasicAuth(http.DefaultClient, cfg.Email, cfg.Password)
+	httpClient := webdav.HTTPClientWithBasicAuth(retry.HTTPClient(log.HTTPClient(nil, "CardDAV")), cfg.Email, cfg.Password)
 
 	client, err := carddav.NewClient(httpClient, cfg.URL)
 	if err != nil {
@@ -60,9 +66,10 @@ func Connect(cfg Config) (*Client, error) {
 	}
 
 	return &Client{
-		client: client,
-		email:  cfg.Email,
-		url:    cfg.URL,
+		client:     client,
+		httpClient: httpClient,
+		email:      cfg.Email,
+		url:        cfg.URL,
 	}, nil
 }
 
@@ -362,3 +369,76 @@ func createVCard(contact *Contact) vcard.Card {
 
 	return card
 }
+
+// CreateAddressBook provisions a new address book collection under the
+// address book home set using extended MKCOL (RFC 5689 with the CardDAV
+// addressbook resource type). Returns the path of the new collection.
+func (c *Client) CreateAddressBook(ctx context.Context, name, description string) (string, error) {
+	principal, err := c.client.FindCurrentUserPrincipal(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to find principal: %w", err)
+	}
+	homeSet, err := c.client.FindAddressBookHomeSet(ctx, principal)
+	if err != nil {
+		return "", fmt.Errorf("failed to find address book home set: %w", err)
+	}
+
+	base, err := url.Parse(c.url)
+	if err != nil {
+		return "", fmt.Errorf("invalid CardDAV URL: %w", err)
+	}
+	ref, err := url.Parse(homeSet)
+	if err != nil {
+		return "", fmt.Errorf("invalid home set path: %w", err)
+	}
+	target := base.ResolveReference(ref)
+	target.Path = strings.TrimRight(target.Path, "/") + "/" + bookSlug(name) + "/"
+
+	var body strings.Builder
+	body.WriteString(xml.Header)
+	body.WriteString(`<D:mkcol xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:carddav"><D:set><D:prop>`)
+	body.WriteString(`<D:resourcetype><D:collection/><C:addressbook/></D:resourcetype>`)
+	body.WriteString(`<D:displayname>`)
+	xml.EscapeText(&body, []byte(name))
+	body.WriteString(`</D:displayname>`)
+	if description != "" {
+		body.WriteString(`<C:addressbook-description>`)
+		xml.EscapeText(&body, []byte(description))
+		body.WriteString(`</C:addressbook-description>`)
+	}
+	body.WriteString(`</D:prop></D:set></D:mkcol>`)
+
+	req, err := http.NewRequestWithContext(ctx, "MKCOL", target.String(), strings.NewReader(body.String()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to create address book: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("failed to create address book: %s", resp.Status)
+	}
+	return target.Path, nil
+}
+
+// bookSlug derives a collection name from a display name.
+func bookSlug(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '_':
+			b.WriteByte('-')
+		}
+	}
+	if b.Len() == 0 {
+		return "addressbook"
+	}
+	return b.String()
+}
@@ -11,11 +11,12 @@ import (
 
 // AuthCmd 处理账户管理。
 type AuthCmd struct {
-	Add      AuthAddCmd      `cmd:"" help:"添加 IMAP/SMTP 账户"`
-	List     AuthListCmd     `cmd:"" help:"列出已配置的账户"`
-	Test     AuthTestCmd     `cmd:"" help:"测试账户连接"`
-	Remove   AuthRemoveCmd   `cmd:"" help:"移除账户"`
-	Password AuthPasswordCmd `cmd:"" help:"设置协议特定的密码"`
+	Add              AuthAddCmd              `cmd:"" help:"添加 IMAP/SMTP 账户"`
+	List             AuthListCmd             `cmd:"" help:"列出已配置的账户"`
+	Test             AuthTestCmd             `cmd:"" help:"测试账户连接"`
+	Remove           AuthRemoveCmd           `cmd:"" help:"移除账户"`
+	Password         AuthPasswordCmd         `cmd:"" help:"设置协议特定的密码"`
+	CredentialHelper AuthCredentialHelperCmd `cmd:"" name:"credential-helper" help:"以 git-credential 协议对外提供凭据"`
 }
 
 // AuthAddCmd 添加新账户。
@@ -28,6 +29,7 @@ type AuthAddCmd struct {
 	CalDAVURL  string `help:"CalDAV 服务器 URL (例如: https://caldav.example.com/)" name:"caldav-url"`
 	CardDAVURL string `help:"CardDAV 服务器 URL (例如: https://carddav.example.com/)" name:"carddav-url"`
 	WebDAVURL  string `help:"WebDAV 服务器 URL (例如: https://webdav.example.com/)" name:"webdav-url"`
+	JMAPURL    string `help:"JMAP 会话 URL (例如: https://api.fastmail.com/jmap/session)" name:"jmap-url"`
 	Password   string `help:"密码 (如果未提供将提示输入)"`
 	Discover   bool   `help:"从 DNS 自动发现服务器"`
 	Insecure   bool   `help:"跳过 TLS 证书验证"`
@@ -110,6 +112,9 @@ func (c *AuthAddCmd) Run(root *Root) error {
 		WebDAV: config.WebDAVConfig{
 			URL: c.WebDAVURL,
 		},
+		JMAP: config.JMAPConfig{
+			URL: c.JMAPURL,
+		},
 	}
 
 	if err := cfg.AddAccount(acct, c.Password); err != nil {
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/visionik/sogcli/internal/config"
+)
+
+// AuthCredentialHelperCmd 以 git-credential 协议对外提供凭据。
+// 其他程序 (msmtp、vdirsyncer、davfs2 等) 可以把 sog 配置为凭据助手,
+// 复用 sog 密钥链中管理的密码, 无需在各自的配置里再存一份明文。
+type AuthCredentialHelperCmd struct {
+	Action string `arg:"" enum:"get,store,erase" help:"git-credential 操作: get, store 或 erase"`
+}
+
+// Run 执行 auth credential-helper 命令。
+func (c *AuthCredentialHelperCmd) Run(root *Root) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	attrs, err := readCredentialAttrs(os.Stdin)
+	if err != nil {
+		return fmt.Errorf("读取凭据请求失败: %w", err)
+	}
+
+	email, protocol, err := matchCredentialAccount(cfg, attrs)
+	if err != nil {
+		return err
+	}
+
+	switch c.Action {
+	case "get":
+		password, err := cfg.GetPasswordForProtocol(email, protocol)
+		if err != nil {
+			return fmt.Errorf("获取密码失败: %w", err)
+		}
+		fmt.Printf("username=%s\n", email)
+		fmt.Printf("password=%s\n", password)
+	case "store":
+		password := attrs["password"]
+		if password == "" {
+			return fmt.Errorf("store 需要 password 属性")
+		}
+		if err := config.SetPasswordForProtocol(email, protocol, password); err != nil {
+			return fmt.Errorf("存储密码失败: %w", err)
+		}
+	case "erase":
+		if err := config.DeletePasswordForProtocol(email, protocol); err != nil {
+			return fmt.Errorf("删除密码失败: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// readCredentialAttrs 从 r 读取 git-credential 格式的 key=value 属性,
+// 直到空行或 EOF 为止。
+func readCredentialAttrs(r io.Reader) (map[string]string, error) {
+	attrs := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("无效的属性行: %q", line)
+		}
+		attrs[key] = value
+	}
+	return attrs, scanner.Err()
+}
+
+// matchCredentialAccount 根据请求的 protocol/host/username 属性找到对应的
+// 账户和协议。host 匹配各账户的 IMAP/SMTP 主机名以及 DAV/JMAP URL 的主机名。
+func matchCredentialAccount(cfg *config.Config, attrs map[string]string) (string, config.Protocol, error) {
+	host := attrs["host"]
+	if host == "" {
+		return "", config.ProtocolDefault, fmt.Errorf("请求缺少 host 属性")
+	}
+	// git-credential 的 host 可能带端口
+	if h, _, ok := strings.Cut(host, ":"); ok {
+		host = h
+	}
+
+	username := attrs["username"]
+
+	for _, acct := range cfg.ListAccounts() {
+		if username != "" && acct.Email != username {
+			continue
+		}
+		protocol, ok := matchAccountHost(acct, attrs["protocol"], host)
+		if !ok {
+			continue
+		}
+		return acct.Email, protocol, nil
+	}
+
+	return "", config.ProtocolDefault, fmt.Errorf("没有账户匹配 host=%s", attrs["host"])
+}
+
+// matchAccountHost 检查 host 是否属于账户的某个服务, 返回对应的协议。
+// reqProtocol 为请求中的 protocol 属性 (可能为空), 用于在同一主机
+// 承载多种服务时消除歧义。
+func matchAccountHost(acct config.Account, reqProtocol, host string) (config.Protocol, bool) {
+	type service struct {
+		protocol config.Protocol
+		host     string
+		schemes  []string
+	}
+	services := []service{
+		{config.ProtocolIMAP, acct.IMAP.Host, []string{"imap", "imaps"}},
+		{config.ProtocolSMTP, acct.SMTP.Host, []string{"smtp", "smtps", "submission"}},
+		{config.ProtocolCalDAV, urlHost(acct.CalDAV.URL), []string{"caldav", "http", "https"}},
+		{config.ProtocolCardDAV, urlHost(acct.CardDAV.URL), []string{"carddav", "http", "https"}},
+		{config.ProtocolWebDAV, urlHost(acct.WebDAV.URL), []string{"webdav", "http", "https"}},
+		{config.ProtocolJMAP, urlHost(acct.JMAP.URL), []string{"jmap", "http", "https"}},
+	}
+
+	for _, svc := range services {
+		if svc.host == "" || svc.host != host {
+			continue
+		}
+		if reqProtocol == "" {
+			return svc.protocol, true
+		}
+		for _, scheme := range svc.schemes {
+			if reqProtocol == scheme {
+				return svc.protocol, true
+			}
+		}
+	}
+	return config.ProtocolDefault, false
+}
+
+// urlHost 提取 URL 的主机名 (不含端口), 解析失败返回空字符串。
+func urlHost(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
@@ -0,0 +1,354 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/visionik/sogcli/internal/caldav"
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+	"github.com/visionik/sogcli/internal/itip"
+	"github.com/visionik/sogcli/internal/jmap"
+)
+
+// BriefCmd 生成每日简报：今天的日程、未读邮件统计、
+// 到期/逾期任务以及待处理的会议邀请。
+// 输出设计为可直接通过管道传给 LLM 或发布到聊天工具。
+type BriefCmd struct {
+	Format string `help:"输出格式: text, markdown (或使用全局 --json)" default:"text" enum:"text,markdown"`
+	Folder string `help:"要检查的邮件文件夹" default:"INBOX"`
+}
+
+// briefReport 是每日简报的完整内容。
+type briefReport struct {
+	Date         string            `json:"date"`
+	Events       []caldav.Event    `json:"events"`
+	UnreadCount  int               `json:"unread_count"`
+	TopSenders   []briefSender     `json:"top_senders,omitempty"`
+	TasksDue     []caldav.Task     `json:"tasks_due,omitempty"`
+	TasksOverdue []caldav.Task     `json:"tasks_overdue,omitempty"`
+	Invites      []briefInvitation `json:"pending_invites,omitempty"`
+}
+
+// briefSender 是未读邮件的发件人统计。
+type briefSender struct {
+	From  string `json:"from"`
+	Count int    `json:"count"`
+}
+
+// briefInvitation 是待回复的会议邀请。
+type briefInvitation struct {
+	UID       string `json:"uid"`
+	Summary   string `json:"summary"`
+	Organizer string `json:"organizer,omitempty"`
+	Start     string `json:"start,omitempty"`
+	MailUID   uint32 `json:"mail_uid"`
+}
+
+// Run 执行每日简报命令。
+func (c *BriefCmd) Run(root *Root) error {
+	now := time.Now()
+	report := briefReport{Date: now.Format("2006-01-02")}
+
+	// 各部分独立收集; 单个后端失败只产生警告
+	if err := c.collectCalendar(root, now, &report); err != nil {
+		fmt.Fprintf(os.Stderr, "警告: 日历部分失败: %v\n", err)
+	}
+	if err := c.collectMail(root, &report); err != nil {
+		fmt.Fprintf(os.Stderr, "警告: 邮件部分失败: %v\n", err)
+	}
+	if err := c.collectTasks(root, now, &report); err != nil {
+		fmt.Fprintf(os.Stderr, "警告: 任务部分失败: %v\n", err)
+	}
+
+	if root.JSON {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if c.Format == "markdown" {
+		printBriefMarkdown(&report)
+	} else {
+		printBriefText(&report)
+	}
+	return nil
+}
+
+// collectCalendar 收集今天的日程。
+func (c *BriefCmd) collectCalendar(root *Root, now time.Time, report *briefReport) error {
+	client, calPath, err := getCalDAVClient(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	ctx := context.Background()
+	events, err := client.ListEvents(ctx, calPath, dayStart, dayEnd)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	report.Events = events
+	return nil
+}
+
+// collectMail 收集未读邮件统计和待处理的邀请。
+func (c *BriefCmd) collectMail(root *Root, report *briefReport) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return fmt.Errorf("未指定账户")
+	}
+
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return err
+	}
+
+	// JMAP 账户只统计未读数量
+	if acct.JMAP.URL != "" {
+		client, err := connectJMAP(cfg, email, acct)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		messages, err := client.ListMessages(c.Folder, 200, true)
+		if err != nil {
+			return err
+		}
+		report.UnreadCount = len(messages)
+		report.TopSenders = topSenders(jmapSenders(messages), 5)
+		return nil
+	}
+
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("连接失败: %w", err)
+	}
+	defer client.Close()
+
+	messages, err := client.ListMessages(c.Folder, 200, true)
+	if err != nil {
+		return err
+	}
+	report.UnreadCount = len(messages)
+	var senders []string
+	for _, m := range messages {
+		senders = append(senders, m.From)
+	}
+	report.TopSenders = topSenders(senders, 5)
+
+	// 待处理邀请: 未读邮件中包含 METHOD:REQUEST 的日历附件
+	hits, err := client.SearchMessages(c.Folder, "UNSEEN TEXT METHOD:REQUEST", 20)
+	if err != nil {
+		return nil // 邀请扫描失败不影响其余简报
+	}
+	for _, hit := range hits {
+		raw, err := client.GetMessage(c.Folder, hit.UID, false)
+		if err != nil {
+			continue
+		}
+		calData := itip.ExtractCalendar([]byte(raw.Body))
+		if calData == nil {
+			continue
+		}
+		inv, err := itip.ParseInvite(calData)
+		if err != nil || inv.Method != itip.MethodRequest {
+			continue
+		}
+		invite := briefInvitation{
+			UID:       inv.UID,
+			Summary:   inv.Summary,
+			Organizer: inv.Organizer.Email,
+			MailUID:   hit.UID,
+		}
+		if !inv.Start.IsZero() {
+			invite.Start = inv.Start.Format("2006-01-02 15:04")
+		}
+		report.Invites = append(report.Invites, invite)
+	}
+	return nil
+}
+
+// collectTasks 收集今天到期和逾期的任务。
+func (c *BriefCmd) collectTasks(root *Root, now time.Time, report *briefReport) error {
+	client, listPath, err := getCalDAVClientForTasks(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	tasks, err := client.ListTasks(ctx, listPath, false)
+	if err != nil {
+		return err
+	}
+
+	dayEnd := time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, now.Location())
+	for _, t := range tasks {
+		if t.Due.IsZero() {
+			continue
+		}
+		if t.Due.Before(now) {
+			report.TasksOverdue = append(report.TasksOverdue, t)
+		} else if !t.Due.After(dayEnd) {
+			report.TasksDue = append(report.TasksDue, t)
+		}
+	}
+	return nil
+}
+
+// jmapSenders 提取 JMAP 邮件列表中的发件人。
+func jmapSenders(messages []jmap.Message) []string {
+	var senders []string
+	for _, m := range messages {
+		senders = append(senders, m.From)
+	}
+	return senders
+}
+
+// topSenders 统计出现次数最多的发件人。
+func topSenders(senders []string, max int) []briefSender {
+	counts := make(map[string]int)
+	for _, s := range senders {
+		if s != "" {
+			counts[s]++
+		}
+	}
+	result := make([]briefSender, 0, len(counts))
+	for from, n := range counts {
+		result = append(result, briefSender{From: from, Count: n})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].From < result[j].From
+	})
+	if max > 0 && len(result) > max {
+		result = result[:max]
+	}
+	return result
+}
+
+// printBriefText 以纯文本形式输出简报。
+func printBriefText(report *briefReport) {
+	fmt.Printf("每日简报 — %s\n\n", report.Date)
+
+	fmt.Printf("今日日程 (%d):\n", len(report.Events))
+	if len(report.Events) == 0 {
+		fmt.Println("  (无事件)")
+	}
+	for _, e := range report.Events {
+		timeStr := "全天"
+		if !e.AllDay {
+			timeStr = e.Start.Format("15:04")
+		}
+		line := fmt.Sprintf("  %-6s %s", timeStr, e.Summary)
+		if e.Location != "" {
+			line += " @ " + e.Location
+		}
+		fmt.Println(line)
+	}
+
+	fmt.Printf("\n未读邮件: %d\n", report.UnreadCount)
+	for _, s := range report.TopSenders {
+		fmt.Printf("  %3d  %s\n", s.Count, s.From)
+	}
+
+	if len(report.TasksOverdue) > 0 {
+		fmt.Printf("\n逾期任务 (%d):\n", len(report.TasksOverdue))
+		for _, t := range report.TasksOverdue {
+			fmt.Printf("  [%s] %s\n", t.Due.Format("01-02"), t.Summary)
+		}
+	}
+	if len(report.TasksDue) > 0 {
+		fmt.Printf("\n今日到期 (%d):\n", len(report.TasksDue))
+		for _, t := range report.TasksDue {
+			fmt.Printf("  %s\n", t.Summary)
+		}
+	}
+
+	if len(report.Invites) > 0 {
+		fmt.Printf("\n待回复邀请 (%d):\n", len(report.Invites))
+		for _, inv := range report.Invites {
+			fmt.Printf("  %s — %s (%s)\n", inv.Start, inv.Summary, inv.Organizer)
+		}
+	}
+}
+
+// printBriefMarkdown 以 Markdown 形式输出简报。
+func printBriefMarkdown(report *briefReport) {
+	fmt.Printf("# 每日简报 — %s\n\n", report.Date)
+
+	fmt.Printf("## 今日日程 (%d)\n\n", len(report.Events))
+	if len(report.Events) == 0 {
+		fmt.Println("无事件。")
+	}
+	for _, e := range report.Events {
+		timeStr := "全天"
+		if !e.AllDay {
+			timeStr = e.Start.Format("15:04")
+		}
+		line := fmt.Sprintf("- **%s** %s", timeStr, e.Summary)
+		if e.Location != "" {
+			line += " _(" + e.Location + ")_"
+		}
+		fmt.Println(line)
+	}
+
+	fmt.Printf("\n## 未读邮件: %d\n\n", report.UnreadCount)
+	for _, s := range report.TopSenders {
+		fmt.Printf("- %s (%d)\n", s.From, s.Count)
+	}
+
+	if len(report.TasksOverdue) > 0 || len(report.TasksDue) > 0 {
+		fmt.Printf("\n## 任务\n\n")
+		for _, t := range report.TasksOverdue {
+			fmt.Printf("- [ ] **逾期 %s** %s\n", t.Due.Format("01-02"), t.Summary)
+		}
+		for _, t := range report.TasksDue {
+			fmt.Printf("- [ ] 今日到期: %s\n", t.Summary)
+		}
+	}
+
+	if len(report.Invites) > 0 {
+		fmt.Printf("\n## 待回复邀请\n\n")
+		for _, inv := range report.Invites {
+			fmt.Printf("- %s — %s (%s)\n", inv.Start, inv.Summary, inv.Organizer)
+		}
+	}
+}
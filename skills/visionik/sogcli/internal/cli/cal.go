@@ -17,6 +17,7 @@ type CalCmd struct {
 	Search    CalSearchCmd    `cmd:"" help:"搜索日历事件"`
 	Today     CalTodayCmd     `cmd:"" help:"今日事件"`
 	Week      CalWeekCmd      `cmd:"" help:"本周事件"`
+	Month     CalMonthCmd     `cmd:"" help:"月历网格视图"`
 	Create    CalCreateCmd    `cmd:"" help:"创建日历事件"`
 	Update    CalUpdateCmd    `cmd:"" help:"更新日历事件"`
 	Delete    CalDeleteCmd    `cmd:"" help:"删除日历事件"`
@@ -101,10 +102,36 @@ func (c *CalTodayCmd) Run(root *Root) error {
 // CalWeekCmd 列出本周事件
 type CalWeekCmd struct {
 	Calendar string `arg:"" optional:"" help:"日历路径（默认：primary）"`
+	Grid     bool   `help:"以按小时分块的网格视图显示"`
 }
 
 // Run 执行本周事件命令
 func (c *CalWeekCmd) Run(root *Root) error {
+	// 网格视图直接获取事件并渲染
+	if c.Grid {
+		client, calPath, err := getCalDAVClient(root)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		if c.Calendar != "" {
+			calPath = c.Calendar
+		}
+
+		start, _ := parseDate("today")
+		end, _ := parseDate("+7d")
+
+		ctx := context.Background()
+		events, err := client.ListEvents(ctx, calPath, start, end)
+		if err != nil {
+			return fmt.Errorf("列出事件失败: %w", err)
+		}
+
+		fmt.Print(renderWeekGrid(start, events))
+		return nil
+	}
+
 	// 创建并执行CalListCmd命令，设置时间范围为今天到7天后
 	cmd := &CalListCmd{
 		Calendar: c.Calendar,
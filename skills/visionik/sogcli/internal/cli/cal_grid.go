@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/caldav"
+)
+
+// CalMonthCmd 以月历网格显示每天的事件数量。
+type CalMonthCmd struct {
+	Calendar string `arg:"" optional:"" help:"日历路径（默认：primary）"`
+	Month    string `help:"要显示的月份 (YYYY-MM, 默认当月)"`
+}
+
+// Run 执行月历视图命令
+func (c *CalMonthCmd) Run(root *Root) error {
+	// 解析目标月份
+	month := time.Now()
+	if c.Month != "" {
+		var err error
+		month, err = time.Parse("2006-01", c.Month)
+		if err != nil {
+			return fmt.Errorf("无效的 --month (应为 YYYY-MM): %w", err)
+		}
+	}
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+	next := first.AddDate(0, 1, 0)
+
+	// 获取CalDAV客户端和默认日历路径
+	client, calPath, err := getCalDAVClient(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.Calendar != "" {
+		calPath = c.Calendar
+	}
+
+	ctx := context.Background()
+	events, err := client.ListEvents(ctx, calPath, first, next)
+	if err != nil {
+		return fmt.Errorf("列出事件失败: %w", err)
+	}
+
+	// 统计每天的事件数量
+	counts := make(map[int]int)
+	for _, e := range events {
+		day := e.Start.In(time.Local)
+		if day.Month() == first.Month() && day.Year() == first.Year() {
+			counts[day.Day()]++
+		}
+	}
+
+	fmt.Print(renderMonthGrid(first, counts, time.Now()))
+	return nil
+}
+
+// renderMonthGrid 渲染月历网格, 每天标注事件数量 (*N),
+// 今天以方括号标出。
+func renderMonthGrid(first time.Time, counts map[int]int, now time.Time) string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("        %s\n", first.Format("2006-01")))
+	for _, h := range []string{"一", "二", "三", "四", "五", "六", "日"} {
+		b.WriteString("    " + h)
+	}
+	b.WriteString("\n")
+
+	// 周一作为每周的第一天
+	offset := (int(first.Weekday()) + 6) % 7
+	for i := 0; i < offset; i++ {
+		b.WriteString("      ")
+	}
+
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+	isThisMonth := now.Year() == first.Year() && now.Month() == first.Month()
+
+	for day := 1; day <= daysInMonth; day++ {
+		num := fmt.Sprintf("%d", day)
+		if isThisMonth && day == now.Day() {
+			num = "[" + num + "]"
+		}
+
+		suffix := "  "
+		if n := counts[day]; n > 9 {
+			suffix = "*+"
+		} else if n > 0 {
+			suffix = fmt.Sprintf("*%d", n)
+		}
+
+		b.WriteString(fmt.Sprintf("%4s%s", num, suffix))
+		if (offset+day)%7 == 0 {
+			b.WriteString("\n")
+		}
+	}
+	if (offset+daysInMonth)%7 != 0 {
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// renderWeekGrid 渲染按小时分块的一周视图。行是小时, 列是
+// 从 start 开始的七天; 忙碌的时段以方块标出。
+func renderWeekGrid(start time.Time, events []caldav.Event) string {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 0, 7)
+
+	// 统计每天每小时重叠的事件数
+	var busy [7][24]int
+	var allDay []string
+	for _, e := range events {
+		if e.AllDay {
+			allDay = append(allDay,
+				fmt.Sprintf("%s %s", e.Start.In(time.Local).Format("01/02"), e.Summary))
+			continue
+		}
+		for t := e.Start.In(time.Local).Truncate(time.Hour); t.Before(e.End.In(time.Local)); t = t.Add(time.Hour) {
+			if t.Before(start) || !t.Before(end) {
+				continue
+			}
+			day := int(t.Sub(start).Hours()) / 24
+			busy[day][t.Hour()]++
+		}
+	}
+
+	// 默认显示工作时间, 有事件时扩展范围
+	minHour, maxHour := 8, 18
+	for d := 0; d < 7; d++ {
+		for h := 0; h < 24; h++ {
+			if busy[d][h] > 0 {
+				if h < minHour {
+					minHour = h
+				}
+				if h+1 > maxHour {
+					maxHour = h + 1
+				}
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("      ")
+	for d := 0; d < 7; d++ {
+		b.WriteString(fmt.Sprintf(" %s ", start.AddDate(0, 0, d).Format("01/02")))
+	}
+	b.WriteString("\n")
+
+	for h := minHour; h < maxHour; h++ {
+		b.WriteString(fmt.Sprintf("%02d:00 ", h))
+		for d := 0; d < 7; d++ {
+			switch n := busy[d][h]; {
+			case n == 0:
+				b.WriteString("   ·   ")
+			case n == 1:
+				b.WriteString("  ███  ")
+			default:
+				b.WriteString(fmt.Sprintf("  █%d█  ", min(n, 9)))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(allDay) > 0 {
+		b.WriteString("全天: " + strings.Join(allDay, "; ") + "\n")
+	}
+
+	return b.String()
+}
+
+// min 返回两个整数中较小的一个。
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -12,13 +12,14 @@ import (
 
 // ContactsCmd 处理联系人相关操作
 type ContactsCmd struct {
-	List   ContactsListCmd   `cmd:"" help:"列出联系人"`
-	Get    ContactsGetCmd    `cmd:"" help:"获取联系人详情"`
-	Search ContactsSearchCmd `cmd:"" help:"搜索联系人"`
-	Create ContactsCreateCmd `cmd:"" help:"创建联系人"`
-	Update ContactsUpdateCmd `cmd:"" help:"更新联系人"`
-	Delete ContactsDeleteCmd `cmd:"" help:"删除联系人"`
-	Books  ContactsBooksCmd  `cmd:"" name:"books" help:"列出通讯录"`
+	List    ContactsListCmd    `cmd:"" help:"列出联系人"`
+	Get     ContactsGetCmd     `cmd:"" help:"获取联系人详情"`
+	Search  ContactsSearchCmd  `cmd:"" help:"搜索联系人"`
+	Create  ContactsCreateCmd  `cmd:"" help:"创建联系人"`
+	Update  ContactsUpdateCmd  `cmd:"" help:"更新联系人"`
+	Delete  ContactsDeleteCmd  `cmd:"" help:"删除联系人"`
+	Books   ContactsBooksCmd   `cmd:"" name:"books" help:"列出通讯录"`
+	Suggest ContactsSuggestCmd `cmd:"" help:"推荐收件人地址 (联系人 + 最近发送)"`
 }
 
 // ContactsListCmd 列出通讯录中的联系人
@@ -292,11 +293,17 @@ func (c *ContactsDeleteCmd) Run(root *Root) error {
 	return nil
 }
 
-// ContactsBooksCmd 列出可用的通讯录
-type ContactsBooksCmd struct{}
+// ContactsBooksCmd 管理通讯录
+type ContactsBooksCmd struct {
+	List   ContactsBooksListCmd   `cmd:"" default:"1" help:"列出通讯录"`
+	Create ContactsBooksCreateCmd `cmd:"" help:"创建通讯录 (扩展 MKCOL)"`
+}
+
+// ContactsBooksListCmd 列出可用的通讯录
+type ContactsBooksListCmd struct{}
 
 // Run 执行列出通讯录命令
-func (c *ContactsBooksCmd) Run(root *Root) error {
+func (c *ContactsBooksListCmd) Run(root *Root) error {
 	// 获取CardDAV客户端
 	client, _, err := getCardDAVClient(root)
 	if err != nil {
@@ -322,11 +329,42 @@ func (c *ContactsBooksCmd) Run(root *Root) error {
 		return outputAddressBooksJSON(books)
 	}
 
-	// 输出通讯录列表
-	fmt.Printf("%-50s %s\n", "路径", "名称")
+	// 输出通讯录列表 (包含服务器暴露的描述)
+	fmt.Printf("%-50s %-20s %s\n", "路径", "名称", "描述")
 	for _, book := range books {
-		fmt.Printf("%-50s %s\n", book.Path, book.Name)
+		fmt.Printf("%-50s %-20s %s\n", book.Path, book.Name, book.Description)
+	}
+	return nil
+}
+
+// ContactsBooksCreateCmd 创建新的通讯录
+type ContactsBooksCreateCmd struct {
+	Name        string `arg:"" help:"通讯录显示名称"`
+	Description string `help:"通讯录描述"`
+}
+
+// Run 执行创建通讯录命令
+func (c *ContactsBooksCreateCmd) Run(root *Root) error {
+	// 获取CardDAV客户端
+	client, _, err := getCardDAVClient(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// 通过扩展 MKCOL 创建通讯录
+	ctx := context.Background()
+	path, err := client.CreateAddressBook(ctx, c.Name, c.Description)
+	if err != nil {
+		return fmt.Errorf("创建通讯录失败: %w", err)
+	}
+
+	if root.JSON {
+		fmt.Printf(`{"path":"%s","name":"%s"}`+"\n", path, c.Name)
+		return nil
 	}
+
+	fmt.Printf("创建通讯录成功: %s (%s)\n", c.Name, path)
 	return nil
 }
 
@@ -409,13 +447,13 @@ func outputContactsJSON(contacts []carddav.Contact) error {
 func outputContactsTable(contacts []carddav.Contact) error {
 	// 输出表头
 	fmt.Printf("%-30s %-30s %-20s\n", "姓名", "邮箱", "电话")
-	
+
 	for _, c := range contacts {
 		name := c.FullName
 		if len(name) > 30 {
 			name = name[:27] + "..."
 		}
-		
+
 		email := ""
 		if len(c.Emails) > 0 {
 			email = c.Emails[0]
@@ -423,12 +461,12 @@ func outputContactsTable(contacts []carddav.Contact) error {
 		if len(email) > 30 {
 			email = email[:27] + "..."
 		}
-		
+
 		phone := ""
 		if len(c.Phones) > 0 {
 			phone = c.Phones[0]
 		}
-		
+
 		fmt.Printf("%-30s %-30s %-20s\n", name, email, phone)
 	}
 	return nil
@@ -439,11 +477,11 @@ func outputContactDetail(contact *carddav.Contact) error {
 	// 输出联系人基本信息
 	fmt.Printf("UID:       %s\n", contact.UID)
 	fmt.Printf("姓名:      %s\n", contact.FullName)
-	
+
 	if contact.FirstName != "" || contact.LastName != "" {
 		fmt.Printf("           (名: %s, 姓: %s)\n", contact.FirstName, contact.LastName)
 	}
-	
+
 	// 输出联系方式
 	if len(contact.Emails) > 0 {
 		fmt.Printf("邮箱:      %s\n", strings.Join(contact.Emails, ", "))
@@ -451,7 +489,7 @@ func outputContactDetail(contact *carddav.Contact) error {
 	if len(contact.Phones) > 0 {
 		fmt.Printf("电话:      %s\n", strings.Join(contact.Phones, ", "))
 	}
-	
+
 	// 输出工作信息
 	if contact.Org != "" {
 		fmt.Printf("组织:      %s\n", contact.Org)
@@ -459,7 +497,7 @@ func outputContactDetail(contact *carddav.Contact) error {
 	if contact.Title != "" {
 		fmt.Printf("职位:      %s\n", contact.Title)
 	}
-	
+
 	// 输出其他信息
 	if len(contact.Addresses) > 0 {
 		fmt.Printf("地址:      %s\n", strings.Join(contact.Addresses, "; "))
@@ -473,7 +511,7 @@ func outputContactDetail(contact *carddav.Contact) error {
 	if contact.URL != "" {
 		fmt.Printf("网址:      %s\n", contact.URL)
 	}
-	
+
 	return nil
 }
 
@@ -483,4 +521,4 @@ func outputAddressBooksJSON(books []carddav.AddressBook) error {
 		fmt.Printf(`{"path":"%s","name":"%s","description":"%s"}`+"\n", b.Path, b.Name, b.Description)
 	}
 	return nil
-}
\ No newline at end of file
+}
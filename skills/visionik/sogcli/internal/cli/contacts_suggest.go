@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+)
+
+// sentCacheFile 是已发送邮件地址缓存的文件名。
+const sentCacheFile = "sent_addresses.json"
+
+// sentCacheMaxAge 是缓存被视为新鲜的最长时间。
+const sentCacheMaxAge = 24 * time.Hour
+
+// sentCacheHarvestMax 是刷新缓存时扫描的已发送邮件数量。
+const sentCacheHarvestMax = 200
+
+// ContactsSuggestCmd 根据前缀推荐收件人地址
+type ContactsSuggestCmd struct {
+	Prefix     string `arg:"" help:"地址或姓名前缀"`
+	Max        int    `help:"返回的最大推荐数量" default:"10"`
+	SentFolder string `help:"要采集地址的已发送文件夹" name:"sent-folder" default:"Sent"`
+	Refresh    bool   `help:"强制刷新已发送邮件地址缓存"`
+}
+
+// suggestion 是一条收件人推荐。
+type suggestion struct {
+	Name   string `json:"name,omitempty"`
+	Email  string `json:"email"`
+	Source string `json:"source"` // contacts 或 sent
+}
+
+// sentAddressCache 是本地缓存文件的结构。
+type sentAddressCache struct {
+	Updated   time.Time      `json:"updated"`
+	Addresses []imap.Address `json:"addresses"`
+}
+
+// Run 执行推荐收件人命令
+func (c *ContactsSuggestCmd) Run(root *Root) error {
+	prefix := strings.ToLower(c.Prefix)
+	var suggestions []suggestion
+	seen := make(map[string]bool)
+
+	// 来源一: CardDAV 联系人 (优先)
+	if client, bookPath, err := getCardDAVClient(root); err == nil {
+		ctx := context.Background()
+		contacts, err := client.SearchContacts(ctx, bookPath, c.Prefix)
+		client.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "警告: 搜索联系人失败: %v\n", err)
+		} else {
+			for _, contact := range contacts {
+				for _, email := range contact.Emails {
+					key := strings.ToLower(email)
+					if !seen[key] {
+						seen[key] = true
+						suggestions = append(suggestions, suggestion{
+							Name: contact.FullName, Email: email, Source: "contacts",
+						})
+					}
+				}
+			}
+		}
+	} else {
+		fmt.Fprintf(os.Stderr, "警告: %v\n", err)
+	}
+
+	// 来源二: 最近发送的邮件 (本地缓存)
+	addrs, err := c.sentAddresses(root)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "警告: 采集已发送地址失败: %v\n", err)
+	}
+	for _, a := range addrs {
+		key := strings.ToLower(a.Email)
+		if seen[key] || !matchesPrefix(prefix, a.Name, a.Email) {
+			continue
+		}
+		seen[key] = true
+		suggestions = append(suggestions, suggestion{Name: a.Name, Email: a.Email, Source: "sent"})
+	}
+
+	if len(suggestions) > c.Max {
+		suggestions = suggestions[:c.Max]
+	}
+
+	if len(suggestions) == 0 {
+		if !root.JSON {
+			fmt.Println("没有匹配的地址。")
+		}
+		return nil
+	}
+
+	if root.JSON {
+		for _, s := range suggestions {
+			fmt.Printf(`{"name":"%s","email":"%s","source":"%s"}`+"\n",
+				jsonEscape(s.Name), jsonEscape(s.Email), s.Source)
+		}
+		return nil
+	}
+
+	for _, s := range suggestions {
+		if s.Name != "" {
+			fmt.Printf("%s\t%s\n", s.Email, s.Name)
+		} else {
+			fmt.Println(s.Email)
+		}
+	}
+	return nil
+}
+
+// matchesPrefix 判断姓名或邮箱是否匹配给定前缀 (不区分大小写)。
+func matchesPrefix(prefix, name, email string) bool {
+	if prefix == "" {
+		return true
+	}
+	if strings.HasPrefix(strings.ToLower(email), prefix) {
+		return true
+	}
+	for _, word := range strings.Fields(strings.ToLower(name)) {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sentAddresses 返回已发送邮件中出现的收件人地址, 优先使用本地缓存,
+// 缓存过期或 --refresh 时通过 IMAP 重新采集。
+func (c *ContactsSuggestCmd) sentAddresses(root *Root) ([]imap.Address, error) {
+	path, err := config.CachePath(sentCacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.Refresh {
+		if data, err := os.ReadFile(path); err == nil {
+			var cache sentAddressCache
+			if json.Unmarshal(data, &cache) == nil && time.Since(cache.Updated) < sentCacheMaxAge {
+				return cache.Addresses, nil
+			}
+		}
+	}
+
+	addrs, err := c.harvestSent(root)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := sentAddressCache{Updated: time.Now(), Addresses: addrs}
+	if data, err := json.Marshal(cache); err == nil {
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			fmt.Fprintf(os.Stderr, "警告: 写入地址缓存失败: %v\n", err)
+		}
+	}
+	return addrs, nil
+}
+
+// harvestSent 通过 IMAP 扫描已发送文件夹, 去重后返回收件人地址。
+func (c *ContactsSuggestCmd) harvestSent(root *Root) ([]imap.Address, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return nil, fmt.Errorf("未指定账户。使用 --account 或设置默认账户")
+	}
+
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return nil, fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("连接失败: %w", err)
+	}
+	defer client.Close()
+
+	raw, err := client.ListRecipients(c.SentFolder, sentCacheHarvestMax)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按最近出现顺序去重
+	seen := make(map[string]bool, len(raw))
+	addrs := make([]imap.Address, 0, len(raw))
+	for _, a := range raw {
+		key := strings.ToLower(a.Email)
+		if !seen[key] {
+			seen[key] = true
+			addrs = append(addrs, a)
+		}
+	}
+	return addrs, nil
+}
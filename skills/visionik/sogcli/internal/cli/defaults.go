@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/visionik/sogcli/internal/config"
+)
+
+// ApplyProfileFromArgs 在 kong 解析之前确定要使用的配置档案。
+// 与 main 中 --ai-help 的预扫描方式一致: 档案会影响配置加载本身,
+// 所以必须在解析标志之前生效。
+func ApplyProfileFromArgs(args []string) {
+	profile := os.Getenv("SOG_PROFILE")
+	for i, arg := range args {
+		if arg == "--profile" && i+1 < len(args) {
+			profile = args[i+1]
+		} else if strings.HasPrefix(arg, "--profile=") {
+			profile = strings.TrimPrefix(arg, "--profile=")
+		}
+	}
+	if profile != "" {
+		config.SetProfile(profile)
+	}
+}
+
+// ConfigDefaultsResolver 返回一个 kong resolver, 从配置的 defaults
+// 表为未显式传入的标志提供默认值。键为 "命令路径.标志名",
+// 例如 "mail.list.max" 或 "cal.create.duration"; 不带命令路径的键
+// (如 "folder") 对所有命令生效。
+func ConfigDefaultsResolver() kong.Resolver {
+	cfg, err := config.Load()
+	if err != nil || len(cfg.Defaults) == 0 {
+		// 配置问题在命令执行时报告, 这里只是没有默认值可提供。
+		return kong.ResolverFunc(func(*kong.Context, *kong.Path, *kong.Flag) (interface{}, error) {
+			return nil, nil
+		})
+	}
+
+	defaults := cfg.Defaults
+	return kong.ResolverFunc(func(kctx *kong.Context, parent *kong.Path, flag *kong.Flag) (interface{}, error) {
+		var parts []string
+		for n := parent.Node(); n != nil && n.Parent != nil; n = n.Parent {
+			parts = append([]string{n.Name}, parts...)
+		}
+		key := strings.Join(append(parts, flag.Name), ".")
+		if v, ok := defaults[key]; ok {
+			return v, nil
+		}
+		if v, ok := defaults[flag.Name]; ok {
+			return v, nil
+		}
+		return nil, nil
+	})
+}
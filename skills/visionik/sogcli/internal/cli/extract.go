@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// 实体提取的正则表达式。日期模式覆盖 ISO 格式、斜杠格式和
+// 英文月份写法; 电话模式要求至少 7 位数字以避免误报。
+var (
+	extractURLRe   = regexp.MustCompile(`https?://[^\s<>"'\)\]]+`)
+	extractDateRes = []*regexp.Regexp{
+		regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}(?::\d{2})?)?\b`),
+		regexp.MustCompile(`\b\d{1,2}/\d{1,2}/\d{2,4}\b`),
+		regexp.MustCompile(`(?i)\b(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\.?\s+\d{1,2}(?:st|nd|rd|th)?(?:,?\s+\d{4})?\b`),
+		regexp.MustCompile(`(?i)\b\d{1,2}(?:st|nd|rd|th)?\s+(?:Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)[a-z]*\.?(?:\s+\d{4})?\b`),
+	}
+	extractPhoneRe = regexp.MustCompile(`\+?\d[\d\s\-().]{5,}\d`)
+)
+
+// extractEntities 从正文中提取指定类型的实体, 去重并保持出现顺序。
+func extractEntities(kind, body string) []string {
+	var matches []string
+	switch kind {
+	case "urls":
+		matches = extractURLRe.FindAllString(body, -1)
+		for i, m := range matches {
+			matches[i] = strings.TrimRight(m, ".,;:")
+		}
+	case "dates":
+		for _, re := range extractDateRes {
+			matches = append(matches, re.FindAllString(body, -1)...)
+		}
+	case "phones":
+		for _, m := range extractPhoneRe.FindAllString(body, -1) {
+			digits := 0
+			for _, r := range m {
+				if r >= '0' && r <= '9' {
+					digits++
+				}
+			}
+			if digits >= 7 && digits <= 15 {
+				matches = append(matches, strings.TrimSpace(m))
+			}
+		}
+	}
+
+	seen := make(map[string]bool, len(matches))
+	result := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if !seen[m] {
+			seen[m] = true
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// printExtracted 以 JSONL 输出提取到的实体, 供下游自动化直接消费。
+func printExtracted(kind, body string) {
+	entityKind := strings.TrimSuffix(kind, "s") // urls -> url
+	for _, v := range extractEntities(kind, body) {
+		fmt.Printf(`{"kind":"%s","value":"%s"}`+"\n", entityKind, jsonEscape(v))
+	}
+}
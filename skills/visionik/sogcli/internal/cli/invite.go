@@ -8,7 +8,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/visionik/sogcli/internal/caldav"
 	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
 	"github.com/visionik/sogcli/internal/itip"
 	"github.com/visionik/sogcli/internal/smtp"
 )
@@ -20,6 +22,7 @@ type InviteCmd struct {
 	Cancel  InviteCancelCmd  `cmd:"" help:"取消会议"`
 	Parse   InviteParseCmd   `cmd:"" help:"解析.ics文件"`
 	Preview InvitePreviewCmd `cmd:"" help:"预览邀请而不发送"`
+	Status  InviteStatusCmd  `cmd:"" help:"查看邀请的RSVP回复状态"`
 }
 
 // InviteSendCmd 发送会议邀请
@@ -32,6 +35,7 @@ type InviteSendCmd struct {
 	Location    string   `help:"会议地点" short:"l"`
 	Description string   `help:"会议描述" short:"d"`
 	Organizer   string   `help:"组织者姓名"`
+	NoCalendar  bool     `help:"不将事件保存到组织者的CalDAV日历" name:"no-calendar"`
 }
 
 // Run 执行发送邀请命令
@@ -110,10 +114,20 @@ func (c *InviteSendCmd) Run(root *Root) error {
 		return fmt.Errorf("发送邀请失败: %w", err)
 	}
 
+	// 将组织者的事件自动保存到CalDAV日历（失败不影响邀请发送）
+	savedToCalendar := false
+	if !c.NoCalendar {
+		if err := saveInviteToCalendar(root, inv); err != nil {
+			fmt.Fprintf(os.Stderr, "警告: 保存事件到日历失败: %v\n", err)
+		} else {
+			savedToCalendar = true
+		}
+	}
+
 	// 根据输出格式返回结果
 	if root.JSON {
-		fmt.Printf(`{"uid":"%s","summary":"%s","start":"%s","end":"%s","attendees":%d}`+"\n",
-			inv.UID, inv.Summary, inv.Start.Format(time.RFC3339), inv.End.Format(time.RFC3339), len(inv.Attendees))
+		fmt.Printf(`{"uid":"%s","summary":"%s","start":"%s","end":"%s","attendees":%d,"saved_to_calendar":%t}`+"\n",
+			inv.UID, inv.Summary, inv.Start.Format(time.RFC3339), inv.End.Format(time.RFC3339), len(inv.Attendees), savedToCalendar)
 		return nil
 	}
 
@@ -125,9 +139,39 @@ func (c *InviteSendCmd) Run(root *Root) error {
 		fmt.Printf("  地点: %s\n", inv.Location)
 	}
 	fmt.Printf("  参与者: %s\n", strings.Join(c.Attendees, ", "))
+	if savedToCalendar {
+		fmt.Println("  已保存到日历")
+	}
 	return nil
 }
 
+// saveInviteToCalendar 将组织者的事件保存到其CalDAV日历。
+func saveInviteToCalendar(root *Root, inv *itip.Invite) error {
+	client, calPath, err := getCalDAVClient(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var attendees []string
+	for _, att := range inv.Attendees {
+		attendees = append(attendees, att.Email)
+	}
+
+	event := &caldav.Event{
+		UID:         inv.UID,
+		Summary:     inv.Summary,
+		Description: inv.Description,
+		Location:    inv.Location,
+		Start:       inv.Start,
+		End:         inv.End,
+		Organizer:   inv.Organizer.Email,
+		Attendees:   attendees,
+	}
+
+	return client.CreateEvent(context.Background(), calPath, event)
+}
+
 // InviteReplyCmd 回复会议邀请
 type InviteReplyCmd struct {
 	File    string `arg:"" help:".ics文件或'-'表示从标准输入读取"`
@@ -390,6 +434,185 @@ func (c *InvitePreviewCmd) Run(root *Root) error {
 	return nil
 }
 
+// InviteStatusCmd 查看邀请的RSVP回复状态
+type InviteStatusCmd struct {
+	UID    string `arg:"" help:"要查询的会议UID"`
+	Folder string `help:"要扫描的文件夹" default:"INBOX"`
+	Max    int    `help:"最多扫描的邮件数量" default:"200"`
+}
+
+// rsvpStatus 汇总单个参与者的回复状态
+type rsvpStatus struct {
+	Email   string `json:"email"`
+	Name    string `json:"name,omitempty"`
+	Status  string `json:"status"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// Run 执行查询RSVP状态命令
+func (c *InviteStatusCmd) Run(root *Root) error {
+	// 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	// 获取账户信息
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return fmt.Errorf("未指定账户")
+	}
+
+	// 获取账户配置
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return err
+	}
+
+	// 获取密码
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	// 连接IMAP服务器
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("连接失败: %w", err)
+	}
+	defer client.Close()
+
+	// 搜索包含该UID的邮件（REPLY邮件正文中包含UID）
+	hits, err := client.SearchMessages(c.Folder, "TEXT "+c.UID, c.Max)
+	if err != nil {
+		return fmt.Errorf("搜索失败: %w", err)
+	}
+
+	// 逐封获取正文，提取iCalendar数据并分类
+	var invite *itip.Invite
+	replies := map[string]rsvpStatus{}
+	for _, hit := range hits {
+		msg, err := client.GetMessage(c.Folder, hit.UID, false)
+		if err != nil {
+			continue
+		}
+		icsData := itip.ExtractCalendar([]byte(msg.Body))
+		if icsData == nil {
+			continue
+		}
+		parsed, err := itip.ParseInvite(icsData)
+		if err != nil || parsed.UID != c.UID {
+			continue
+		}
+		switch parsed.Method {
+		case itip.MethodRequest:
+			// 原始邀请：记录预期的参与者列表
+			invite = parsed
+		case itip.MethodReply:
+			// 回复：记录每个参与者的PARTSTAT
+			for _, att := range parsed.Attendees {
+				replies[strings.ToLower(att.Email)] = rsvpStatus{
+					Email:  att.Email,
+					Name:   att.Name,
+					Status: string(att.Status),
+				}
+			}
+		}
+	}
+
+	// 汇总状态：预期参与者优先，未回复的标记为no-response
+	statuses := aggregateRSVP(invite, replies)
+	if len(statuses) == 0 {
+		return fmt.Errorf("未找到UID为 %s 的邀请或回复", c.UID)
+	}
+
+	// 统计数量
+	counts := map[string]int{}
+	for _, s := range statuses {
+		counts[s.Status]++
+	}
+
+	// 输出结果
+	if root.JSON {
+		fmt.Printf(`{"uid":"%s","accepted":%d,"declined":%d,"tentative":%d,"no_response":%d,"attendees":[`,
+			c.UID, counts["accepted"], counts["declined"], counts["tentative"], counts["no-response"])
+		for i, s := range statuses {
+			if i > 0 {
+				fmt.Print(",")
+			}
+			fmt.Printf(`{"email":"%s","status":"%s"}`, s.Email, s.Status)
+		}
+		fmt.Println("]}")
+		return nil
+	}
+
+	// 输出回复状态面板
+	fmt.Printf("UID: %s\n", c.UID)
+	if invite != nil {
+		fmt.Printf("会议: %s\n", invite.Summary)
+	}
+	fmt.Printf("已接受: %d  已拒绝: %d  暂定: %d  未回复: %d\n",
+		counts["accepted"], counts["declined"], counts["tentative"], counts["no-response"])
+	fmt.Println("参与者:")
+	for _, s := range statuses {
+		fmt.Printf("  %-32s %s\n", s.Email, s.Status)
+	}
+	return nil
+}
+
+// aggregateRSVP 合并原始邀请的参与者列表和收到的回复。
+// 有回复的参与者使用其PARTSTAT，没有回复的标记为no-response。
+func aggregateRSVP(invite *itip.Invite, replies map[string]rsvpStatus) []rsvpStatus {
+	var statuses []rsvpStatus
+	seen := map[string]bool{}
+
+	// 将PARTSTAT映射为面板状态词
+	toWord := func(s string) string {
+		switch itip.ParticipantStatus(strings.ToUpper(s)) {
+		case itip.StatusAccepted:
+			return "accepted"
+		case itip.StatusDeclined:
+			return "declined"
+		case itip.StatusTentative:
+			return "tentative"
+		default:
+			return "no-response"
+		}
+	}
+
+	if invite != nil {
+		for _, att := range invite.Attendees {
+			key := strings.ToLower(att.Email)
+			seen[key] = true
+			if r, ok := replies[key]; ok {
+				statuses = append(statuses, rsvpStatus{Email: att.Email, Name: att.Name, Status: toWord(r.Status)})
+			} else {
+				statuses = append(statuses, rsvpStatus{Email: att.Email, Name: att.Name, Status: "no-response"})
+			}
+		}
+	}
+
+	// 包含不在原始邀请中的回复者
+	for _, r := range replies {
+		if !seen[strings.ToLower(r.Email)] {
+			statuses = append(statuses, rsvpStatus{Email: r.Email, Name: r.Name, Status: toWord(r.Status)})
+		}
+	}
+
+	return statuses
+}
+
 // 辅助函数
 
 // getDomain 从邮箱地址中提取域名
@@ -423,6 +646,10 @@ func sendInviteEmail(cfg *config.Config, from string, inv *itip.Invite, icsData
 		Password: password,
 		StartTLS: acct.SMTP.StartTLS,
 		TLS:      acct.SMTP.TLS,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
 	})
 	if err != nil {
 		return err
@@ -476,6 +703,10 @@ func sendReplyEmail(cfg *config.Config, from string, inv *itip.Invite, resp *iti
 		Password: password,
 		StartTLS: acct.SMTP.StartTLS,
 		TLS:      acct.SMTP.TLS,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
 	})
 	if err != nil {
 		return err
@@ -529,6 +760,10 @@ func sendCancelEmail(cfg *config.Config, from string, uid string, attendees []st
 		Password: password,
 		StartTLS: acct.SMTP.StartTLS,
 		TLS:      acct.SMTP.TLS,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
 	})
 	if err != nil {
 		return err
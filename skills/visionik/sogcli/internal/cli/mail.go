@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/visionik/sogcli/internal/config"
@@ -14,17 +15,22 @@ import (
 
 // MailCmd 处理邮件的读取和发送操作
 type MailCmd struct {
-	List    MailListCmd    `cmd:"" help:"列出文件夹中的邮件"`
-	Get     MailGetCmd     `cmd:"" help:"通过UID获取邮件"`
-	Search  MailSearchCmd  `cmd:"" help:"搜索邮件"`
-	Send    MailSendCmd    `cmd:"" help:"发送邮件"`
-	Reply   MailReplyCmd   `cmd:"" help:"回复邮件"`
-	Forward MailForwardCmd `cmd:"" help:"转发邮件"`
-	Move    MailMoveCmd    `cmd:"" help:"将邮件移动到其他文件夹"`
-	Copy    MailCopyCmd    `cmd:"" help:"将邮件复制到其他文件夹"`
-	Flag    MailFlagCmd    `cmd:"" help:"为邮件设置标记"`
-	Unflag  MailUnflagCmd  `cmd:"" help:"从邮件中移除标记"`
-	Delete  MailDeleteCmd  `cmd:"" help:"删除邮件"`
+	List        MailListCmd        `cmd:"" help:"列出文件夹中的邮件"`
+	Get         MailGetCmd         `cmd:"" help:"通过UID获取邮件"`
+	Search      MailSearchCmd      `cmd:"" help:"搜索邮件"`
+	Send        MailSendCmd        `cmd:"" help:"发送邮件"`
+	Reply       MailReplyCmd       `cmd:"" help:"回复邮件"`
+	Forward     MailForwardCmd     `cmd:"" help:"转发邮件"`
+	Move        MailMoveCmd        `cmd:"" help:"将邮件移动到其他文件夹"`
+	Copy        MailCopyCmd        `cmd:"" help:"将邮件复制到其他文件夹"`
+	Flag        MailFlagCmd        `cmd:"" help:"为邮件设置标记"`
+	Unflag      MailUnflagCmd      `cmd:"" help:"从邮件中移除标记"`
+	Delete      MailDeleteCmd      `cmd:"" help:"删除邮件"`
+	Bounce      MailBounceCmd      `cmd:"" help:"将邮件原样重定向给其他收件人 (Resent-*)"`
+	Archive     MailArchiveCmd     `cmd:"" help:"归档邮件到 Archive/<年份> 文件夹"`
+	Spam        MailSpamCmd        `cmd:"" help:"标记为垃圾邮件 (移入垃圾文件夹并训练)"`
+	Ham         MailHamCmd         `cmd:"" help:"标记为正常邮件 (移回收件箱并训练)"`
+	Unsubscribe MailUnsubscribeCmd `cmd:"" help:"按 List-Unsubscribe 头自动退订"`
 }
 
 // MailListCmd 列出文件夹中的邮件
@@ -57,6 +63,11 @@ func (c *MailListCmd) Run(root *Root) error {
 		return err
 	}
 
+	// 如果配置了JMAP则透明地使用JMAP后端
+	if acct.JMAP.URL != "" {
+		return c.runJMAP(root, cfg, email, acct)
+	}
+
 	// 获取密码
 	password, err := cfg.GetPassword(email)
 	if err != nil {
@@ -130,10 +141,11 @@ func (c *MailListCmd) Run(root *Root) error {
 
 // MailGetCmd 通过UID获取邮件
 type MailGetCmd struct {
-	UID     uint32 `arg:"" help:"邮件UID"`
+	UID     string `arg:"" help:"邮件UID（IMAP为数字，JMAP为字符串ID）"`
 	Folder  string `help:"包含邮件的文件夹" default:"INBOX"`
 	Headers bool   `help:"仅显示邮件头"`
 	Raw     bool   `help:"输出原始RFC822格式"`
+	Extract string `help:"从正文中提取实体并以JSON输出" enum:",urls,dates,phones" default:""`
 }
 
 // Run 执行获取邮件命令
@@ -159,6 +171,17 @@ func (c *MailGetCmd) Run(root *Root) error {
 		return err
 	}
 
+	// 如果配置了JMAP则透明地使用JMAP后端
+	if acct.JMAP.URL != "" {
+		return c.runJMAP(root, cfg, email, acct)
+	}
+
+	// IMAP的UID是数字
+	uid, err := parseUID(c.UID)
+	if err != nil {
+		return err
+	}
+
 	// 获取密码
 	password, err := cfg.GetPassword(email)
 	if err != nil {
@@ -181,11 +204,17 @@ func (c *MailGetCmd) Run(root *Root) error {
 	defer client.Close()
 
 	// 获取邮件
-	msg, err := client.GetMessage(c.Folder, c.UID, c.Headers)
+	msg, err := client.GetMessage(c.Folder, uid, c.Headers)
 	if err != nil {
 		return fmt.Errorf("获取邮件失败: %w", err)
 	}
 
+	// 实体提取模式: 只输出提取结果
+	if c.Extract != "" {
+		printExtracted(c.Extract, msg.Body)
+		return nil
+	}
+
 	// 输出结果
 	if root.JSON {
 		fmt.Printf(`{"uid":%d,"from":"%s","date":"%s","subject":"%s","body":"%s"}`+"\n",
@@ -236,6 +265,11 @@ func (c *MailSearchCmd) Run(root *Root) error {
 		return err
 	}
 
+	// 如果配置了JMAP则透明地使用JMAP后端
+	if acct.JMAP.URL != "" {
+		return c.runJMAP(root, cfg, email, acct)
+	}
+
 	// 获取密码
 	password, err := cfg.GetPassword(email)
 	if err != nil {
@@ -339,6 +373,11 @@ func (c *MailSendCmd) Run(root *Root) error {
 		return err
 	}
 
+	// 如果配置了JMAP则透明地使用JMAP后端
+	if acct.JMAP.URL != "" {
+		return c.runJMAP(root, cfg, email, acct)
+	}
+
 	// 获取密码
 	password, err := cfg.GetPassword(email)
 	if err != nil {
@@ -380,6 +419,10 @@ func (c *MailSendCmd) Run(root *Root) error {
 		NoTLS:    acct.SMTP.NoTLS,
 		Email:    email,
 		Password: password,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
 	})
 
 	// 发送邮件
@@ -400,6 +443,15 @@ func (c *MailSendCmd) Run(root *Root) error {
 	return nil
 }
 
+// parseUID 将字符串UID解析为IMAP的数字UID。
+func parseUID(s string) (uint32, error) {
+	uid, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("无效的UID: %s", s)
+	}
+	return uint32(uid), nil
+}
+
 // parseRecipients 将逗号分隔的字符串分割为收件人列表
 func parseRecipients(s string) []string {
 	if s == "" {
@@ -791,6 +843,10 @@ func (c *MailReplyCmd) Run(root *Root) error {
 		NoTLS:    acct.SMTP.NoTLS,
 		Email:    email,
 		Password: password,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
 	})
 
 	msg := &smtp.Message{
@@ -894,6 +950,10 @@ func (c *MailForwardCmd) Run(root *Root) error {
 		NoTLS:    acct.SMTP.NoTLS,
 		Email:    email,
 		Password: password,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
 	})
 
 	to := parseRecipients(c.To)
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+)
+
+// MailArchiveCmd 将邮件归档到 Archive/<年份> 文件夹, 不存在时自动创建。
+type MailArchiveCmd struct {
+	UID    string `arg:"" optional:"" help:"邮件UID (或使用 --query 批量归档)"`
+	Query  string `help:"按 IMAP SEARCH 查询批量归档 (如 \"BEFORE 1-Jan-2026\")"`
+	Folder string `help:"包含邮件的文件夹" default:"INBOX"`
+	Max    int    `help:"批量归档的最大邮件数" default:"100"`
+}
+
+// Run 执行归档邮件命令
+func (c *MailArchiveCmd) Run(root *Root) error {
+	if c.UID == "" && c.Query == "" {
+		return fmt.Errorf("需要指定邮件UID或 --query")
+	}
+	if c.UID != "" && c.Query != "" {
+		return fmt.Errorf("邮件UID和 --query 不能同时使用")
+	}
+
+	// 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	// 获取账户信息
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return fmt.Errorf("未指定账户。使用 --account 或设置默认账户")
+	}
+
+	// 获取账户配置
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return err
+	}
+
+	// 获取密码
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	// 连接IMAP服务器
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("连接失败: %w", err)
+	}
+	defer client.Close()
+
+	// 确定归档文件夹并按需创建
+	archiveFolder := fmt.Sprintf("Archive/%d", time.Now().Year())
+	if err := ensureFolder(client, archiveFolder); err != nil {
+		return err
+	}
+
+	// 收集要归档的UID
+	var uids []uint32
+	if c.UID != "" {
+		uid, err := parseUID(c.UID)
+		if err != nil {
+			return err
+		}
+		uids = []uint32{uid}
+	} else {
+		messages, err := client.SearchMessages(c.Folder, c.Query, c.Max)
+		if err != nil {
+			return fmt.Errorf("搜索邮件失败: %w", err)
+		}
+		for _, m := range messages {
+			uids = append(uids, m.UID)
+		}
+	}
+
+	if len(uids) == 0 {
+		fmt.Println("没有匹配的邮件。")
+		return nil
+	}
+
+	// 逐个移动
+	var archived []uint32
+	for _, uid := range uids {
+		if err := client.MoveMessage(c.Folder, uid, archiveFolder); err != nil {
+			fmt.Fprintf(os.Stderr, "警告: 归档邮件 %d 失败: %v\n", uid, err)
+			continue
+		}
+		archived = append(archived, uid)
+	}
+
+	if root.JSON {
+		strs := make([]string, len(archived))
+		for i, uid := range archived {
+			strs[i] = fmt.Sprintf("%d", uid)
+		}
+		fmt.Printf(`{"archived":[%s],"folder":"%s"}`+"\n", strings.Join(strs, ","), jsonEscape(archiveFolder))
+		return nil
+	}
+
+	fmt.Printf("已归档 %d 封邮件到 %s\n", len(archived), archiveFolder)
+	return nil
+}
+
+// ensureFolder 确保文件夹存在, 不存在时创建。
+func ensureFolder(client *imap.Client, name string) error {
+	folders, err := client.ListFolders()
+	if err != nil {
+		return fmt.Errorf("列出文件夹失败: %w", err)
+	}
+	for _, f := range folders {
+		if f == name {
+			return nil
+		}
+	}
+	if err := client.CreateFolder(name); err != nil {
+		return fmt.Errorf("创建文件夹 %s 失败: %w", name, err)
+	}
+	return nil
+}
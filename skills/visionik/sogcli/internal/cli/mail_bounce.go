@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+	"github.com/visionik/sogcli/internal/smtp"
+)
+
+// MailBounceCmd 将邮件原样重定向给其他收件人 (真正的 redirect,
+// 添加 Resent-* 头而不是包装为转发)。
+type MailBounceCmd struct {
+	UID    string   `arg:"" help:"邮件UID"`
+	To     []string `help:"新收件人" required:""`
+	Folder string   `help:"包含邮件的文件夹" default:"INBOX"`
+}
+
+// Run 执行重定向邮件命令
+func (c *MailBounceCmd) Run(root *Root) error {
+	// 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	// 获取账户信息
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return fmt.Errorf("未指定账户。使用 --account 或设置默认账户")
+	}
+
+	// 获取账户配置
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return err
+	}
+
+	uid, err := parseUID(c.UID)
+	if err != nil {
+		return err
+	}
+
+	// 获取IMAP密码
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	// 连接IMAP服务器并获取原始邮件
+	imapClient, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("连接失败: %w", err)
+	}
+	defer imapClient.Close()
+
+	msg, err := imapClient.GetMessage(c.Folder, uid, false)
+	if err != nil {
+		return fmt.Errorf("获取邮件失败: %w", err)
+	}
+	if msg.Body == "" {
+		return fmt.Errorf("邮件正文为空, 无法重定向")
+	}
+
+	// 在原始邮件前添加 Resent-* 头 (RFC 5322 §3.6.6)
+	raw := resentHeaders(email, c.To) + msg.Body
+
+	// 获取SMTP密码
+	smtpPassword, err := cfg.GetPasswordForProtocol(email, config.ProtocolSMTP)
+	if err != nil {
+		return fmt.Errorf("获取SMTP密码失败: %w", err)
+	}
+
+	smtpClient := smtp.NewClient(smtp.Config{
+		Host:     acct.SMTP.Host,
+		Port:     acct.SMTP.Port,
+		TLS:      acct.SMTP.TLS,
+		StartTLS: acct.SMTP.StartTLS,
+		Insecure: acct.SMTP.Insecure,
+		NoTLS:    acct.SMTP.NoTLS,
+		Email:    email,
+		Password: smtpPassword,
+	})
+
+	ctx := context.Background()
+	if err := smtpClient.SendRaw(ctx, email, c.To, []byte(raw)); err != nil {
+		return fmt.Errorf("重定向邮件失败: %w", err)
+	}
+
+	if root.JSON {
+		fmt.Printf(`{"uid":%d,"to":"%s","status":"bounced"}`+"\n", uid, jsonEscape(strings.Join(c.To, ", ")))
+		return nil
+	}
+
+	fmt.Printf("已将邮件 %d 重定向至: %s\n", uid, strings.Join(c.To, ", "))
+	return nil
+}
+
+// resentHeaders 构建重定向所需的 Resent-* 头部块。
+func resentHeaders(from string, to []string) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Resent-From: %s\r\n", from))
+	b.WriteString(fmt.Sprintf("Resent-To: %s\r\n", strings.Join(to, ", ")))
+	b.WriteString(fmt.Sprintf("Resent-Date: %s\r\n", time.Now().Format(time.RFC1123Z)))
+	b.WriteString(fmt.Sprintf("Resent-Message-ID: <bounce-%d@sog>\r\n", time.Now().UnixNano()))
+	return b.String()
+}
@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/jmap"
+)
+
+// 当账户配置了 JMAP URL 时，邮件的列出/获取/搜索/发送命令
+// 会透明地使用 JMAP 后端而非 IMAP/SMTP。
+
+// connectJMAP 为账户建立 JMAP 连接。
+func connectJMAP(cfg *config.Config, email string, acct *config.Account) (*jmap.Client, error) {
+	password, err := cfg.GetPasswordForProtocol(email, config.ProtocolJMAP)
+	if err != nil {
+		return nil, fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	client, err := jmap.Connect(jmap.Config{
+		URL:      acct.JMAP.URL,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("连接JMAP失败: %w", err)
+	}
+	return client, nil
+}
+
+// printJMAPMessages 以与IMAP列表相同的格式输出JMAP邮件列表。
+func printJMAPMessages(root *Root, messages []jmap.Message) {
+	if len(messages) == 0 {
+		fmt.Println("未找到邮件。")
+		return
+	}
+
+	if root.JSON {
+		for _, m := range messages {
+			fmt.Printf(`{"id":"%s","from":"%s","date":"%s","subject":"%s","seen":%t}`+"\n",
+				m.ID, m.From, m.Date, m.Subject, m.Seen)
+		}
+		return
+	}
+
+	// 输出表头
+	fmt.Printf("%-26s %-12s %-24s %s\n", "ID", "日期", "发件人", "主题")
+	for _, m := range messages {
+		marker := " "
+		if !m.Seen {
+			marker = "*"
+		}
+		from := m.From
+		if len(from) > 24 {
+			from = from[:21] + "..."
+		}
+		subject := m.Subject
+		if len(subject) > 50 {
+			subject = subject[:47] + "..."
+		}
+		fmt.Printf("%s%-25s %-12s %-24s %s\n", marker, m.ID, m.Date, from, subject)
+	}
+}
+
+// runJMAP 通过JMAP执行列出邮件。
+func (c *MailListCmd) runJMAP(root *Root, cfg *config.Config, email string, acct *config.Account) error {
+	client, err := connectJMAP(cfg, email, acct)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	messages, err := client.ListMessages(c.Folder, c.Max, c.Unseen)
+	if err != nil {
+		return fmt.Errorf("列出邮件失败: %w", err)
+	}
+
+	printJMAPMessages(root, messages)
+	return nil
+}
+
+// runJMAP 通过JMAP执行获取邮件。
+func (c *MailGetCmd) runJMAP(root *Root, cfg *config.Config, email string, acct *config.Account) error {
+	client, err := connectJMAP(cfg, email, acct)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	msg, err := client.GetMessage(c.UID)
+	if err != nil {
+		return fmt.Errorf("获取邮件失败: %w", err)
+	}
+
+	// 实体提取模式: 只输出提取结果
+	if c.Extract != "" {
+		printExtracted(c.Extract, msg.Body)
+		return nil
+	}
+
+	if root.JSON {
+		fmt.Printf(`{"id":"%s","from":"%s","date":"%s","subject":"%s","body":"%s"}`+"\n",
+			msg.ID, msg.From, msg.Date, msg.Subject, msg.Body)
+		return nil
+	}
+
+	fmt.Printf("发件人: %s\n", msg.From)
+	fmt.Printf("日期: %s\n", msg.Date)
+	fmt.Printf("主题: %s\n", msg.Subject)
+	if !c.Headers && msg.Body != "" {
+		fmt.Println("")
+		fmt.Println(msg.Body)
+	}
+	return nil
+}
+
+// runJMAP 通过JMAP执行搜索邮件。
+func (c *MailSearchCmd) runJMAP(root *Root, cfg *config.Config, email string, acct *config.Account) error {
+	client, err := connectJMAP(cfg, email, acct)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	messages, err := client.SearchMessages(c.Folder, c.Query, c.Max)
+	if err != nil {
+		return fmt.Errorf("搜索失败: %w", err)
+	}
+
+	printJMAPMessages(root, messages)
+	return nil
+}
+
+// runJMAP 通过JMAP执行发送邮件。
+func (c *MailSendCmd) runJMAP(root *Root, cfg *config.Config, email string, acct *config.Account) error {
+	client, err := connectJMAP(cfg, email, acct)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// 获取邮件正文
+	body := c.Body
+	if c.BodyFile != "" {
+		var data []byte
+		var err error
+		if c.BodyFile == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(c.BodyFile)
+		}
+		if err != nil {
+			return fmt.Errorf("读取正文失败: %w", err)
+		}
+		body = string(data)
+	}
+	if body == "" {
+		return fmt.Errorf("必须指定 --body 或 --body-file")
+	}
+
+	to := parseRecipients(c.To)
+	cc := parseRecipients(c.Cc)
+	bcc := parseRecipients(c.Bcc)
+
+	if err := client.SendMessage(email, to, cc, bcc, c.Subject, body); err != nil {
+		return fmt.Errorf("发送失败: %w", err)
+	}
+
+	fmt.Printf("已发送给 %v\n", to)
+	return nil
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+)
+
+// defaultJunkFolder 是未配置时使用的垃圾邮件文件夹。
+const defaultJunkFolder = "Junk"
+
+// MailSpamCmd 将邮件标记为垃圾邮件: 移入垃圾文件夹并可选地
+// 通过外部命令 (sa-learn, rspamc) 进行训练。
+type MailSpamCmd struct {
+	UID    string `arg:"" help:"邮件UID"`
+	Folder string `help:"包含邮件的文件夹" default:"INBOX"`
+}
+
+// Run 执行标记垃圾邮件命令
+func (c *MailSpamCmd) Run(root *Root) error {
+	return reportSpamHam(root, c.UID, c.Folder, true)
+}
+
+// MailHamCmd 将邮件标记为正常邮件: 从垃圾文件夹移回收件箱并
+// 可选地通过外部命令进行训练。
+type MailHamCmd struct {
+	UID    string `arg:"" help:"邮件UID"`
+	Folder string `help:"包含邮件的文件夹 (默认: 垃圾文件夹)" default:""`
+}
+
+// Run 执行标记正常邮件命令
+func (c *MailHamCmd) Run(root *Root) error {
+	return reportSpamHam(root, c.UID, c.Folder, false)
+}
+
+// reportSpamHam 实现垃圾/正常邮件报告的共同流程。
+func reportSpamHam(root *Root, uidStr, folder string, spam bool) error {
+	// 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	// 获取账户信息
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return fmt.Errorf("未指定账户。使用 --account 或设置默认账户")
+	}
+
+	// 获取账户配置
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return err
+	}
+
+	junkFolder := acct.Spam.Folder
+	if junkFolder == "" {
+		junkFolder = defaultJunkFolder
+	}
+	if folder == "" {
+		// ham 默认从垃圾文件夹取邮件
+		folder = junkFolder
+	}
+
+	uid, err := parseUID(uidStr)
+	if err != nil {
+		return err
+	}
+
+	// 获取密码
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	// 连接IMAP服务器
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("连接失败: %w", err)
+	}
+	defer client.Close()
+
+	// 配置了训练命令时先获取原始邮件
+	learnCmd := acct.Spam.LearnSpam
+	if !spam {
+		learnCmd = acct.Spam.LearnHam
+	}
+	if learnCmd != "" {
+		msg, err := client.GetMessage(folder, uid, false)
+		if err != nil {
+			return fmt.Errorf("获取邮件失败: %w", err)
+		}
+		if err := pipeToCommand(learnCmd, []byte(msg.Body)); err != nil {
+			fmt.Fprintf(os.Stderr, "警告: 训练命令失败: %v\n", err)
+		}
+	}
+
+	// 移动邮件
+	var dst string
+	if spam {
+		if err := ensureFolder(client, junkFolder); err != nil {
+			return err
+		}
+		dst = junkFolder
+	} else {
+		dst = "INBOX"
+	}
+	if err := client.MoveMessage(folder, uid, dst); err != nil {
+		return fmt.Errorf("移动邮件失败: %w", err)
+	}
+
+	kind := "spam"
+	if !spam {
+		kind = "ham"
+	}
+	if root.JSON {
+		fmt.Printf(`{"uid":%d,"reported":"%s","folder":"%s"}`+"\n", uid, kind, jsonEscape(dst))
+		return nil
+	}
+	fmt.Printf("已将邮件 %d 标记为 %s 并移动到 %s\n", uid, kind, dst)
+	return nil
+}
+
+// pipeToCommand 通过 shell 运行训练命令并将原始邮件写入其标准输入。
+func pipeToCommand(command string, raw []byte) error {
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdin = strings.NewReader(string(raw))
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
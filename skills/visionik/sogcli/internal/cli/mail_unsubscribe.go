@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
+	"github.com/visionik/sogcli/internal/smtp"
+)
+
+// MailUnsubscribeCmd 解析邮件的 List-Unsubscribe 头并自动退订:
+// 支持一键 HTTPS POST (RFC 8058) 和 mailto 退订邮件。
+type MailUnsubscribeCmd struct {
+	UID    string `arg:"" help:"邮件UID"`
+	Folder string `help:"包含邮件的文件夹" default:"INBOX"`
+	DryRun bool   `help:"仅预览将执行的退订操作, 不实际执行"`
+}
+
+// Run 执行退订命令
+func (c *MailUnsubscribeCmd) Run(root *Root) error {
+	// 加载配置
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	// 获取账户信息
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return fmt.Errorf("未指定账户。使用 --account 或设置默认账户")
+	}
+
+	// 获取账户配置
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return err
+	}
+
+	uid, err := parseUID(c.UID)
+	if err != nil {
+		return err
+	}
+
+	// 获取IMAP密码
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	// 连接IMAP服务器并获取原始邮件
+	imapClient, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return fmt.Errorf("连接失败: %w", err)
+	}
+	defer imapClient.Close()
+
+	msg, err := imapClient.GetMessage(c.Folder, uid, false)
+	if err != nil {
+		return fmt.Errorf("获取邮件失败: %w", err)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(msg.Body))
+	if err != nil {
+		return fmt.Errorf("解析邮件头失败: %w", err)
+	}
+
+	httpsURL, mailtoURL := parseListUnsubscribe(parsed.Header.Get("List-Unsubscribe"))
+	oneClick := strings.Contains(
+		strings.ToLower(parsed.Header.Get("List-Unsubscribe-Post")), "one-click")
+
+	switch {
+	case httpsURL != "" && oneClick:
+		return c.unsubscribePost(root, uid, httpsURL)
+	case mailtoURL != "":
+		return c.unsubscribeMailto(root, cfg, acct, email, uid, mailtoURL)
+	case httpsURL != "":
+		// 没有 One-Click 声明的 HTTPS 链接需要浏览器交互, 只能提示
+		return fmt.Errorf("该邮件仅提供交互式退订链接, 请在浏览器中打开: %s", httpsURL)
+	default:
+		return fmt.Errorf("邮件没有 List-Unsubscribe 头, 无法自动退订")
+	}
+}
+
+// unsubscribePost 执行 RFC 8058 一键退订 POST。
+func (c *MailUnsubscribeCmd) unsubscribePost(root *Root, uid uint32, target string) error {
+	if c.DryRun {
+		if root.JSON {
+			fmt.Printf(`{"uid":%d,"method":"one-click","url":"%s","status":"dry-run"}`+"\n",
+				uid, jsonEscape(target))
+			return nil
+		}
+		fmt.Printf("将执行一键退订 POST: %s\n", target)
+		return nil
+	}
+
+	httpClient := retry.HTTPClient(log.HTTPClient(&http.Client{
+		Timeout: 30 * time.Second,
+	}, "Unsubscribe"))
+
+	resp, err := httpClient.Post(target, "application/x-www-form-urlencoded",
+		strings.NewReader("List-Unsubscribe=One-Click"))
+	if err != nil {
+		return fmt.Errorf("退订请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("退订请求失败: %s", resp.Status)
+	}
+
+	if root.JSON {
+		fmt.Printf(`{"uid":%d,"method":"one-click","status":"unsubscribed"}`+"\n", uid)
+		return nil
+	}
+	fmt.Printf("已退订 (一键退订): 邮件 %d\n", uid)
+	return nil
+}
+
+// unsubscribeMailto 发送 mailto 退订邮件。
+func (c *MailUnsubscribeCmd) unsubscribeMailto(root *Root, cfg *config.Config, acct *config.Account, email string, uid uint32, target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf("解析 mailto 链接失败: %w", err)
+	}
+
+	to := u.Opaque
+	subject := u.Query().Get("subject")
+	if subject == "" {
+		subject = "unsubscribe"
+	}
+	body := u.Query().Get("body")
+	if body == "" {
+		body = "unsubscribe"
+	}
+
+	if c.DryRun {
+		if root.JSON {
+			fmt.Printf(`{"uid":%d,"method":"mailto","to":"%s","subject":"%s","status":"dry-run"}`+"\n",
+				uid, jsonEscape(to), jsonEscape(subject))
+			return nil
+		}
+		fmt.Printf("将发送退订邮件至: %s (主题: %s)\n", to, subject)
+		return nil
+	}
+
+	// 获取SMTP密码
+	smtpPassword, err := cfg.GetPasswordForProtocol(email, config.ProtocolSMTP)
+	if err != nil {
+		return fmt.Errorf("获取SMTP密码失败: %w", err)
+	}
+
+	smtpClient := smtp.NewClient(smtp.Config{
+		Host:     acct.SMTP.Host,
+		Port:     acct.SMTP.Port,
+		TLS:      acct.SMTP.TLS,
+		StartTLS: acct.SMTP.StartTLS,
+		Insecure: acct.SMTP.Insecure,
+		NoTLS:    acct.SMTP.NoTLS,
+		Email:    email,
+		Password: smtpPassword,
+
+		DKIMKeyFile:  acct.DKIM.KeyFile,
+		DKIMSelector: acct.DKIM.Selector,
+		DKIMDomain:   acct.DKIM.Domain,
+	})
+
+	sendMsg := &smtp.Message{
+		From:    email,
+		To:      []string{to},
+		Subject: subject,
+		Body:    body,
+	}
+	if err := smtpClient.Send(context.Background(), sendMsg); err != nil {
+		return fmt.Errorf("发送退订邮件失败: %w", err)
+	}
+
+	if root.JSON {
+		fmt.Printf(`{"uid":%d,"method":"mailto","to":"%s","status":"unsubscribed"}`+"\n",
+			uid, jsonEscape(to))
+		return nil
+	}
+	fmt.Printf("已发送退订邮件至: %s\n", to)
+	return nil
+}
+
+// parseListUnsubscribe 从 List-Unsubscribe 头中提取 HTTPS 和 mailto
+// 链接。头的格式为逗号分隔的 <URL> 列表。
+func parseListUnsubscribe(header string) (httpsURL, mailtoURL string) {
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		part = strings.TrimPrefix(part, "<")
+		part = strings.TrimSuffix(part, ">")
+		switch {
+		case strings.HasPrefix(part, "https://") && httpsURL == "":
+			httpsURL = part
+		case strings.HasPrefix(part, "mailto:") && mailtoURL == "":
+			mailtoURL = part
+		}
+	}
+	return httpsURL, mailtoURL
+}
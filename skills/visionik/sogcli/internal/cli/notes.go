@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/caldav"
+)
+
+// NotesCmd handles note operations (CalDAV VJOURNAL).
+type NotesCmd struct {
+	List   NotesListCmd   `cmd:"" help:"List notes"`
+	Add    NotesAddCmd    `cmd:"" aliases:"create" help:"Add a note"`
+	Get    NotesGetCmd    `cmd:"" help:"Get note details"`
+	Edit   NotesEditCmd   `cmd:"" aliases:"update" help:"Edit a note"`
+	Delete NotesDeleteCmd `cmd:"" aliases:"rm,del" help:"Delete a note"`
+}
+
+// NotesListCmd lists notes.
+type NotesListCmd struct {
+	Calendar string `arg:"" optional:"" help:"Calendar path (default: primary)"`
+	Max      int    `help:"Maximum notes to return" default:"50"`
+}
+
+// Run executes the notes list command.
+func (c *NotesListCmd) Run(root *Root) error {
+	client, calPath, err := getCalDAVClientForNotes(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.Calendar != "" {
+		calPath = c.Calendar
+	}
+
+	ctx := context.Background()
+	notes, err := client.ListJournals(ctx, calPath)
+	if err != nil {
+		return fmt.Errorf("failed to list notes: %w", err)
+	}
+
+	if len(notes) == 0 {
+		fmt.Println("No notes found.")
+		return nil
+	}
+
+	// Limit results
+	if c.Max > 0 && len(notes) > c.Max {
+		notes = notes[:c.Max]
+	}
+
+	if root.JSON {
+		return outputNotesJSON(notes)
+	}
+
+	return outputNotesTable(notes)
+}
+
+// NotesAddCmd adds a new note.
+type NotesAddCmd struct {
+	Title      string   `arg:"" help:"Note title"`
+	Body       string   `help:"Note body" short:"b"`
+	BodyFile   string   `help:"Read body from file (- for stdin)" name:"body-file"`
+	Categories []string `help:"Categories/tags" short:"c"`
+	Calendar   string   `help:"Calendar path (default: primary)"`
+}
+
+// Run executes the notes add command.
+func (c *NotesAddCmd) Run(root *Root) error {
+	client, calPath, err := getCalDAVClientForNotes(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.Calendar != "" {
+		calPath = c.Calendar
+	}
+
+	body, err := readNoteBody(c.Body, c.BodyFile)
+	if err != nil {
+		return err
+	}
+
+	note := &caldav.Journal{
+		UID:         generateNoteUID(),
+		Summary:     c.Title,
+		Description: body,
+		Categories:  c.Categories,
+		Created:     time.Now(),
+	}
+
+	ctx := context.Background()
+	if err := client.CreateJournal(ctx, calPath, note); err != nil {
+		return fmt.Errorf("failed to create note: %w", err)
+	}
+
+	if root.JSON {
+		return outputNotesJSON([]caldav.Journal{*note})
+	}
+
+	fmt.Printf("Created note: %s (%s)\n", note.Summary, note.UID)
+	return nil
+}
+
+// NotesGetCmd gets note details.
+type NotesGetCmd struct {
+	UID      string `arg:"" help:"Note UID"`
+	Calendar string `help:"Calendar path (default: primary)"`
+}
+
+// Run executes the notes get command.
+func (c *NotesGetCmd) Run(root *Root) error {
+	client, calPath, err := getCalDAVClientForNotes(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.Calendar != "" {
+		calPath = c.Calendar
+	}
+
+	ctx := context.Background()
+	note, err := client.GetJournal(ctx, calPath, c.UID)
+	if err != nil {
+		return fmt.Errorf("failed to get note: %w", err)
+	}
+
+	if root.JSON {
+		return outputNotesJSON([]caldav.Journal{*note})
+	}
+
+	return outputNoteDetail(note)
+}
+
+// NotesEditCmd edits a note.
+type NotesEditCmd struct {
+	UID        string   `arg:"" help:"Note UID"`
+	Title      string   `help:"New note title"`
+	Body       string   `help:"New note body" short:"b"`
+	BodyFile   string   `help:"Read body from file (- for stdin)" name:"body-file"`
+	Categories []string `help:"Categories/tags" short:"c"`
+	Calendar   string   `help:"Calendar path (default: primary)"`
+}
+
+// Run executes the notes edit command.
+func (c *NotesEditCmd) Run(root *Root) error {
+	client, calPath, err := getCalDAVClientForNotes(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.Calendar != "" {
+		calPath = c.Calendar
+	}
+
+	ctx := context.Background()
+	note, err := client.GetJournal(ctx, calPath, c.UID)
+	if err != nil {
+		return fmt.Errorf("failed to get note: %w", err)
+	}
+
+	// Apply updates
+	if c.Title != "" {
+		note.Summary = c.Title
+	}
+	if c.Body != "" || c.BodyFile != "" {
+		body, err := readNoteBody(c.Body, c.BodyFile)
+		if err != nil {
+			return err
+		}
+		note.Description = body
+	}
+	if len(c.Categories) > 0 {
+		note.Categories = c.Categories
+	}
+
+	if err := client.UpdateJournal(ctx, calPath, note); err != nil {
+		return fmt.Errorf("failed to update note: %w", err)
+	}
+
+	fmt.Printf("Updated note: %s\n", c.UID)
+	return nil
+}
+
+// NotesDeleteCmd deletes a note.
+type NotesDeleteCmd struct {
+	UID      string `arg:"" help:"Note UID"`
+	Calendar string `help:"Calendar path (default: primary)"`
+}
+
+// Run executes the notes delete command.
+func (c *NotesDeleteCmd) Run(root *Root) error {
+	client, calPath, err := getCalDAVClientForNotes(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.Calendar != "" {
+		calPath = c.Calendar
+	}
+
+	ctx := context.Background()
+	if err := client.DeleteJournal(ctx, calPath, c.UID); err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	fmt.Printf("Deleted note: %s\n", c.UID)
+	return nil
+}
+
+// getCalDAVClientForNotes creates a CalDAV client from config for note operations.
+func getCalDAVClientForNotes(root *Root) (*caldav.Client, string, error) {
+	// Reuse the calendar client - notes are stored in CalDAV
+	return getCalDAVClient(root)
+}
+
+// readNoteBody resolves the note body from --body or --body-file.
+func readNoteBody(body, bodyFile string) (string, error) {
+	if bodyFile == "" {
+		return body, nil
+	}
+	var data []byte
+	var err error
+	if bodyFile == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(bodyFile)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read body: %w", err)
+	}
+	return string(data), nil
+}
+
+// generateNoteUID generates a unique identifier for a note.
+func generateNoteUID() string {
+	return fmt.Sprintf("note-%d@sog", time.Now().UnixNano())
+}
+
+// outputNotesJSON outputs notes as JSON.
+func outputNotesJSON(notes []caldav.Journal) error {
+	for _, n := range notes {
+		createdStr := ""
+		if !n.Created.IsZero() {
+			createdStr = n.Created.Format(time.RFC3339)
+		}
+		fmt.Printf(`{"uid":"%s","summary":"%s","created":"%s"}`+"\n",
+			n.UID, n.Summary, createdStr)
+	}
+	return nil
+}
+
+// outputNotesTable outputs notes as a table.
+func outputNotesTable(notes []caldav.Journal) error {
+	fmt.Printf("%-12s %-40s %s\n", "CREATED", "TITLE", "UID")
+	for _, n := range notes {
+		created := "-"
+		if !n.Created.IsZero() {
+			created = n.Created.Format("2006-01-02")
+		}
+		title := n.Summary
+		if len(title) > 40 {
+			title = title[:37] + "..."
+		}
+		fmt.Printf("%-12s %-40s %s\n", created, title, n.UID)
+	}
+	return nil
+}
+
+// outputNoteDetail outputs a single note in detail.
+func outputNoteDetail(note *caldav.Journal) error {
+	fmt.Printf("UID:         %s\n", note.UID)
+	fmt.Printf("Title:       %s\n", note.Summary)
+	if !note.Created.IsZero() {
+		fmt.Printf("Created:     %s\n", note.Created.Format("2006-01-02 15:04"))
+	}
+	if !note.LastModified.IsZero() {
+		fmt.Printf("Modified:    %s\n", note.LastModified.Format("2006-01-02 15:04"))
+	}
+	if len(note.Categories) > 0 {
+		fmt.Printf("Categories:  %s\n", strings.Join(note.Categories, ", "))
+	}
+	if note.Description != "" {
+		fmt.Println("")
+		fmt.Println(note.Description)
+	}
+	return nil
+}
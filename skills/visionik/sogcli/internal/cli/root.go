@@ -4,6 +4,8 @@ package cli
 import (
 	"fmt"
 	"os"
+
+	"github.com/visionik/sogcli/internal/log"
 )
 
 // Root 是命令行工具的顶层结构体。
@@ -12,12 +14,14 @@ type Root struct {
 	// 全局标志 (与 gog 模式匹配)
 	AIHelp  bool        `name:"ai-help" help:"显示 AI/LLM 智能体的详细帮助信息"`
 	Account string      `help:"要使用的账户邮箱" env:"SOG_ACCOUNT" short:"a"`
+	Profile string      `help:"使用配置中的命名档案" env:"SOG_PROFILE"`
 	JSON    bool        `help:"输出 JSON 到标准输出 (最适合脚本处理)" xor:"format"`
 	Plain   bool        `help:"输出稳定的、可解析的文本到标准输出 (TSV格式; 无颜色)" xor:"format"`
 	Color   string      `help:"颜色输出: auto|always|never" default:"auto" enum:"auto,always,never"`
 	Force   bool        `help:"跳过破坏性命令的确认提示"`
 	NoInput bool        `help:"从不提示; 直接失败 (适用于 CI 环境)" name:"no-input"`
 	Verbose bool        `help:"启用详细日志" short:"v"`
+	Debug   bool        `help:"启用协议级调试跟踪 (IMAP/SMTP/HTTP, 已脱敏)"`
 	Version VersionFlag `name:"version" help:"打印版本信息并退出"`
 
 	// 子命令
@@ -26,11 +30,28 @@ type Root struct {
 	Cal      CalCmd      `cmd:"" aliases:"c" help:"日历操作 (CalDAV)"`
 	Contacts ContactsCmd `cmd:"" aliases:"con" help:"联系人操作 (CardDAV)"`
 	Tasks    TasksCmd    `cmd:"" aliases:"t" help:"任务操作 (CalDAV VTODO)"`
+	Notes    NotesCmd    `cmd:"" aliases:"n" help:"笔记操作 (CalDAV VJOURNAL)"`
 	Drive    DriveCmd    `cmd:"" aliases:"files" help:"文件操作 (WebDAV)"`
 	Invite   InviteCmd   `cmd:"" aliases:"inv" help:"会议邀请 (iTIP/iMIP)"`
 	Folders  FoldersCmd  `cmd:"" aliases:"f" help:"管理文件夹"`
 	Drafts   DraftsCmd   `cmd:"" aliases:"d" help:"管理草稿"`
 	Idle     IdleCmd     `cmd:"" help:"监听新邮件 (IMAP IDLE)"`
+	Search   SearchCmd   `cmd:"" aliases:"s" help:"跨邮件/日历/联系人/任务/文件的统一搜索"`
+	Brief    BriefCmd    `cmd:"" aliases:"b" help:"每日简报 (日程/未读邮件/任务/邀请)"`
+	Serve    ServeCmd    `cmd:"" help:"服务器模式 (MCP over stdio)"`
+
+	SelfUpdate SelfUpdateCmd `cmd:"" name:"self-update" help:"更新 sog 到最新版本"`
+}
+
+// AfterApply 在参数解析后根据全局标志配置日志级别。
+func (r *Root) AfterApply() error {
+	switch {
+	case r.Debug:
+		log.SetLevel(log.LevelDebug)
+	case r.Verbose:
+		log.SetLevel(log.LevelInfo)
+	}
+	return nil
 }
 
 // VersionFlag 处理 --version 参数。
@@ -58,11 +79,13 @@ sog mail list
 ## 全局标志
 
 --account, -a    要使用的账户邮箱 ($SOG_ACCOUNT)
+--profile        使用配置中的命名档案 ($SOG_PROFILE)
 --json           JSON 输出 (用于脚本)
 --plain          TSV 输出 (可解析)
 --force          跳过确认提示
 --no-input       从不提示 (CI 模式)
---verbose, -v    调试日志
+--verbose, -v    详细日志
+--debug          协议级调试跟踪 (IMAP/SMTP/HTTP, 已脱敏)
 --ai-help        显示此帮助信息
 
 ## 身份验证
@@ -76,12 +99,15 @@ sog auth add <邮箱> [标志]
   --caldav-url     CalDAV 服务器 URL
   --carddav-url    CardDAV 服务器 URL
   --webdav-url     WebDAV 服务器 URL
+  --jmap-url       JMAP 会话 URL (配置后邮件操作透明地使用 JMAP)
   --password       密码 (存储在密钥链中)
 
 sog auth list                    列出账户
 sog auth test [邮箱]             测试连接
 sog auth remove <邮箱>           移除账户
 sog auth password <邮箱>         设置协议特定的密码
+sog auth credential-helper get|store|erase
+                                 git-credential 协议凭据助手 (供 msmtp/vdirsyncer 等复用)
   --imap, --smtp, --caldav, --carddav, --webdav
 
 ## 邮件 (IMAP/SMTP)
@@ -93,6 +119,7 @@ sog mail list [文件夹]
 sog mail get <uid>
   --headers        仅标题
   --raw            原始 RFC822 格式
+  --extract        提取实体: urls, dates, phones (JSON 输出)
 
 sog mail search <查询>
   IMAP SEARCH 语法: FROM, TO, SUBJECT, SINCE, BEFORE 等
@@ -113,6 +140,15 @@ sog mail copy <uid> <文件夹>
 sog mail flag <uid> <标志>       标志: seen, flagged, answered, deleted
 sog mail unflag <uid> <标志>
 sog mail delete <uid>
+sog mail bounce <uid> --to <邮箱>  原样重定向邮件 (Resent-* 头, 非转发)
+sog mail archive [uid]           归档到 Archive/<年份> (自动创建文件夹)
+  --query          按 IMAP SEARCH 查询批量归档
+  --max            批量归档的最大数量 (默认: 100)
+sog mail spam <uid>              标记为垃圾邮件 (移入垃圾文件夹)
+sog mail ham <uid>               标记为正常邮件 (移回收件箱)
+  训练命令在账户配置的 spam.learn_spam / spam.learn_ham 中设置
+sog mail unsubscribe <uid> [--dry-run]  按 List-Unsubscribe 头自动退订
+  支持一键 HTTPS POST (RFC 8058) 和 mailto; --dry-run 仅预览
 
 ## 文件夹
 
@@ -139,6 +175,8 @@ sog cal get <uid>
 sog cal search <查询>           在标题/描述/位置中搜索
 sog cal today [日历]
 sog cal week [日历]
+  --grid           按小时分块的一周网格视图
+sog cal month [日历] [--month YYYY-MM]  月历网格 (每天标注事件数)
 
 sog cal create <标题> --start <日期时间> [标志]
   --start          开始时间 (YYYY-MM-DDTHH:MM 或 YYYY-MM-DD 表示全天)
@@ -168,7 +206,14 @@ sog contacts create <名称> [标志]
 
 sog contacts update <uid> [标志]  与创建相同的标志
 sog contacts delete <uid>
-sog contacts books               列出地址簿
+sog contacts books               列出地址簿 (含描述)
+sog contacts books create <名称>  创建地址簿 (扩展 MKCOL)
+  --description    地址簿描述
+
+sog contacts suggest <前缀>      推荐收件人 (联系人 + 最近发送的地址)
+  --max            最大推荐数量 (默认: 10)
+  --sent-folder    已发送文件夹 (默认: Sent)
+  --refresh        强制刷新本地地址缓存
 
 ## 任务 (CalDAV VTODO)
 
@@ -189,6 +234,22 @@ sog tasks clear                  删除所有已完成的任务
 sog tasks due <日期>             截止到日期的任务
 sog tasks overdue                逾期任务
 sog tasks lists                  列出任务列表
+sog tasks sync-todotxt <文件>    与 todo.txt 文件同步
+  --two-way        同时将文件中的更改推送回服务器
+
+## 笔记 (CalDAV VJOURNAL)
+
+sog notes list [日历]
+  --最大            最大笔记数量
+
+sog notes add <标题> [标志]
+  -b, --body       笔记正文
+  --body-file      从文件读取正文 (- 表示标准输入)
+  -c, --categories 分类/标签
+
+sog notes get <uid>
+sog notes edit <uid> [标志]      与添加相同的标志, 外加 --title
+sog notes delete <uid>
 
 ## 文件 (WebDAV)
 
@@ -218,13 +279,40 @@ sog invite reply <文件> --status <accept|decline|tentative>
 
 sog invite cancel <uid> <参会人>...
 sog invite parse <文件>          解析 .ics 文件
+sog invite status <uid>          查看 RSVP 回复状态
+  --folder         要扫描的文件夹 (默认: INBOX)
 sog invite preview <摘要> <参会人>... --start <日期时间>
 
+## 每日简报
+
+sog brief                        今日日程 + 未读邮件 + 任务 + 待回复邀请
+  --format         输出格式: text, markdown (或使用 --json)
+  --folder         要检查的邮件文件夹 (默认: INBOX)
+
+## 统一搜索
+
+sog search <查询>                并发搜索邮件/事件/联系人/任务/文件
+  -k, --kind       限制类型: mail,event,contact,task,file (可重复)
+  --max            每种类型的最大结果数 (默认: 20)
+  --folder         邮件搜索的文件夹 (默认: INBOX)
+  结合 --json 输出 JSONL, 每行带 "kind" 字段
+
+## MCP 服务器
+
+sog serve --mcp                  在 stdio 上暴露 MCP 工具
+  工具: mail_list, mail_search, mail_get, cal_list,
+        contacts_search, tasks_list, tasks_add, drive_list
+
 ## IMAP IDLE
 
 sog idle [文件夹]                监听新邮件 (推送通知)
   --timeout        超时时间（秒）
 
+## 自更新
+
+sog self-update                  更新到最新版本 (校验和/签名验证)
+  --channel        发布通道: stable, edge (默认: stable)
+
 ## 输出格式
 
 默认: 人类可读的有颜色输出
@@ -234,6 +322,25 @@ sog idle [文件夹]                监听新邮件 (推送通知)
 ## 环境变量
 
 SOG_ACCOUNT      默认账户邮箱
+SOG_PROFILE      默认配置档案
+
+## 配置默认值与档案
+
+config.json 支持 defaults 表和命名档案:
+
+{
+  "defaults": {"mail.list.max": "50", "cal.create.duration": "30m"},
+  "profiles": {
+    "work": {"default_account": "you@corp.example", "defaults": {"folder": "Work"}}
+  }
+}
+
+键为 "命令路径.标志名"; 不带路径的键对所有命令生效。
+档案通过 --profile 或 $SOG_PROFILE 选择, 其 defaults 覆盖顶层同名键。
+
+账户可配置 DKIM 签名 (直连发送时在提交前签名):
+
+"dkim": {"key_file": "~/.config/sog/dkim.pem", "selector": "mail"}
 
 ## 示例
 
@@ -0,0 +1,370 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+)
+
+// SearchCmd 跨邮件、日历、联系人、任务和文件的统一搜索。
+// 查询会并发地分发到 IMAP SEARCH、CalDAV、CardDAV 和 WebDAV，
+// 并返回带类型标记的合并结果列表。
+type SearchCmd struct {
+	Query  string   `arg:"" help:"搜索查询"`
+	Kind   []string `help:"限制搜索类型: mail,event,contact,task,file (默认: 全部)" short:"k" enum:"mail,event,contact,task,file"`
+	Max    int      `help:"每种类型的最大结果数" default:"20"`
+	Folder string   `help:"邮件搜索的文件夹" default:"INBOX"`
+}
+
+// SearchResult 是统一搜索的单条结果。
+type SearchResult struct {
+	Kind   string `json:"kind"` // mail, event, contact, task, file
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Detail string `json:"detail,omitempty"`
+	Date   string `json:"date,omitempty"`
+}
+
+// searchKinds 是统一搜索支持的类型，按输出顺序排列。
+var searchKinds = []string{"mail", "event", "contact", "task", "file"}
+
+// Run 执行统一搜索命令。
+func (c *SearchCmd) Run(root *Root) error {
+	enabled := make(map[string]bool)
+	if len(c.Kind) == 0 {
+		for _, k := range searchKinds {
+			enabled[k] = true
+		}
+	} else {
+		for _, k := range c.Kind {
+			enabled[k] = true
+		}
+	}
+
+	// 每种类型在独立的 goroutine 中使用独立的连接搜索
+	results := make([][]SearchResult, len(searchKinds))
+	errs := make([]error, len(searchKinds))
+
+	var wg sync.WaitGroup
+	for i, kind := range searchKinds {
+		if !enabled[kind] {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, kind string) {
+			defer wg.Done()
+			switch kind {
+			case "mail":
+				results[i], errs[i] = c.searchMail(root)
+			case "event":
+				results[i], errs[i] = c.searchEvents(root)
+			case "contact":
+				results[i], errs[i] = c.searchContacts(root)
+			case "task":
+				results[i], errs[i] = c.searchTasks(root)
+			case "file":
+				results[i], errs[i] = c.searchFiles(root)
+			}
+		}(i, kind)
+	}
+	wg.Wait()
+
+	// 单个后端失败不应使整个搜索失败；以警告形式报告
+	var merged []SearchResult
+	for i, kind := range searchKinds {
+		if errs[i] != nil {
+			fmt.Fprintf(os.Stderr, "警告: %s 搜索失败: %v\n", kind, errs[i])
+			continue
+		}
+		merged = append(merged, results[i]...)
+	}
+
+	if len(merged) == 0 {
+		if !root.JSON {
+			fmt.Println("未找到结果。")
+		}
+		return nil
+	}
+
+	if root.JSON {
+		for _, r := range merged {
+			fmt.Printf(`{"kind":"%s","id":"%s","title":"%s","detail":"%s","date":"%s"}`+"\n",
+				r.Kind, r.ID, jsonEscape(r.Title), jsonEscape(r.Detail), r.Date)
+		}
+		return nil
+	}
+
+	fmt.Printf("%-8s %-12s %-40s %s\n", "类型", "日期", "标题", "详情")
+	for _, r := range merged {
+		title := r.Title
+		if len(title) > 40 {
+			title = title[:37] + "..."
+		}
+		date := r.Date
+		if date == "" {
+			date = "-"
+		}
+		fmt.Printf("%-8s %-12s %-40s %s\n", r.Kind, date, title, r.Detail)
+	}
+	return nil
+}
+
+// searchMail 通过 IMAP SEARCH (或 JMAP) 搜索邮件。
+func (c *SearchCmd) searchMail(root *Root) ([]SearchResult, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return nil, fmt.Errorf("未指定账户")
+	}
+
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return nil, err
+	}
+
+	// 如果配置了JMAP则透明地使用JMAP后端
+	if acct.JMAP.URL != "" {
+		client, err := connectJMAP(cfg, email, acct)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		messages, err := client.SearchMessages(c.Folder, "TEXT "+c.Query, c.Max)
+		if err != nil {
+			return nil, err
+		}
+
+		var out []SearchResult
+		for _, m := range messages {
+			out = append(out, SearchResult{
+				Kind:   "mail",
+				ID:     m.ID,
+				Title:  m.Subject,
+				Detail: m.From,
+				Date:   m.Date,
+			})
+		}
+		return out, nil
+	}
+
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return nil, fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("连接失败: %w", err)
+	}
+	defer client.Close()
+
+	messages, err := client.SearchMessages(c.Folder, "TEXT "+c.Query, c.Max)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []SearchResult
+	for _, m := range messages {
+		out = append(out, SearchResult{
+			Kind:   "mail",
+			ID:     fmt.Sprintf("%d", m.UID),
+			Title:  m.Subject,
+			Detail: m.From,
+			Date:   m.Date,
+		})
+	}
+	return out, nil
+}
+
+// searchEvents 在 CalDAV 事件中搜索 (前后各一年)。
+func (c *SearchCmd) searchEvents(root *Root) ([]SearchResult, error) {
+	client, calPath, err := getCalDAVClient(root)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	now := time.Now()
+	ctx := context.Background()
+	events, err := client.ListEvents(ctx, calPath, now.AddDate(-1, 0, 0), now.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.ToLower(c.Query)
+	var out []SearchResult
+	for _, e := range events {
+		if !strings.Contains(strings.ToLower(e.Summary), query) &&
+			!strings.Contains(strings.ToLower(e.Description), query) &&
+			!strings.Contains(strings.ToLower(e.Location), query) {
+			continue
+		}
+		out = append(out, SearchResult{
+			Kind:   "event",
+			ID:     e.UID,
+			Title:  e.Summary,
+			Detail: e.Location,
+			Date:   e.Start.Format("2006-01-02"),
+		})
+		if c.Max > 0 && len(out) >= c.Max {
+			break
+		}
+	}
+	return out, nil
+}
+
+// searchContacts 通过 CardDAV 搜索联系人。
+func (c *SearchCmd) searchContacts(root *Root) ([]SearchResult, error) {
+	client, bookPath, err := getCardDAVClient(root)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	contacts, err := client.SearchContacts(ctx, bookPath, c.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []SearchResult
+	for _, ct := range contacts {
+		detail := ""
+		if len(ct.Emails) > 0 {
+			detail = ct.Emails[0]
+		}
+		out = append(out, SearchResult{
+			Kind:   "contact",
+			ID:     ct.UID,
+			Title:  ct.FullName,
+			Detail: detail,
+		})
+		if c.Max > 0 && len(out) >= c.Max {
+			break
+		}
+	}
+	return out, nil
+}
+
+// searchTasks 在 CalDAV 任务中搜索。
+func (c *SearchCmd) searchTasks(root *Root) ([]SearchResult, error) {
+	client, listPath, err := getCalDAVClientForTasks(root)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	tasks, err := client.ListTasks(ctx, listPath, true)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.ToLower(c.Query)
+	var out []SearchResult
+	for _, t := range tasks {
+		if !strings.Contains(strings.ToLower(t.Summary), query) &&
+			!strings.Contains(strings.ToLower(t.Description), query) {
+			continue
+		}
+		date := ""
+		if !t.Due.IsZero() {
+			date = t.Due.Format("2006-01-02")
+		}
+		out = append(out, SearchResult{
+			Kind:   "task",
+			ID:     t.UID,
+			Title:  t.Summary,
+			Detail: t.Status,
+			Date:   date,
+		})
+		if c.Max > 0 && len(out) >= c.Max {
+			break
+		}
+	}
+	return out, nil
+}
+
+// searchFileDepth 是文件搜索遍历 WebDAV 目录的最大深度。
+const searchFileDepth = 2
+
+// searchFiles 在 WebDAV 文件名中搜索。
+func (c *SearchCmd) searchFiles(root *Root) ([]SearchResult, error) {
+	client, err := getWebDAVClient(root)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	query := strings.ToLower(c.Query)
+
+	var out []SearchResult
+	var walk func(path string, depth int) error
+	walk = func(path string, depth int) error {
+		files, err := client.List(ctx, path)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			if c.Max > 0 && len(out) >= c.Max {
+				return nil
+			}
+			if strings.Contains(strings.ToLower(f.Name), query) {
+				date := ""
+				if !f.Modified.IsZero() {
+					date = f.Modified.Format("2006-01-02")
+				}
+				out = append(out, SearchResult{
+					Kind:   "file",
+					ID:     f.Path,
+					Title:  f.Name,
+					Detail: f.Path,
+					Date:   date,
+				})
+			}
+			if f.IsDir && depth < searchFileDepth && f.Path != path {
+				if err := walk(f.Path, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := walk("/", 0); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// jsonEscape 转义 JSON 字符串中的特殊字符。
+func jsonEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	s = strings.ReplaceAll(s, "\t", `\t`)
+	return s
+}
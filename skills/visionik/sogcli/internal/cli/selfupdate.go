@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/visionik/sogcli/internal/selfupdate"
+)
+
+// BuildVersion 是构建时注入的当前版本，由 main 设置。
+var BuildVersion = "dev"
+
+// SelfUpdateCmd 检查发布源并原地更新 sog 二进制文件。
+// 下载内容经过校验和 (及签名，若构建时配置了签名密钥) 验证后
+// 原子地替换当前可执行文件。
+type SelfUpdateCmd struct {
+	Channel string `help:"发布通道" default:"stable" enum:"stable,edge"`
+}
+
+// Run 执行 self-update 命令。
+func (c *SelfUpdateCmd) Run(root *Root) error {
+	feedURL := selfupdate.FeedURL(selfupdate.DefaultFeedBase, "sog", c.Channel)
+
+	fmt.Printf("正在检查 %s 通道的更新...\n", c.Channel)
+	rel, err := selfupdate.Check(feedURL)
+	if err != nil {
+		return fmt.Errorf("检查更新失败: %w", err)
+	}
+
+	if !selfupdate.IsNewer(BuildVersion, rel.Version) {
+		fmt.Printf("已是最新版本 (%s)\n", BuildVersion)
+		return nil
+	}
+
+	fmt.Printf("发现新版本: %s -> %s\n", BuildVersion, rel.Version)
+
+	binary, err := selfupdate.Download(rel)
+	if err != nil {
+		return fmt.Errorf("下载更新失败: %w", err)
+	}
+
+	execPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("无法确定可执行文件路径: %w", err)
+	}
+
+	if err := selfupdate.Apply(binary, execPath); err != nil {
+		return fmt.Errorf("应用更新失败: %w", err)
+	}
+
+	fmt.Printf("✓ 已更新到 %s\n", rel.Version)
+	return nil
+}
@@ -0,0 +1,316 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/visionik/sogcli/internal/caldav"
+	"github.com/visionik/sogcli/internal/config"
+	"github.com/visionik/sogcli/internal/imap"
+	"github.com/visionik/sogcli/internal/mcp"
+)
+
+// ServeCmd 以服务器模式运行 sog。
+// 目前支持 --mcp: 在标准输入/输出上暴露 Model Context Protocol 工具，
+// 使 AI 智能体可以直接调用邮件/日历/联系人/任务/文件操作，
+// 而无需通过 shell 调用并解析文本输出。
+type ServeCmd struct {
+	MCP bool `help:"以 MCP 服务器模式运行 (stdio)" required:""`
+}
+
+// Run 执行 serve 命令。
+func (c *ServeCmd) Run(root *Root) error {
+	if !c.MCP {
+		return fmt.Errorf("目前仅支持 --mcp 模式")
+	}
+
+	server := mcp.NewServer("sog", "dev")
+	registerMCPTools(server, root)
+
+	fmt.Fprintln(os.Stderr, "sog MCP 服务器已在 stdio 上启动")
+	return server.Serve(os.Stdin, os.Stdout)
+}
+
+// registerMCPTools 注册所有 MCP 工具。
+func registerMCPTools(server *mcp.Server, root *Root) {
+	stringProp := func(desc string) map[string]interface{} {
+		return map[string]interface{}{"type": "string", "description": desc}
+	}
+	intProp := func(desc string) map[string]interface{} {
+		return map[string]interface{}{"type": "integer", "description": desc}
+	}
+	schema := func(props map[string]interface{}, required ...string) map[string]interface{} {
+		s := map[string]interface{}{"type": "object", "properties": props}
+		if len(required) > 0 {
+			s["required"] = required
+		}
+		return s
+	}
+
+	server.Register(mcp.Tool{
+		Name:        "mail_list",
+		Description: "List messages in a mail folder",
+		InputSchema: schema(map[string]interface{}{
+			"folder": stringProp("Folder name (default: INBOX)"),
+			"max":    intProp("Maximum messages (default: 20)"),
+		}),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, err := mcpIMAPClient(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			messages, err := client.ListMessages(argString(args, "folder", "INBOX"), argInt(args, "max", 20), false)
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(messages)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "mail_search",
+		Description: "Search mail using IMAP SEARCH syntax (FROM, TO, SUBJECT, SINCE, TEXT, ...)",
+		InputSchema: schema(map[string]interface{}{
+			"query":  stringProp("IMAP search query"),
+			"folder": stringProp("Folder name (default: INBOX)"),
+			"max":    intProp("Maximum messages (default: 20)"),
+		}, "query"),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, err := mcpIMAPClient(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			messages, err := client.SearchMessages(argString(args, "folder", "INBOX"), argString(args, "query", ""), argInt(args, "max", 20))
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(messages)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "mail_get",
+		Description: "Get a message by UID including its body",
+		InputSchema: schema(map[string]interface{}{
+			"uid":    intProp("Message UID"),
+			"folder": stringProp("Folder name (default: INBOX)"),
+		}, "uid"),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, err := mcpIMAPClient(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			msg, err := client.GetMessage(argString(args, "folder", "INBOX"), uint32(argInt(args, "uid", 0)), false)
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(msg)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "cal_list",
+		Description: "List calendar events in a date range (defaults: today to +30 days)",
+		InputSchema: schema(map[string]interface{}{
+			"from": stringProp("Start date YYYY-MM-DD"),
+			"to":   stringProp("End date YYYY-MM-DD"),
+		}),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, calPath, err := getCalDAVClient(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			from := time.Now()
+			to := from.AddDate(0, 0, 30)
+			if s := argString(args, "from", ""); s != "" {
+				if t, err := time.Parse("2006-01-02", s); err == nil {
+					from = t
+				}
+			}
+			if s := argString(args, "to", ""); s != "" {
+				if t, err := time.Parse("2006-01-02", s); err == nil {
+					to = t
+				}
+			}
+			events, err := client.ListEvents(context.Background(), calPath, from, to)
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(events)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "contacts_search",
+		Description: "Search contacts by name, email, or phone",
+		InputSchema: schema(map[string]interface{}{
+			"query": stringProp("Search query"),
+		}, "query"),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, bookPath, err := getCardDAVClient(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			contacts, err := client.SearchContacts(context.Background(), bookPath, argString(args, "query", ""))
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(contacts)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "tasks_list",
+		Description: "List tasks, optionally including completed ones",
+		InputSchema: schema(map[string]interface{}{
+			"all": map[string]interface{}{"type": "boolean", "description": "Include completed tasks"},
+		}),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, listPath, err := getCalDAVClientForTasks(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			all, _ := args["all"].(bool)
+			tasks, err := client.ListTasks(context.Background(), listPath, all)
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(tasks)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "tasks_add",
+		Description: "Add a task with optional due date (YYYY-MM-DD) and priority (1-9)",
+		InputSchema: schema(map[string]interface{}{
+			"title":    stringProp("Task title"),
+			"due":      stringProp("Due date YYYY-MM-DD"),
+			"priority": intProp("Priority 1-9, 1 = highest"),
+		}, "title"),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, listPath, err := getCalDAVClientForTasks(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			task := newTaskFromArgs(args)
+			if err := client.CreateTask(context.Background(), listPath, task); err != nil {
+				return "", err
+			}
+			return mcpJSON(task)
+		},
+	})
+
+	server.Register(mcp.Tool{
+		Name:        "drive_list",
+		Description: "List files in a WebDAV directory",
+		InputSchema: schema(map[string]interface{}{
+			"path": stringProp("Directory path (default: /)"),
+		}),
+		Handler: func(args map[string]interface{}) (string, error) {
+			client, err := getWebDAVClient(root)
+			if err != nil {
+				return "", err
+			}
+			defer client.Close()
+			files, err := client.List(context.Background(), argString(args, "path", "/"))
+			if err != nil {
+				return "", err
+			}
+			return mcpJSON(files)
+		},
+	})
+}
+
+// newTaskFromArgs 从工具参数构造一个新任务。
+func newTaskFromArgs(args map[string]interface{}) *caldav.Task {
+	task := &caldav.Task{
+		UID:      generateTaskUID(),
+		Summary:  argString(args, "title", ""),
+		Priority: argInt(args, "priority", 0),
+		Status:   caldav.TaskStatusNeedsAction,
+	}
+	if due := argString(args, "due", ""); due != "" {
+		if t, err := parseTaskDate(due); err == nil {
+			task.Due = t
+		}
+	}
+	return task
+}
+
+// mcpIMAPClient 为 MCP 工具调用建立 IMAP 连接。
+func mcpIMAPClient(root *Root) (*imap.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	email := root.Account
+	if email == "" {
+		email = cfg.DefaultAccount
+	}
+	if email == "" {
+		return nil, fmt.Errorf("未指定账户")
+	}
+
+	acct, err := cfg.GetAccount(email)
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := cfg.GetPassword(email)
+	if err != nil {
+		return nil, fmt.Errorf("获取密码失败: %w", err)
+	}
+
+	client, err := imap.Connect(imap.Config{
+		Host:     acct.IMAP.Host,
+		Port:     acct.IMAP.Port,
+		TLS:      acct.IMAP.TLS,
+		Insecure: acct.IMAP.Insecure,
+		NoTLS:    acct.IMAP.NoTLS,
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("连接失败: %w", err)
+	}
+	return client, nil
+}
+
+// mcpJSON 将工具结果序列化为 JSON 文本。
+func mcpJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// argString 从工具参数中读取字符串，缺失时返回默认值。
+func argString(args map[string]interface{}, key, def string) string {
+	if v, ok := args[key].(string); ok && v != "" {
+		return v
+	}
+	return def
+}
+
+// argInt 从工具参数中读取整数，缺失时返回默认值。
+func argInt(args map[string]interface{}, key string, def int) int {
+	switch v := args[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return def
+}
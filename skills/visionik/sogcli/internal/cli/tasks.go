@@ -22,6 +22,8 @@ type TasksCmd struct {
 	Due     TasksDueCmd     `cmd:"" help:"Tasks due by date"`
 	Overdue TasksOverdueCmd `cmd:"" help:"Overdue tasks"`
 	Lists   TasksListsCmd   `cmd:"" help:"List task lists (calendars)"`
+
+	SyncTodotxt TasksSyncTodotxtCmd `cmd:"" name:"sync-todotxt" help:"Sync tasks to/from a todo.txt file"`
 }
 
 // TasksListCmd lists tasks.
@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/caldav"
+)
+
+// TasksSyncTodotxtCmd syncs tasks to/from a plain-text todo.txt file.
+// VTODOs map to todo.txt lines: priority 1-9 becomes (A)-(I), due dates
+// become due: tags, categories become +projects. A uid: tag keeps each
+// line matched to its CalDAV task across syncs.
+type TasksSyncTodotxtCmd struct {
+	File   string `arg:"" help:"Path to the todo.txt file"`
+	TwoWay bool   `help:"Also push file changes back to the server" name:"two-way"`
+	List   string `help:"Task list path (default: primary)"`
+}
+
+// todoItem is a parsed todo.txt line.
+type todoItem struct {
+	Completed bool
+	Priority  int // 1-9, 0 = none
+	Text      string
+	Projects  []string
+	Due       time.Time
+	UID       string
+}
+
+// Run executes the tasks sync-todotxt command.
+func (c *TasksSyncTodotxtCmd) Run(root *Root) error {
+	client, listPath, err := getCalDAVClientForTasks(root)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.List != "" {
+		listPath = c.List
+	}
+
+	ctx := context.Background()
+	tasks, err := client.ListTasks(ctx, listPath, true)
+	if err != nil {
+		return fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	byUID := make(map[string]*caldav.Task, len(tasks))
+	for i := range tasks {
+		byUID[tasks[i].UID] = &tasks[i]
+	}
+
+	var items []todoItem
+	if data, err := os.ReadFile(c.File); err == nil {
+		items = parseTodoFile(string(data))
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %w", c.File, err)
+	}
+
+	var created, completed, pulled int
+
+	if c.TwoWay {
+		for i := range items {
+			item := &items[i]
+
+			// Lines without a uid: tag are new tasks
+			if item.UID == "" {
+				task := &caldav.Task{
+					UID:        generateTaskUID(),
+					Summary:    item.Text,
+					Priority:   item.Priority,
+					Due:        item.Due,
+					Categories: item.Projects,
+					Status:     caldav.TaskStatusNeedsAction,
+				}
+				if item.Completed {
+					task.Status = caldav.TaskStatusCompleted
+					task.Completed = time.Now()
+				}
+				if err := client.CreateTask(ctx, listPath, task); err != nil {
+					return fmt.Errorf("failed to create task %q: %w", item.Text, err)
+				}
+				item.UID = task.UID
+				byUID[task.UID] = task
+				created++
+				continue
+			}
+
+			// Completion state flows file -> server
+			task, ok := byUID[item.UID]
+			if !ok {
+				continue
+			}
+			if item.Completed && task.Status != caldav.TaskStatusCompleted {
+				if err := client.CompleteTask(ctx, listPath, task.UID); err != nil {
+					return fmt.Errorf("failed to complete task %s: %w", task.UID, err)
+				}
+				task.Status = caldav.TaskStatusCompleted
+				completed++
+			}
+		}
+	}
+
+	// Completion state flows server -> file, and server-only tasks are pulled in
+	seen := make(map[string]bool, len(items))
+	for i := range items {
+		item := &items[i]
+		if item.UID == "" {
+			continue
+		}
+		seen[item.UID] = true
+		if task, ok := byUID[item.UID]; ok {
+			items[i] = taskToTodoItem(task)
+			if task.Status == caldav.TaskStatusCompleted && !item.Completed {
+				items[i].Completed = true
+			}
+		}
+	}
+	for _, task := range tasks {
+		if seen[task.UID] {
+			continue
+		}
+		t := task
+		items = append(items, taskToTodoItem(&t))
+		pulled++
+	}
+
+	// Stable order: open tasks by priority then text, completed last
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Completed != items[j].Completed {
+			return !items[i].Completed
+		}
+		pi, pj := items[i].Priority, items[j].Priority
+		if pi == 0 {
+			pi = 10
+		}
+		if pj == 0 {
+			pj = 10
+		}
+		if pi != pj {
+			return pi < pj
+		}
+		return items[i].Text < items[j].Text
+	})
+
+	var sb strings.Builder
+	for _, item := range items {
+		sb.WriteString(formatTodoLine(item))
+		sb.WriteString("\n")
+	}
+	if err := os.WriteFile(c.File, []byte(sb.String()), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", c.File, err)
+	}
+
+	if root.JSON {
+		fmt.Printf(`{"file":"%s","tasks":%d,"pulled":%d,"created":%d,"completed":%d}`+"\n",
+			c.File, len(items), pulled, created, completed)
+		return nil
+	}
+
+	fmt.Printf("Synced %d tasks to %s", len(items), c.File)
+	if c.TwoWay {
+		fmt.Printf(" (created %d, completed %d on server)", created, completed)
+	}
+	fmt.Println("")
+	return nil
+}
+
+// taskToTodoItem converts a CalDAV task to a todo.txt item.
+func taskToTodoItem(task *caldav.Task) todoItem {
+	return todoItem{
+		Completed: task.Status == caldav.TaskStatusCompleted,
+		Priority:  task.Priority,
+		Text:      task.Summary,
+		Projects:  task.Categories,
+		Due:       task.Due,
+		UID:       task.UID,
+	}
+}
+
+// formatTodoLine renders a todo.txt line.
+func formatTodoLine(item todoItem) string {
+	var parts []string
+	if item.Completed {
+		parts = append(parts, "x")
+	}
+	if p := priorityToTodoLetter(item.Priority); p != "" {
+		parts = append(parts, "("+p+")")
+	}
+	parts = append(parts, item.Text)
+	for _, proj := range item.Projects {
+		parts = append(parts, "+"+strings.ReplaceAll(proj, " ", "_"))
+	}
+	if !item.Due.IsZero() {
+		parts = append(parts, "due:"+item.Due.Format("2006-01-02"))
+	}
+	if item.UID != "" {
+		parts = append(parts, "uid:"+item.UID)
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseTodoFile parses a todo.txt file into items.
+func parseTodoFile(data string) []todoItem {
+	var items []todoItem
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		items = append(items, parseTodoLine(line))
+	}
+	return items
+}
+
+// parseTodoLine parses a single todo.txt line.
+func parseTodoLine(line string) todoItem {
+	item := todoItem{}
+
+	if strings.HasPrefix(line, "x ") {
+		item.Completed = true
+		line = strings.TrimSpace(line[2:])
+	}
+
+	if len(line) >= 3 && line[0] == '(' && line[2] == ')' && line[1] >= 'A' && line[1] <= 'Z' {
+		item.Priority = todoLetterToPriority(line[1])
+		line = strings.TrimSpace(line[3:])
+	}
+
+	var textParts []string
+	for _, word := range strings.Fields(line) {
+		switch {
+		case strings.HasPrefix(word, "+") && len(word) > 1:
+			item.Projects = append(item.Projects, strings.TrimPrefix(word, "+"))
+		case strings.HasPrefix(word, "due:"):
+			if t, err := time.Parse("2006-01-02", strings.TrimPrefix(word, "due:")); err == nil {
+				item.Due = t
+			}
+		case strings.HasPrefix(word, "uid:"):
+			item.UID = strings.TrimPrefix(word, "uid:")
+		default:
+			textParts = append(textParts, word)
+		}
+	}
+	item.Text = strings.Join(textParts, " ")
+	return item
+}
+
+// priorityToTodoLetter maps iCalendar priority 1-9 to todo.txt (A)-(I).
+func priorityToTodoLetter(priority int) string {
+	if priority < 1 || priority > 9 {
+		return ""
+	}
+	return string(rune('A' + priority - 1))
+}
+
+// todoLetterToPriority maps todo.txt priority letters back to 1-9.
+func todoLetterToPriority(letter byte) int {
+	p := int(letter-'A') + 1
+	if p > 9 {
+		p = 9
+	}
+	return p
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
+
+	"github.com/visionik/sogcli/internal/retry"
 )
 
 // Config 保存 sog 的配置信息。
@@ -13,9 +16,47 @@ type Config struct {
 	Accounts       map[string]Account `json:"accounts"`
 	DefaultAccount string             `json:"default_account,omitempty"`
 	Storage        string             `json:"storage,omitempty"` // keychain 或 file
+	Defaults       map[string]string  `json:"defaults,omitempty"`
+	Profiles       map[string]Profile `json:"profiles,omitempty"`
 	path           string
 }
 
+// Profile 是一组命名配置, 通过 --profile (或 SOG_PROFILE) 选择。
+// 其 Defaults 会覆盖顶层 defaults 中的同名键。
+type Profile struct {
+	DefaultAccount string            `json:"default_account,omitempty"`
+	Defaults       map[string]string `json:"defaults,omitempty"`
+}
+
+// activeProfile 是本次调用选中的配置档案, 在 Load 之前通过
+// SetProfile 设置 (与存储类型的全局配置方式一致)。
+var activeProfile string
+
+// SetProfile 设置本次调用要应用的配置档案名称。
+func SetProfile(name string) {
+	activeProfile = name
+}
+
+// applyProfile 将命名配置档案叠加到配置上。
+func (c *Config) applyProfile(name string) error {
+	p, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("找不到配置档案: %s", name)
+	}
+	if p.DefaultAccount != "" {
+		c.DefaultAccount = p.DefaultAccount
+	}
+	if len(p.Defaults) > 0 {
+		if c.Defaults == nil {
+			c.Defaults = make(map[string]string, len(p.Defaults))
+		}
+		for k, v := range p.Defaults {
+			c.Defaults[k] = v
+		}
+	}
+	return nil
+}
+
 // Account 保存邮件账户的配置信息。
 type Account struct {
 	Email   string        `json:"email"`
@@ -24,6 +65,37 @@ type Account struct {
 	CalDAV  CalDAVConfig  `json:"caldav,omitempty"`
 	CardDAV CardDAVConfig `json:"carddav,omitempty"`
 	WebDAV  WebDAVConfig  `json:"webdav,omitempty"`
+	JMAP    JMAPConfig    `json:"jmap,omitempty"`
+	Retry   RetryConfig   `json:"retry,omitempty"`
+	Spam    SpamConfig    `json:"spam,omitempty"`
+	DKIM    DKIMConfig    `json:"dkim,omitempty"`
+}
+
+// DKIMConfig 保存直连发送时的 DKIM 签名配置。
+// 设置 key_file 后, 经该账户外发的邮件会在提交前由 sog 签名。
+type DKIMConfig struct {
+	KeyFile  string `json:"key_file,omitempty"` // PEM 格式的 RSA 或 Ed25519 私钥路径
+	Selector string `json:"selector,omitempty"` // DNS 选择器, 如 "mail"
+	Domain   string `json:"domain,omitempty"`   // 签名域, 默认取发件地址的域名
+}
+
+// SpamConfig 保存垃圾邮件报告与训练的配置。
+type SpamConfig struct {
+	Folder    string `json:"folder,omitempty"`     // 垃圾邮件文件夹 (默认 Junk)
+	LearnSpam string `json:"learn_spam,omitempty"` // 训练命令, 如 "sa-learn --spam"
+	LearnHam  string `json:"learn_ham,omitempty"`  // 训练命令, 如 "sa-learn --ham"
+}
+
+// RetryConfig 保存该账户的重试策略。零值使用默认值。
+type RetryConfig struct {
+	MaxAttempts int `json:"max_attempts,omitempty"`  // 总尝试次数 (默认 3)
+	BaseDelayMS int `json:"base_delay_ms,omitempty"` // 首次重试前的延迟毫秒数 (默认 500)
+}
+
+// JMAPConfig 保存 JMAP 服务器配置。
+// 配置后，邮件的列出/获取/搜索/发送将透明地使用 JMAP 而非 IMAP/SMTP。
+type JMAPConfig struct {
+	URL string `json:"url,omitempty"` // 会话资源 URL
 }
 
 // CalDAVConfig 保存 CalDAV 服务器配置。
@@ -62,6 +134,18 @@ func configDir() (string, error) {
 	return filepath.Join(home, ".config", "sog"), nil
 }
 
+// CachePath 返回配置目录下缓存文件的路径, 并确保目录存在。
+func CachePath(name string) (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
 // configPath 返回配置文件的路径。
 func configPath() (string, error) {
 	dir, err := configDir()
@@ -104,6 +188,13 @@ func Load() (*Config, error) {
 		SetStorageType(StorageKeyring)
 	}
 
+	// 应用选中的配置档案
+	if activeProfile != "" {
+		if err := cfg.applyProfile(activeProfile); err != nil {
+			return nil, err
+		}
+	}
+
 	return cfg, nil
 }
 
@@ -153,6 +244,13 @@ func (c *Config) GetAccount(email string) (*Account, error) {
 	if !ok {
 		return nil, fmt.Errorf("找不到账户: %s", email)
 	}
+
+	// 应用该账户的重试策略 (与 Load 设置存储类型的方式一致)。
+	retry.Configure(retry.Policy{
+		MaxAttempts: acct.Retry.MaxAttempts,
+		BaseDelay:   time.Duration(acct.Retry.BaseDelayMS) * time.Millisecond,
+	})
+
 	return &acct, nil
 }
 
@@ -41,6 +41,7 @@ const (
 	ProtocolCalDAV  Protocol = "caldav"
 	ProtocolCardDAV Protocol = "carddav"
 	ProtocolWebDAV  Protocol = "webdav"
+	ProtocolJMAP    Protocol = "jmap"
 )
 
 // credentialsFilePath returns the path to the credentials file.
@@ -9,6 +9,9 @@ import (
 
 	"github.com/emersion/go-imap/v2"
 	"github.com/emersion/go-imap/v2/imapclient"
+
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
 )
 
 // Client wraps an IMAP connection.
@@ -33,24 +36,31 @@ func Connect(cfg Config) (*Client, error) {
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 
 	var client *imapclient.Client
-	var err error
-
-	if cfg.NoTLS {
-		// Plain text connection
-		client, err = imapclient.DialInsecure(addr, nil)
-	} else if cfg.TLS {
-		// TLS connection
-		tlsConfig := &tls.Config{
-			ServerName:         cfg.Host,
-			InsecureSkipVerify: cfg.Insecure,
-		}
-		opts := &imapclient.Options{
-			TLSConfig: tlsConfig,
+
+	opts := &imapclient.Options{}
+	if log.DebugEnabled() {
+		opts.DebugWriter = log.NewProtocolWriter("IMAP")
+	}
+
+	// Dialing retries transient network failures per the account's
+	// retry policy; login errors (bad credentials) do not.
+	err := retry.Do(func() error {
+		var err error
+		if cfg.NoTLS {
+			// Plain text connection
+			client, err = imapclient.DialInsecure(addr, opts)
+		} else if cfg.TLS {
+			// TLS connection
+			opts.TLSConfig = &tls.Config{
+				ServerName:         cfg.Host,
+				InsecureSkipVerify: cfg.Insecure,
+			}
+			client, err = imapclient.DialTLS(addr, opts)
+		} else {
+			client, err = imapclient.DialInsecure(addr, opts)
 		}
-		client, err = imapclient.DialTLS(addr, opts)
-	} else {
-		client, err = imapclient.DialInsecure(addr, nil)
-	}
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect: %w", err)
 	}
@@ -98,7 +108,14 @@ type Message struct {
 	Body    string
 }
 
+// listFetchWindow is the maximum sequence range covered by a single FETCH.
+// Large folders are walked in windows so no single response balloons; the
+// per-window commands are issued back to back and pipeline on the wire.
+const listFetchWindow = 500
+
 // ListMessages returns messages from a folder.
+// Only ENVELOPE, FLAGS and INTERNALDATE are fetched; bodies are fetched
+// lazily by GetMessage.
 func (c *Client) ListMessages(folder string, max int, unseenOnly bool) ([]Message, error) {
 	// Select mailbox
 	selectData, err := c.client.Select(folder, nil).Wait()
@@ -111,64 +128,112 @@ func (c *Client) ListMessages(folder string, max int, unseenOnly bool) ([]Messag
 		return nil, nil
 	}
 
-	// Build sequence set for last N messages
+	// For unseen-only, SEARCH first so we only fetch what we will show.
+	if unseenOnly {
+		criteria := &imap.SearchCriteria{NotFlag: []imap.Flag{imap.FlagSeen}}
+		searchData, err := c.client.UIDSearch(criteria, nil).Wait()
+		if err != nil {
+			return nil, fmt.Errorf("failed to search: %w", err)
+		}
+		uids := searchData.AllUIDs()
+		if len(uids) == 0 {
+			return nil, nil
+		}
+		if len(uids) > max {
+			uids = uids[len(uids)-max:]
+		}
+		uidSet := imap.UIDSet{}
+		for _, uid := range uids {
+			uidSet.AddNum(uid)
+		}
+		fetchCmd := c.client.Fetch(uidSet, listFetchOptions())
+		return collectListFetch([]*imapclient.FetchCommand{fetchCmd}, max)
+	}
+
+	// Build windowed sequence sets for the last N messages. Issuing all
+	// FETCH commands before collecting lets them pipeline.
 	start := uint32(1)
 	if selectData.NumMessages > uint32(max) {
 		start = selectData.NumMessages - uint32(max) + 1
 	}
-	seqSet := imap.SeqSet{}
-	seqSet.AddRange(start, selectData.NumMessages)
 
-	// Fetch messages
-	fetchOptions := &imap.FetchOptions{
-		Flags:    true,
-		Envelope: true,
-		UID:      true,
+	var fetchCmds []*imapclient.FetchCommand
+	for lo := start; lo <= selectData.NumMessages; lo += listFetchWindow {
+		hi := lo + listFetchWindow - 1
+		if hi > selectData.NumMessages {
+			hi = selectData.NumMessages
+		}
+		seqSet := imap.SeqSet{}
+		seqSet.AddRange(lo, hi)
+		fetchCmds = append(fetchCmds, c.client.Fetch(seqSet, listFetchOptions()))
 	}
 
-	fetchCmd := c.client.Fetch(seqSet, fetchOptions)
+	return collectListFetch(fetchCmds, max)
+}
+
+// listFetchOptions returns the minimal FETCH items needed for a listing.
+func listFetchOptions() *imap.FetchOptions {
+	return &imap.FetchOptions{
+		Flags:        true,
+		Envelope:     true,
+		InternalDate: true,
+		UID:          true,
+	}
+}
+
+// collectListFetch drains one or more pipelined FETCH commands into messages.
+func collectListFetch(fetchCmds []*imapclient.FetchCommand, max int) ([]Message, error) {
 	messages := make([]Message, 0, max)
 
-	for {
-		msgData := fetchCmd.Next()
-		if msgData == nil {
-			break
-		}
+	for _, fetchCmd := range fetchCmds {
+		for {
+			msgData := fetchCmd.Next()
+			if msgData == nil {
+				break
+			}
 
-		buf, err := msgData.Collect()
-		if err != nil {
-			continue
-		}
+			buf, err := msgData.Collect()
+			if err != nil {
+				continue
+			}
 
-		m := Message{
-			UID: uint32(buf.UID),
-		}
+			m := Message{
+				UID: uint32(buf.UID),
+			}
 
-		if buf.Envelope != nil {
-			m.Subject = buf.Envelope.Subject
-			m.Date = buf.Envelope.Date.Format("Jan 02")
-			if len(buf.Envelope.From) > 0 {
-				from := buf.Envelope.From[0]
-				if from.Name != "" {
-					m.From = from.Name
-				} else {
-					m.From = from.Addr()
+			if buf.Envelope != nil {
+				m.Subject = buf.Envelope.Subject
+				m.Date = buf.Envelope.Date.Format("Jan 02")
+				if len(buf.Envelope.From) > 0 {
+					from := buf.Envelope.From[0]
+					if from.Name != "" {
+						m.From = from.Name
+					} else {
+						m.From = from.Addr()
+					}
 				}
 			}
-		}
 
-		for _, f := range buf.Flags {
-			if f == imap.FlagSeen {
-				m.Seen = true
-				break
+			// Fall back to INTERNALDATE when the envelope has no date.
+			if m.Date == "" || (buf.Envelope != nil && buf.Envelope.Date.IsZero()) {
+				if !buf.InternalDate.IsZero() {
+					m.Date = buf.InternalDate.Format("Jan 02")
+				}
 			}
-		}
 
-		messages = append(messages, m)
-	}
+			for _, f := range buf.Flags {
+				if f == imap.FlagSeen {
+					m.Seen = true
+					break
+				}
+			}
 
-	if err := fetchCmd.Close(); err != nil {
-		return nil, fmt.Errorf("failed to fetch: %w", err)
+			messages = append(messages, m)
+		}
+
+		if err := fetchCmd.Close(); err != nil {
+			return nil, fmt.Errorf("failed to fetch: %w", err)
+		}
 	}
 
 	return messages, nil
@@ -662,3 +727,59 @@ func (c *Client) Idle(folder string, callback func(msgCount uint32)) error {
 		}
 	}
 }
+
+// Address is a name/email pair taken from a message envelope.
+type Address struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
+// ListRecipients returns the To/Cc addresses of the most recent messages
+// in a folder (typically Sent), newest first, for recipient suggestion.
+func (c *Client) ListRecipients(folder string, max int) ([]Address, error) {
+	selectData, err := c.client.Select(folder, nil).Wait()
+	if err != nil {
+		return nil, fmt.Errorf("failed to select folder: %w", err)
+	}
+	if selectData.NumMessages == 0 {
+		return nil, nil
+	}
+
+	start := uint32(1)
+	if selectData.NumMessages > uint32(max) {
+		start = selectData.NumMessages - uint32(max) + 1
+	}
+	seqSet := imap.SeqSet{}
+	seqSet.AddRange(start, selectData.NumMessages)
+
+	fetchCmd := c.client.Fetch(seqSet, &imap.FetchOptions{Envelope: true})
+
+	var addrs []Address
+	for {
+		msgData := fetchCmd.Next()
+		if msgData == nil {
+			break
+		}
+		buf, err := msgData.Collect()
+		if err != nil || buf.Envelope == nil {
+			continue
+		}
+		for _, list := range [][]imap.Address{buf.Envelope.To, buf.Envelope.Cc} {
+			for _, a := range list {
+				if addr := a.Addr(); addr != "" {
+					addrs = append(addrs, Address{Name: a.Name, Email: addr})
+				}
+			}
+		}
+	}
+	if err := fetchCmd.Close(); err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+
+	// Newest messages last in sequence order; reverse so recent
+	// recipients come first.
+	for i, j := 0, len(addrs)-1; i < j; i, j = i+1, j-1 {
+		addrs[i], addrs[j] = addrs[j], addrs[i]
+	}
+	return addrs, nil
+}
@@ -314,6 +314,21 @@ func parseParticipant(prop *ical.Prop) Participant {
 	return p
 }
 
+// ExtractCalendar extracts the first iCalendar block embedded in a raw
+// message body. Returns nil if no BEGIN:VCALENDAR/END:VCALENDAR pair is found.
+func ExtractCalendar(raw []byte) []byte {
+	s := string(raw)
+	start := strings.Index(s, "BEGIN:VCALENDAR")
+	if start < 0 {
+		return nil
+	}
+	end := strings.Index(s[start:], "END:VCALENDAR")
+	if end < 0 {
+		return nil
+	}
+	return []byte(s[start : start+end+len("END:VCALENDAR")])
+}
+
 // GenerateUID generates a unique identifier for a meeting.
 func GenerateUID(domain string) string {
 	return fmt.Sprintf("%d-%d@%s", time.Now().UnixNano(), time.Now().Unix(), domain)
@@ -0,0 +1,562 @@
+// Package jmap provides a JMAP (RFC 8620/8621) mail client.
+// JMAP is much faster than IMAP for listing and search because queries and
+// fetches batch into a single HTTP round trip.
+package jmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
+)
+
+const (
+	usingCore       = "urn:ietf:params:jmap:core"
+	usingMail       = "urn:ietf:params:jmap:mail"
+	usingSubmission = "urn:ietf:params:jmap:submission"
+)
+
+// Config holds JMAP connection configuration.
+type Config struct {
+	URL      string // Session resource URL (e.g. https://api.fastmail.com/jmap/session)
+	Email    string
+	Password string
+}
+
+// Client wraps a JMAP session.
+type Client struct {
+	httpClient *http.Client
+	cfg        Config
+	apiURL     string
+	accountID  string
+}
+
+// Message represents an email message, mirroring the fields the CLI shows.
+type Message struct {
+	ID      string
+	Subject string
+	From    string
+	To      string
+	Date    string
+	Seen    bool
+	Body    string
+}
+
+// session is the JMAP session resource.
+type session struct {
+	APIURL          string            `json:"apiUrl"`
+	PrimaryAccounts map[string]string `json:"primaryAccounts"`
+}
+
+// Connect fetches the session resource and resolves the mail account.
+func Connect(cfg Config) (*Client, error) {
+	c := &Client{
+		httpClient: retry.HTTPClient(log.HTTPClient(&http.Client{Timeout: 30 * time.Second}, "JMAP")),
+		cfg:        cfg,
+	}
+
+	req, err := http.NewRequest("GET", cfg.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(cfg.Email, cfg.Password)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch session: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("session request failed: %s", resp.Status)
+	}
+
+	var sess session
+	if err := json.NewDecoder(resp.Body).Decode(&sess); err != nil {
+		return nil, fmt.Errorf("failed to decode session: %w", err)
+	}
+
+	c.apiURL = sess.APIURL
+	c.accountID = sess.PrimaryAccounts[usingMail]
+	if c.apiURL == "" || c.accountID == "" {
+		return nil, fmt.Errorf("server does not advertise a JMAP mail account")
+	}
+
+	return c, nil
+}
+
+// Close closes the client. JMAP is stateless HTTP, nothing to do.
+func (c *Client) Close() error {
+	return nil
+}
+
+// invocation is a single JMAP method call or response.
+type invocation struct {
+	Name   string
+	Args   json.RawMessage
+	CallID string
+}
+
+func (inv invocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]interface{}{inv.Name, inv.Args, inv.CallID})
+}
+
+func (inv *invocation) UnmarshalJSON(data []byte) error {
+	var parts [3]json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(parts[0], &inv.Name); err != nil {
+		return err
+	}
+	inv.Args = parts[1]
+	return json.Unmarshal(parts[2], &inv.CallID)
+}
+
+// call performs a JMAP API request with the given method calls and returns
+// the method responses keyed by call ID.
+func (c *Client) call(calls []invocation) (map[string]invocation, error) {
+	return c.callUsing([]string{usingCore, usingMail}, calls)
+}
+
+// callUsing is call with an explicit capability list, for methods (like
+// EmailSubmission/set) that need more than core+mail declared.
+func (c *Client) callUsing(using []string, calls []invocation) (map[string]invocation, error) {
+	reqBody := map[string]interface{}{
+		"using":       using,
+		"methodCalls": calls,
+	}
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.apiURL, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.cfg.Email, c.cfg.Password)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("API request failed: %s", resp.Status)
+	}
+
+	var apiResp struct {
+		MethodResponses []invocation `json:"methodResponses"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	responses := make(map[string]invocation, len(apiResp.MethodResponses))
+	for _, inv := range apiResp.MethodResponses {
+		if inv.Name == "error" {
+			var jerr struct {
+				Type        string `json:"type"`
+				Description string `json:"description"`
+			}
+			_ = json.Unmarshal(inv.Args, &jerr)
+			return nil, fmt.Errorf("JMAP error %s: %s", jerr.Type, jerr.Description)
+		}
+		responses[inv.CallID] = inv
+	}
+	return responses, nil
+}
+
+// mailboxID resolves a folder name (or the special INBOX) to a mailbox ID.
+func (c *Client) mailboxID(folder string) (string, error) {
+	args, _ := json.Marshal(map[string]interface{}{
+		"accountId":  c.accountID,
+		"properties": []string{"id", "name", "role"},
+	})
+	responses, err := c.call([]invocation{{Name: "Mailbox/get", Args: args, CallID: "mb"}})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		List []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Role string `json:"role"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(responses["mb"].Args, &result); err != nil {
+		return "", err
+	}
+
+	for _, mb := range result.List {
+		if strings.EqualFold(mb.Name, folder) {
+			return mb.ID, nil
+		}
+		if strings.EqualFold(folder, "INBOX") && mb.Role == "inbox" {
+			return mb.ID, nil
+		}
+	}
+	return "", fmt.Errorf("mailbox not found: %s", folder)
+}
+
+// emailProperties are the fields fetched for listings.
+var emailProperties = []string{"id", "subject", "from", "to", "receivedAt", "keywords"}
+
+// queryAndGet runs Email/query with the given filter and a back-referenced
+// Email/get in one round trip, returning the decoded messages.
+func (c *Client) queryAndGet(filter map[string]interface{}, max int, withBody bool) ([]Message, error) {
+	props := emailProperties
+	getArgs := map[string]interface{}{
+		"accountId": c.accountID,
+		"#ids": map[string]string{
+			"resultOf": "q",
+			"name":     "Email/query",
+			"path":     "/ids",
+		},
+	}
+	if withBody {
+		props = append(append([]string{}, props...), "bodyValues", "textBody")
+		getArgs["fetchTextBodyValues"] = true
+	}
+	getArgs["properties"] = props
+
+	queryArgs, _ := json.Marshal(map[string]interface{}{
+		"accountId": c.accountID,
+		"filter":    filter,
+		"sort": []map[string]interface{}{
+			{"property": "receivedAt", "isAscending": false},
+		},
+		"limit": max,
+	})
+	getArgsJSON, _ := json.Marshal(getArgs)
+
+	responses, err := c.call([]invocation{
+		{Name: "Email/query", Args: queryArgs, CallID: "q"},
+		{Name: "Email/get", Args: getArgsJSON, CallID: "g"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeEmails(responses["g"].Args)
+}
+
+// jmapEmail is the wire form of an Email object.
+type jmapEmail struct {
+	ID         string          `json:"id"`
+	Subject    string          `json:"subject"`
+	From       []jmapAddress   `json:"from"`
+	To         []jmapAddress   `json:"to"`
+	ReceivedAt string          `json:"receivedAt"`
+	Keywords   map[string]bool `json:"keywords"`
+	TextBody   []jmapBodyPart  `json:"textBody"`
+	BodyValues map[string]struct {
+		Value string `json:"value"`
+	} `json:"bodyValues"`
+}
+
+type jmapAddress struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+type jmapBodyPart struct {
+	PartID string `json:"partId"`
+}
+
+// decodeEmails converts an Email/get response into Messages.
+func decodeEmails(args json.RawMessage) ([]Message, error) {
+	var result struct {
+		List []jmapEmail `json:"list"`
+	}
+	if err := json.Unmarshal(args, &result); err != nil {
+		return nil, err
+	}
+
+	messages := make([]Message, 0, len(result.List))
+	for _, e := range result.List {
+		messages = append(messages, toMessage(e))
+	}
+	return messages, nil
+}
+
+// toMessage converts a wire email to the CLI-facing Message.
+func toMessage(e jmapEmail) Message {
+	m := Message{
+		ID:      e.ID,
+		Subject: e.Subject,
+		Seen:    e.Keywords["$seen"],
+	}
+	if t, err := time.Parse(time.RFC3339, e.ReceivedAt); err == nil {
+		m.Date = t.Format("Jan 02")
+	} else {
+		m.Date = e.ReceivedAt
+	}
+	if len(e.From) > 0 {
+		if e.From[0].Name != "" {
+			m.From = e.From[0].Name
+		} else {
+			m.From = e.From[0].Email
+		}
+	}
+	if len(e.To) > 0 {
+		m.To = e.To[0].Email
+	}
+	for _, part := range e.TextBody {
+		if bv, ok := e.BodyValues[part.PartID]; ok {
+			m.Body += bv.Value
+		}
+	}
+	return m
+}
+
+// ListMessages returns the most recent messages from a folder.
+func (c *Client) ListMessages(folder string, max int, unseenOnly bool) ([]Message, error) {
+	mbID, err := c.mailboxID(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := map[string]interface{}{"inMailbox": mbID}
+	if unseenOnly {
+		filter = map[string]interface{}{
+			"operator": "AND",
+			"conditions": []map[string]interface{}{
+				{"inMailbox": mbID},
+				{"notKeyword": "$seen"},
+			},
+		}
+	}
+	return c.queryAndGet(filter, max, false)
+}
+
+// GetMessage fetches a single message, including its text body.
+func (c *Client) GetMessage(id string) (*Message, error) {
+	getArgs, _ := json.Marshal(map[string]interface{}{
+		"accountId":           c.accountID,
+		"ids":                 []string{id},
+		"properties":          append(append([]string{}, emailProperties...), "bodyValues", "textBody"),
+		"fetchTextBodyValues": true,
+	})
+	responses, err := c.call([]invocation{{Name: "Email/get", Args: getArgs, CallID: "g"}})
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := decodeEmails(responses["g"].Args)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("message not found: %s", id)
+	}
+	return &messages[0], nil
+}
+
+// SearchMessages searches a folder using the same simple query syntax as
+// the IMAP backend (FROM, TO, SUBJECT, TEXT, UNSEEN, free text).
+func (c *Client) SearchMessages(folder, query string, max int) ([]Message, error) {
+	mbID, err := c.mailboxID(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := []map[string]interface{}{{"inMailbox": mbID}}
+	tokens := strings.Fields(query)
+	for i := 0; i < len(tokens); i++ {
+		switch strings.ToUpper(tokens[i]) {
+		case "ALL":
+			// No extra condition
+		case "FROM":
+			if i+1 < len(tokens) {
+				i++
+				conditions = append(conditions, map[string]interface{}{"from": tokens[i]})
+			}
+		case "TO":
+			if i+1 < len(tokens) {
+				i++
+				conditions = append(conditions, map[string]interface{}{"to": tokens[i]})
+			}
+		case "SUBJECT":
+			if i+1 < len(tokens) {
+				i++
+				conditions = append(conditions, map[string]interface{}{"subject": tokens[i]})
+			}
+		case "TEXT", "BODY":
+			if i+1 < len(tokens) {
+				i++
+				conditions = append(conditions, map[string]interface{}{"text": tokens[i]})
+			}
+		case "UNSEEN", "UNREAD":
+			conditions = append(conditions, map[string]interface{}{"notKeyword": "$seen"})
+		case "SEEN", "READ":
+			conditions = append(conditions, map[string]interface{}{"hasKeyword": "$seen"})
+		case "FLAGGED", "STARRED":
+			conditions = append(conditions, map[string]interface{}{"hasKeyword": "$flagged"})
+		default:
+			conditions = append(conditions, map[string]interface{}{"text": tokens[i]})
+		}
+	}
+
+	filter := map[string]interface{}{
+		"operator":   "AND",
+		"conditions": conditions,
+	}
+	return c.queryAndGet(filter, max, false)
+}
+
+// SendMessage creates a draft email and submits it for delivery
+// (Email/set + EmailSubmission/set in a single request).
+func (c *Client) SendMessage(from string, to, cc, bcc []string, subject, body string) error {
+	draftsID, err := c.mailboxRole("drafts")
+	if err != nil {
+		return err
+	}
+	identityID, err := c.identityID(from)
+	if err != nil {
+		return err
+	}
+
+	addr := func(emails []string) []jmapAddress {
+		out := make([]jmapAddress, 0, len(emails))
+		for _, e := range emails {
+			out = append(out, jmapAddress{Email: e})
+		}
+		return out
+	}
+
+	email := map[string]interface{}{
+		"mailboxIds": map[string]bool{draftsID: true},
+		"keywords":   map[string]bool{"$draft": true},
+		"from":       addr([]string{from}),
+		"to":         addr(to),
+		"subject":    subject,
+		"bodyStructure": map[string]interface{}{
+			"type":   "text/plain",
+			"partId": "body",
+		},
+		"bodyValues": map[string]interface{}{
+			"body": map[string]string{"value": body},
+		},
+	}
+	if len(cc) > 0 {
+		email["cc"] = addr(cc)
+	}
+	if len(bcc) > 0 {
+		email["bcc"] = addr(bcc)
+	}
+
+	setArgs, _ := json.Marshal(map[string]interface{}{
+		"accountId": c.accountID,
+		"create":    map[string]interface{}{"draft": email},
+	})
+	submitArgs, _ := json.Marshal(map[string]interface{}{
+		"accountId": c.accountID,
+		"create": map[string]interface{}{
+			"submission": map[string]interface{}{
+				"identityId": identityID,
+				"emailId":    "#draft",
+			},
+		},
+	})
+
+	responses, err := c.callUsing([]string{usingCore, usingMail, usingSubmission}, []invocation{
+		{Name: "Email/set", Args: setArgs, CallID: "set"},
+		{Name: "EmailSubmission/set", Args: submitArgs, CallID: "submit"},
+	})
+	if err != nil {
+		return err
+	}
+
+	if e, ok := notCreated(responses["set"].Args, "draft"); ok {
+		return fmt.Errorf("draft creation failed %s: %s", e.Type, e.Description)
+	}
+	if e, ok := notCreated(responses["submit"].Args, "submission"); ok {
+		return fmt.Errorf("submission failed %s: %s", e.Type, e.Description)
+	}
+	return nil
+}
+
+// setError is a per-object error from a */set response.
+type setError struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// notCreated extracts the notCreated entry for a creation ID, if any.
+func notCreated(args json.RawMessage, id string) (setError, bool) {
+	var result struct {
+		NotCreated map[string]setError `json:"notCreated"`
+	}
+	if err := json.Unmarshal(args, &result); err != nil {
+		return setError{}, false
+	}
+	e, ok := result.NotCreated[id]
+	return e, ok
+}
+
+// identityID resolves the sending identity whose address matches from.
+// RFC 8621 requires identityId on EmailSubmission creation.
+func (c *Client) identityID(from string) (string, error) {
+	args, _ := json.Marshal(map[string]interface{}{"accountId": c.accountID})
+	responses, err := c.callUsing([]string{usingCore, usingMail, usingSubmission},
+		[]invocation{{Name: "Identity/get", Args: args, CallID: "id"}})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		List []struct {
+			ID    string `json:"id"`
+			Email string `json:"email"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(responses["id"].Args, &result); err != nil {
+		return "", err
+	}
+
+	for _, ident := range result.List {
+		if strings.EqualFold(ident.Email, from) {
+			return ident.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no sending identity for %s", from)
+}
+
+// mailboxRole resolves a mailbox by role (e.g. "drafts", "inbox").
+func (c *Client) mailboxRole(role string) (string, error) {
+	args, _ := json.Marshal(map[string]interface{}{
+		"accountId":  c.accountID,
+		"properties": []string{"id", "role"},
+	})
+	responses, err := c.call([]invocation{{Name: "Mailbox/get", Args: args, CallID: "mb"}})
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		List []struct {
+			ID   string `json:"id"`
+			Role string `json:"role"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(responses["mb"].Args, &result); err != nil {
+		return "", err
+	}
+
+	for _, mb := range result.List {
+		if mb.Role == role {
+			return mb.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no mailbox with role %q", role)
+}
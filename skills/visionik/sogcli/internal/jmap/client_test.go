@@ -0,0 +1,226 @@
+package jmap
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jmapRequest is the decoded body of an API request.
+type jmapRequest struct {
+	Using       []string     `json:"using"`
+	MethodCalls []invocation `json:"methodCalls"`
+}
+
+// fakeServer runs a JMAP API endpoint that answers each method call via
+// respond and records every request body for assertions.
+func fakeServer(t *testing.T, respond func(inv invocation) interface{}) (*Client, *[]jmapRequest, func()) {
+	t.Helper()
+	var seen []jmapRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jmapRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		seen = append(seen, req)
+
+		responses := make([]invocation, 0, len(req.MethodCalls))
+		for _, call := range req.MethodCalls {
+			args, err := json.Marshal(respond(call))
+			require.NoError(t, err)
+			responses = append(responses, invocation{Name: call.Name, Args: args, CallID: call.CallID})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"methodResponses": responses})
+	}))
+
+	c := &Client{
+		httpClient: srv.Client(),
+		cfg:        Config{Email: "user@example.com", Password: "secret"},
+		apiURL:     srv.URL,
+		accountID:  "acct-1",
+	}
+	return c, &seen, srv.Close
+}
+
+// mailboxes is a canned Mailbox/get response body.
+func mailboxes() interface{} {
+	return map[string]interface{}{
+		"list": []map[string]string{
+			{"id": "mb-in", "name": "Inbox", "role": "inbox"},
+			{"id": "mb-dr", "name": "Drafts", "role": "drafts"},
+			{"id": "mb-ar", "name": "Archive"},
+		},
+	}
+}
+
+func TestMailboxID(t *testing.T) {
+	c, _, cleanup := fakeServer(t, func(inv invocation) interface{} {
+		require.Equal(t, "Mailbox/get", inv.Name)
+		return mailboxes()
+	})
+	defer cleanup()
+
+	id, err := c.mailboxID("INBOX")
+	require.NoError(t, err)
+	assert.Equal(t, "mb-in", id)
+
+	id, err = c.mailboxID("archive")
+	require.NoError(t, err)
+	assert.Equal(t, "mb-ar", id)
+
+	_, err = c.mailboxID("nope")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mailbox not found")
+}
+
+func TestMailboxRole(t *testing.T) {
+	c, _, cleanup := fakeServer(t, func(inv invocation) interface{} {
+		return mailboxes()
+	})
+	defer cleanup()
+
+	id, err := c.mailboxRole("drafts")
+	require.NoError(t, err)
+	assert.Equal(t, "mb-dr", id)
+
+	_, err = c.mailboxRole("junk")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `no mailbox with role "junk"`)
+}
+
+func TestSearchMessagesFilter(t *testing.T) {
+	c, seen, cleanup := fakeServer(t, func(inv invocation) interface{} {
+		switch inv.Name {
+		case "Mailbox/get":
+			return mailboxes()
+		case "Email/query":
+			return map[string]interface{}{"ids": []string{"m1"}}
+		case "Email/get":
+			return map[string]interface{}{
+				"list": []map[string]interface{}{
+					{"id": "m1", "subject": "hi", "receivedAt": "2026-01-02T03:04:05Z",
+						"from": []map[string]string{{"name": "Alice", "email": "alice@example.com"}}},
+				},
+			}
+		}
+		return map[string]interface{}{}
+	})
+	defer cleanup()
+
+	messages, err := c.SearchMessages("INBOX", "FROM alice UNSEEN hello", 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, "hi", messages[0].Subject)
+	assert.Equal(t, "Alice", messages[0].From)
+
+	// The second request carries the query; check the token translation.
+	require.Len(t, *seen, 2)
+	var queryArgs struct {
+		Filter struct {
+			Operator   string                   `json:"operator"`
+			Conditions []map[string]interface{} `json:"conditions"`
+		} `json:"filter"`
+	}
+	require.Equal(t, "Email/query", (*seen)[1].MethodCalls[0].Name)
+	require.NoError(t, json.Unmarshal((*seen)[1].MethodCalls[0].Args, &queryArgs))
+	assert.Equal(t, "AND", queryArgs.Filter.Operator)
+	assert.Equal(t, []map[string]interface{}{
+		{"inMailbox": "mb-in"},
+		{"from": "alice"},
+		{"notKeyword": "$seen"},
+		{"text": "hello"},
+	}, queryArgs.Filter.Conditions)
+}
+
+func TestCallErrorEnvelope(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"methodResponses":[["error",{"type":"unknownMethod","description":"nope"},"mb"]]}`))
+	}))
+	defer srv.Close()
+
+	c := &Client{httpClient: srv.Client(), apiURL: srv.URL, accountID: "acct-1"}
+	_, err := c.mailboxID("INBOX")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "JMAP error unknownMethod: nope")
+}
+
+func TestSendMessage(t *testing.T) {
+	c, seen, cleanup := fakeServer(t, func(inv invocation) interface{} {
+		switch inv.Name {
+		case "Mailbox/get":
+			return mailboxes()
+		case "Identity/get":
+			return map[string]interface{}{
+				"list": []map[string]string{{"id": "ident-1", "email": "USER@example.com"}},
+			}
+		case "Email/set":
+			return map[string]interface{}{"created": map[string]interface{}{"draft": map[string]string{"id": "e1"}}}
+		case "EmailSubmission/set":
+			return map[string]interface{}{"created": map[string]interface{}{"submission": map[string]string{"id": "s1"}}}
+		}
+		return map[string]interface{}{}
+	})
+	defer cleanup()
+
+	err := c.SendMessage("user@example.com", []string{"to@example.com"}, nil, nil, "hi", "body")
+	require.NoError(t, err)
+
+	// Last request holds Email/set + EmailSubmission/set and must declare
+	// the submission capability and the resolved identity.
+	last := (*seen)[len(*seen)-1]
+	assert.Contains(t, last.Using, usingSubmission)
+	require.Equal(t, "EmailSubmission/set", last.MethodCalls[1].Name)
+	var submitArgs struct {
+		Create map[string]struct {
+			IdentityID string `json:"identityId"`
+			EmailID    string `json:"emailId"`
+		} `json:"create"`
+	}
+	require.NoError(t, json.Unmarshal(last.MethodCalls[1].Args, &submitArgs))
+	assert.Equal(t, "ident-1", submitArgs.Create["submission"].IdentityID)
+	assert.Equal(t, "#draft", submitArgs.Create["submission"].EmailID)
+}
+
+func TestSendMessageNoIdentity(t *testing.T) {
+	c, _, cleanup := fakeServer(t, func(inv invocation) interface{} {
+		switch inv.Name {
+		case "Mailbox/get":
+			return mailboxes()
+		case "Identity/get":
+			return map[string]interface{}{"list": []map[string]string{}}
+		}
+		return map[string]interface{}{}
+	})
+	defer cleanup()
+
+	err := c.SendMessage("other@example.com", []string{"to@example.com"}, nil, nil, "hi", "body")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no sending identity for other@example.com")
+}
+
+func TestSendMessageDraftRejected(t *testing.T) {
+	c, _, cleanup := fakeServer(t, func(inv invocation) interface{} {
+		switch inv.Name {
+		case "Mailbox/get":
+			return mailboxes()
+		case "Identity/get":
+			return map[string]interface{}{
+				"list": []map[string]string{{"id": "ident-1", "email": "user@example.com"}},
+			}
+		case "Email/set":
+			return map[string]interface{}{
+				"notCreated": map[string]interface{}{
+					"draft": map[string]string{"type": "invalidProperties", "description": "bad to"},
+				},
+			}
+		}
+		return map[string]interface{}{}
+	})
+	defer cleanup()
+
+	err := c.SendMessage("user@example.com", []string{"bad"}, nil, nil, "hi", "body")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "draft creation failed invalidProperties: bad to")
+}
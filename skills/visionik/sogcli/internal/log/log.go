@@ -0,0 +1,166 @@
+// Package log provides leveled logging for sog with protocol wire
+// tracing helpers. Credentials are redacted before anything reaches the
+// log output, which goes to stderr so it never mixes with command output.
+package log
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is a logging severity level.
+type Level int
+
+// Log levels, from least to most verbose.
+const (
+	LevelError Level = iota
+	LevelWarn
+	LevelInfo
+	LevelDebug
+)
+
+var (
+	mu      sync.Mutex
+	current = LevelWarn
+)
+
+// SetLevel sets the global log level.
+func SetLevel(level Level) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = level
+}
+
+// DebugEnabled reports whether debug (wire tracing) output is on.
+func DebugEnabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return current >= LevelDebug
+}
+
+// logf writes one log line to stderr if the level is enabled.
+func logf(level Level, tag, format string, args ...interface{}) {
+	mu.Lock()
+	enabled := current >= level
+	mu.Unlock()
+	if !enabled {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s %-5s %s\n",
+		time.Now().Format("15:04:05.000"), tag, fmt.Sprintf(format, args...))
+}
+
+// Debugf logs at debug level.
+func Debugf(format string, args ...interface{}) { logf(LevelDebug, "DEBUG", format, args...) }
+
+// Infof logs at info level.
+func Infof(format string, args ...interface{}) { logf(LevelInfo, "INFO", format, args...) }
+
+// Warnf logs at warn level.
+func Warnf(format string, args ...interface{}) { logf(LevelWarn, "WARN", format, args...) }
+
+// Errorf logs at error level.
+func Errorf(format string, args ...interface{}) { logf(LevelError, "ERROR", format, args...) }
+
+// redactPatterns match credential-bearing protocol lines. The first
+// capture group is kept; the rest of the line is masked.
+var redactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)^(\S+ LOGIN )\S.*$`),           // IMAP LOGIN user pass
+	regexp.MustCompile(`(?i)^(AUTH PLAIN ).*$`),            // SMTP AUTH PLAIN <b64>
+	regexp.MustCompile(`(?i)^(AUTH LOGIN).*$`),             // SMTP AUTH LOGIN
+	regexp.MustCompile(`(?i)^(PASS ).*$`),                  // generic PASS
+	regexp.MustCompile(`(?i)^(Authorization:\s*\w+\s).*$`), // HTTP auth header
+	regexp.MustCompile(`(?i)("password"\s*:\s*")[^"]*(")`), // JSON password field
+}
+
+// Redact masks credentials in a protocol line.
+func Redact(line string) string {
+	for _, re := range redactPatterns {
+		if re.NumSubexp() > 1 {
+			line = re.ReplaceAllString(line, "${1}[REDACTED]${2}")
+		} else {
+			line = re.ReplaceAllString(line, "${1}[REDACTED]")
+		}
+	}
+	return line
+}
+
+// protocolWriter splits written data into lines and logs each one,
+// redacted, tagged with its protocol name.
+type protocolWriter struct {
+	proto string
+	mu    sync.Mutex
+	buf   []byte
+}
+
+// NewProtocolWriter returns an io.Writer that traces wire data at debug
+// level, one redacted line at a time.
+func NewProtocolWriter(proto string) *protocolWriter {
+	return &protocolWriter{proto: proto}
+}
+
+// Write implements io.Writer.
+func (w *protocolWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := strings.IndexByte(string(w.buf), '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimRight(string(w.buf[:i]), "\r")
+		w.buf = w.buf[i+1:]
+		if line != "" {
+			Debugf("%s %s", w.proto, Redact(line))
+		}
+	}
+	return len(p), nil
+}
+
+// tracingTransport logs HTTP requests and responses at debug level.
+type tracingTransport struct {
+	proto string
+	base  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	Debugf("%s > %s %s", t.proto, req.Method, req.URL.Redacted())
+	start := time.Now()
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		Debugf("%s < error after %s: %v", t.proto, time.Since(start).Round(time.Millisecond), err)
+		return nil, err
+	}
+
+	Debugf("%s < %s in %s", t.proto, resp.Status, time.Since(start).Round(time.Millisecond))
+	return resp, nil
+}
+
+// HTTPClient returns an HTTP client that traces requests at debug level
+// under the given protocol tag. Without --debug it returns base (or
+// http.DefaultClient when base is nil) unchanged.
+func HTTPClient(base *http.Client, proto string) *http.Client {
+	if base == nil {
+		base = http.DefaultClient
+	}
+	if !DebugEnabled() {
+		return base
+	}
+
+	transport := base.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	wrapped := *base
+	wrapped.Transport = &tracingTransport{proto: proto, base: transport}
+	return &wrapped
+}
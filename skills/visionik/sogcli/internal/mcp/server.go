@@ -0,0 +1,189 @@
+// Package mcp implements a minimal Model Context Protocol server over
+// stdio. It speaks JSON-RPC 2.0 with newline-delimited messages and
+// supports the initialize / tools/list / tools/call subset needed to
+// expose CLI capabilities as agent-callable tools.
+package mcp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// protocolVersion is the MCP revision this server implements.
+const protocolVersion = "2024-11-05"
+
+// Tool is a callable capability exposed to MCP clients.
+type Tool struct {
+	Name        string
+	Description string
+	// InputSchema is a JSON Schema object describing the arguments.
+	InputSchema map[string]interface{}
+	// Handler executes the tool and returns its text result.
+	Handler func(args map[string]interface{}) (string, error)
+}
+
+// Server dispatches MCP requests to registered tools.
+type Server struct {
+	name    string
+	version string
+	tools   []Tool
+	byName  map[string]*Tool
+}
+
+// NewServer creates an MCP server identifying itself with name/version.
+func NewServer(name, version string) *Server {
+	return &Server{
+		name:    name,
+		version: version,
+		byName:  make(map[string]*Tool),
+	}
+}
+
+// Register adds a tool to the server.
+func (s *Server) Register(tool Tool) {
+	s.tools = append(s.tools, tool)
+	s.byName[tool.Name] = &s.tools[len(s.tools)-1]
+}
+
+// request is an incoming JSON-RPC 2.0 message.
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response is an outgoing JSON-RPC 2.0 message.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is a JSON-RPC 2.0 error object.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// JSON-RPC 2.0 error codes.
+const (
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+// Serve reads newline-delimited JSON-RPC messages from r and writes
+// responses to w until EOF.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	enc := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			enc.Encode(response{
+				JSONRPC: "2.0",
+				Error:   &rpcError{Code: codeParseError, Message: "parse error"},
+			})
+			continue
+		}
+
+		resp := s.handle(&req)
+		if resp == nil {
+			continue // notification; no response
+		}
+		if err := enc.Encode(resp); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+	}
+	return scanner.Err()
+}
+
+// handle dispatches a single request. Notifications return nil.
+func (s *Server) handle(req *request) *response {
+	// Notifications (no id) never get a response
+	if req.ID == nil {
+		return nil
+	}
+
+	resp := &response{JSONRPC: "2.0", ID: req.ID}
+
+	switch req.Method {
+	case "initialize":
+		resp.Result = map[string]interface{}{
+			"protocolVersion": protocolVersion,
+			"capabilities": map[string]interface{}{
+				"tools": map[string]interface{}{},
+			},
+			"serverInfo": map[string]interface{}{
+				"name":    s.name,
+				"version": s.version,
+			},
+		}
+
+	case "ping":
+		resp.Result = map[string]interface{}{}
+
+	case "tools/list":
+		tools := make([]map[string]interface{}, 0, len(s.tools))
+		for _, t := range s.tools {
+			schema := t.InputSchema
+			if schema == nil {
+				schema = map[string]interface{}{"type": "object"}
+			}
+			tools = append(tools, map[string]interface{}{
+				"name":        t.Name,
+				"description": t.Description,
+				"inputSchema": schema,
+			})
+		}
+		resp.Result = map[string]interface{}{"tools": tools}
+
+	case "tools/call":
+		var params struct {
+			Name      string                 `json:"name"`
+			Arguments map[string]interface{} `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			resp.Error = &rpcError{Code: codeInvalidParams, Message: "invalid params"}
+			break
+		}
+		tool, ok := s.byName[params.Name]
+		if !ok {
+			resp.Error = &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", params.Name)}
+			break
+		}
+		text, err := tool.Handler(params.Arguments)
+		if err != nil {
+			// Tool failures are reported in-band per the MCP spec
+			resp.Result = map[string]interface{}{
+				"content": []map[string]interface{}{
+					{"type": "text", "text": err.Error()},
+				},
+				"isError": true,
+			}
+			break
+		}
+		resp.Result = map[string]interface{}{
+			"content": []map[string]interface{}{
+				{"type": "text", "text": text},
+			},
+		}
+
+	default:
+		resp.Error = &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+	}
+
+	return resp
+}
@@ -0,0 +1,175 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testServer builds a server with one echoing tool and one failing tool.
+func testServer() *Server {
+	s := NewServer("sog", "test")
+	s.Register(Tool{
+		Name:        "echo",
+		Description: "echoes its input",
+		InputSchema: map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"text": map[string]interface{}{"type": "string"}},
+		},
+		Handler: func(args map[string]interface{}) (string, error) {
+			text, _ := args["text"].(string)
+			return "echo: " + text, nil
+		},
+	})
+	s.Register(Tool{
+		Name:        "boom",
+		Description: "always fails",
+		Handler: func(args map[string]interface{}) (string, error) {
+			return "", errors.New("it broke")
+		},
+	})
+	return s
+}
+
+// serve feeds newline-delimited input through Serve and returns the
+// decoded responses, one per output line.
+func serve(t *testing.T, input string) []response {
+	t.Helper()
+	var out bytes.Buffer
+	require.NoError(t, testServer().Serve(strings.NewReader(input), &out))
+
+	var responses []response
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp response
+		require.NoError(t, json.Unmarshal([]byte(line), &resp))
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// resultMap re-decodes a response result into a map for assertions.
+func resultMap(t *testing.T, resp response) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(resp.Result)
+	require.NoError(t, err)
+	var m map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &m))
+	return m
+}
+
+func TestServeInitialize(t *testing.T) {
+	responses := serve(t, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`+"\n")
+	require.Len(t, responses, 1)
+
+	resp := responses[0]
+	assert.Equal(t, "2.0", resp.JSONRPC)
+	assert.Equal(t, json.RawMessage("1"), resp.ID)
+	require.Nil(t, resp.Error)
+
+	result := resultMap(t, resp)
+	assert.Equal(t, protocolVersion, result["protocolVersion"])
+	info := result["serverInfo"].(map[string]interface{})
+	assert.Equal(t, "sog", info["name"])
+}
+
+func TestServeToolsList(t *testing.T) {
+	responses := serve(t, `{"jsonrpc":"2.0","id":"a","method":"tools/list"}`+"\n")
+	require.Len(t, responses, 1)
+
+	result := resultMap(t, responses[0])
+	tools := result["tools"].([]interface{})
+	require.Len(t, tools, 2)
+
+	echo := tools[0].(map[string]interface{})
+	assert.Equal(t, "echo", echo["name"])
+	assert.Equal(t, "echoes its input", echo["description"])
+	schema := echo["inputSchema"].(map[string]interface{})
+	assert.Equal(t, "object", schema["type"])
+	assert.Contains(t, schema, "properties")
+
+	// A tool registered without a schema gets the empty-object default.
+	boom := tools[1].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "object"}, boom["inputSchema"])
+}
+
+func TestServeToolsCall(t *testing.T) {
+	responses := serve(t, `{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{"text":"hi"}}}`+"\n")
+	require.Len(t, responses, 1)
+	require.Nil(t, responses[0].Error)
+
+	result := resultMap(t, responses[0])
+	content := result["content"].([]interface{})
+	require.Len(t, content, 1)
+	part := content[0].(map[string]interface{})
+	assert.Equal(t, "text", part["type"])
+	assert.Equal(t, "echo: hi", part["text"])
+	assert.NotContains(t, result, "isError")
+}
+
+func TestServeToolErrorIsInBand(t *testing.T) {
+	responses := serve(t, `{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"boom","arguments":{}}}`+"\n")
+	require.Len(t, responses, 1)
+
+	// Handler failures are tool results, not JSON-RPC errors.
+	require.Nil(t, responses[0].Error)
+	result := resultMap(t, responses[0])
+	assert.Equal(t, true, result["isError"])
+	part := result["content"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "it broke", part["text"])
+}
+
+func TestServeProtocolErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantCode int
+		wantMsg  string
+	}{
+		{"parse error", `{not json`, codeParseError, "parse error"},
+		{"method not found", `{"jsonrpc":"2.0","id":1,"method":"resources/list"}`, codeMethodNotFound, "method not found: resources/list"},
+		{"invalid call params", `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":"nope"}`, codeInvalidParams, "invalid params"},
+		{"unknown tool", `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"nope"}}`, codeInvalidParams, "unknown tool: nope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := serve(t, tt.input+"\n")
+			require.Len(t, responses, 1)
+			require.NotNil(t, responses[0].Error)
+			assert.Equal(t, tt.wantCode, responses[0].Error.Code)
+			assert.Equal(t, tt.wantMsg, responses[0].Error.Message)
+		})
+	}
+}
+
+func TestServeSuppressesNotifications(t *testing.T) {
+	input := `{"jsonrpc":"2.0","method":"notifications/initialized"}` + "\n" +
+		"\n" + // blank lines are skipped
+		`{"jsonrpc":"2.0","id":7,"method":"ping"}` + "\n"
+
+	responses := serve(t, input)
+	require.Len(t, responses, 1)
+	assert.Equal(t, json.RawMessage("7"), responses[0].ID)
+	assert.Nil(t, responses[0].Error)
+}
+
+func TestServeMultipleRequests(t *testing.T) {
+	var input strings.Builder
+	for i := 1; i <= 3; i++ {
+		fmt.Fprintf(&input, `{"jsonrpc":"2.0","id":%d,"method":"ping"}`+"\n", i)
+	}
+
+	responses := serve(t, input.String())
+	require.Len(t, responses, 3)
+	for i, resp := range responses {
+		assert.Equal(t, json.RawMessage(fmt.Sprint(i+1)), resp.ID)
+	}
+}
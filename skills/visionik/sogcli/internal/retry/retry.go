@@ -0,0 +1,208 @@
+// Package retry provides a shared retry layer for sog's protocol
+// clients. It backs off exponentially on transient network errors and
+// honors Retry-After on 429/503 HTTP responses. The active policy is
+// package-global, set once per invocation from the account config,
+// mirroring how the log package is configured.
+package retry
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/visionik/sogcli/internal/log"
+)
+
+// Policy controls retry behaviour. The zero value means "use defaults".
+type Policy struct {
+	MaxAttempts int           // total attempts including the first (default 3)
+	BaseDelay   time.Duration // delay before the first retry (default 500ms)
+	MaxDelay    time.Duration // cap on the backoff delay (default 30s)
+}
+
+// normalize fills in defaults for zero fields.
+func (p Policy) normalize() Policy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = 500 * time.Millisecond
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = 30 * time.Second
+	}
+	return p
+}
+
+var (
+	mu     sync.Mutex
+	active Policy
+)
+
+// Configure sets the active policy for this invocation.
+func Configure(p Policy) {
+	mu.Lock()
+	defer mu.Unlock()
+	active = p
+}
+
+// policy returns the active policy with defaults applied.
+func policy() Policy {
+	mu.Lock()
+	defer mu.Unlock()
+	return active.normalize()
+}
+
+// delay computes the backoff before retry attempt n (0-based), with a
+// little jitter so parallel operations do not retry in lockstep.
+func (p Policy) delay(n int) time.Duration {
+	d := p.BaseDelay << uint(n)
+	if d > p.MaxDelay || d <= 0 {
+		d = p.MaxDelay
+	}
+	return d + time.Duration(rand.Int63n(int64(d)/4+1))
+}
+
+// Do runs op, retrying on transient errors per the active policy.
+func Do(op func() error) error {
+	p := policy()
+	var err error
+	for attempt := 0; attempt < p.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			d := p.delay(attempt - 1)
+			log.Debugf("retry: attempt %d/%d after %s: %v", attempt+1, p.MaxAttempts, d.Round(time.Millisecond), err)
+			time.Sleep(d)
+		}
+		if err = op(); err == nil || !IsTransient(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// IsTransient reports whether an error looks like a temporary network
+// failure worth retrying (timeouts, resets, stale connections).
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	msg := err.Error()
+	for _, s := range []string{
+		"connection reset",
+		"connection refused",
+		"broken pipe",
+		"use of closed network connection",
+		"i/o timeout",
+		"no route to host",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// transport retries HTTP requests per the active policy.
+type transport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper. Requests are only retried when
+// their body can be replayed (no body, or GetBody set). 429 and 503
+// responses honor Retry-After when present.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	replayable := req.Body == nil || req.GetBody != nil
+	p := policy()
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < p.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			if req.GetBody != nil {
+				body, bodyErr := req.GetBody()
+				if bodyErr != nil {
+					return resp, err
+				}
+				req.Body = body
+			}
+		}
+
+		resp, err = t.base.RoundTrip(req)
+		if err != nil {
+			if !replayable || !IsTransient(err) {
+				return nil, err
+			}
+			d := p.delay(attempt)
+			log.Debugf("retry: HTTP %s %s failed (%v), retrying in %s", req.Method, req.URL.Redacted(), err, d.Round(time.Millisecond))
+			time.Sleep(d)
+			continue
+		}
+
+		if !replayable || !retryableStatus(resp.StatusCode) || attempt == p.MaxAttempts-1 {
+			return resp, nil
+		}
+
+		d := retryAfter(resp)
+		if d <= 0 {
+			d = p.delay(attempt)
+		}
+		if d > p.MaxDelay {
+			// Server asked for longer than we are willing to wait.
+			return resp, nil
+		}
+		log.Debugf("retry: HTTP %s %s got %s, retrying in %s", req.Method, req.URL.Redacted(), resp.Status, d.Round(time.Millisecond))
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		time.Sleep(d)
+	}
+	return resp, err
+}
+
+// retryableStatus reports whether a status code indicates a retryable
+// server condition.
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
+
+// retryAfter parses the Retry-After header (seconds or HTTP date).
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+// HTTPClient returns a client that retries per the active policy. A nil
+// base means http.DefaultClient.
+func HTTPClient(base *http.Client) *http.Client {
+	if base == nil {
+		base = http.DefaultClient
+	}
+	inner := base.Transport
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+	wrapped := *base
+	wrapped.Transport = &transport{base: inner}
+	return &wrapped
+}
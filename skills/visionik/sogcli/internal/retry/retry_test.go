@@ -0,0 +1,167 @@
+package retry
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyNormalize(t *testing.T) {
+	p := Policy{}.normalize()
+	assert.Equal(t, 3, p.MaxAttempts)
+	assert.Equal(t, 500*time.Millisecond, p.BaseDelay)
+	assert.Equal(t, 30*time.Second, p.MaxDelay)
+
+	p = Policy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Minute}.normalize()
+	assert.Equal(t, 5, p.MaxAttempts)
+	assert.Equal(t, time.Second, p.BaseDelay)
+	assert.Equal(t, time.Minute, p.MaxDelay)
+}
+
+func TestPolicyDelay(t *testing.T) {
+	p := Policy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}.normalize()
+
+	// First retry: base delay plus up to 25% jitter.
+	d := p.delay(0)
+	assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+	assert.LessOrEqual(t, d, 125*time.Millisecond)
+
+	// Past the cap the delay sticks at MaxDelay (+jitter).
+	d = p.delay(10)
+	assert.GreaterOrEqual(t, d, time.Second)
+	assert.LessOrEqual(t, d, 1250*time.Millisecond)
+
+	// A shift big enough to overflow int64 must also fall back to MaxDelay.
+	d = p.delay(62)
+	assert.GreaterOrEqual(t, d, time.Second)
+	assert.LessOrEqual(t, d, 1250*time.Millisecond)
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	assert.Equal(t, time.Duration(0), retryAfter(resp))
+
+	resp.Header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, retryAfter(resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(90*time.Second).UTC().Format(http.TimeFormat))
+	d := retryAfter(resp)
+	assert.Greater(t, d, 80*time.Second)
+	assert.LessOrEqual(t, d, 90*time.Second)
+
+	resp.Header.Set("Retry-After", "soon")
+	assert.Equal(t, time.Duration(0), retryAfter(resp))
+}
+
+// timeoutErr implements net.Error with Timeout() true.
+type timeoutErr struct{}
+
+func (timeoutErr) Error() string   { return "deadline exceeded" }
+func (timeoutErr) Timeout() bool   { return true }
+func (timeoutErr) Temporary() bool { return true }
+
+func TestIsTransient(t *testing.T) {
+	assert.False(t, IsTransient(nil))
+	assert.False(t, IsTransient(errors.New("boom")))
+	assert.True(t, IsTransient(timeoutErr{}))
+	assert.True(t, IsTransient(io.EOF))
+	assert.True(t, IsTransient(io.ErrUnexpectedEOF))
+	assert.True(t, IsTransient(errors.New("read tcp: connection reset by peer")))
+	assert.True(t, IsTransient(errors.New("dial tcp: connection refused")))
+}
+
+func TestDoRetriesTransient(t *testing.T) {
+	Configure(Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond})
+	defer Configure(Policy{})
+
+	calls := 0
+	err := Do(func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("connection reset by peer")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Permanent errors are returned immediately.
+	calls = 0
+	err = Do(func() error {
+		calls++
+		return errors.New("boom")
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRoundTripRetriesServerBusy(t *testing.T) {
+	Configure(Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond})
+	defer Configure(Policy{})
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	resp, err := HTTPClient(srv.Client()).Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRoundTripSkipsNonReplayableBody(t *testing.T) {
+	Configure(Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond})
+	defer Configure(Policy{})
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequest("POST", srv.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	req.GetBody = nil // streaming body: must not be retried
+
+	resp, err := HTTPClient(srv.Client()).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRoundTripGivesUpOnLongRetryAfter(t *testing.T) {
+	Configure(Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond})
+	defer Configure(Policy{})
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	resp, err := HTTPClient(srv.Client()).Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 1, calls)
+}
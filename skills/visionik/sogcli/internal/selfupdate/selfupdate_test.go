@@ -0,0 +1,135 @@
+package selfupdate
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNewer(t *testing.T) {
+	tests := []struct {
+		current, latest string
+		want            bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{"v1.2.3", "1.2.3", false},
+		{"dev", "1.0.0", true},
+		{"", "1.0.0", true},
+		{"1.2.3", "1.2.4", true},
+		{"1.2.3", "1.3.0", true},
+		{"1.2.3", "2.0.0", true},
+		{"1.3.0", "1.2.9", false},
+		{"2.0.0", "1.9.9", false},
+		{"1.2", "1.2.0", false},
+		{"1.2", "1.2.1", true},
+		// Non-numeric versions update whenever they differ.
+		{"1.2.3-rc1", "1.2.3", true},
+		{"1.2.3", "1.2.3-rc1", true},
+		{"nightly-42", "nightly-43", true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, IsNewer(tt.current, tt.latest),
+			"IsNewer(%q, %q)", tt.current, tt.latest)
+	}
+}
+
+// signedRelease generates a signing key, installs it as PublicKey for the
+// test, and returns the signature over sumHex.
+func signedRelease(t *testing.T, sumHex string) string {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	old := PublicKey
+	PublicKey = base64.StdEncoding.EncodeToString(pub)
+	t.Cleanup(func() { PublicKey = old })
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, []byte(sumHex)))
+}
+
+func TestVerifySignature(t *testing.T) {
+	sumHex := hex.EncodeToString([]byte("digest"))
+	sig := signedRelease(t, sumHex)
+
+	assert.NoError(t, verifySignature(sumHex, sig))
+
+	err := verifySignature(hex.EncodeToString([]byte("other")), sig)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signature verification failed")
+
+	err = verifySignature(sumHex, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsigned")
+
+	err = verifySignature(sumHex, "not-base64!")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid release signature encoding")
+}
+
+func TestVerifySignatureBadKey(t *testing.T) {
+	old := PublicKey
+	PublicKey = "short"
+	defer func() { PublicKey = old }()
+
+	err := verifySignature("aa", "aa")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid release signing key")
+}
+
+func TestDownload(t *testing.T) {
+	binary := []byte("fake-binary")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(binary)
+	}))
+	defer srv.Close()
+
+	sum := sha256.Sum256(binary)
+	rel := &Release{Version: "1.0.0", URL: srv.URL, SHA256: hex.EncodeToString(sum[:])}
+
+	got, err := Download(rel)
+	require.NoError(t, err)
+	assert.Equal(t, binary, got)
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("tampered"))
+	}))
+	defer srv.Close()
+
+	sum := sha256.Sum256([]byte("original"))
+	rel := &Release{Version: "1.0.0", URL: srv.URL, SHA256: hex.EncodeToString(sum[:])}
+
+	_, err := Download(rel)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+}
+
+func TestDownloadUnsignedWithKeyConfigured(t *testing.T) {
+	binary := []byte("fake-binary")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(binary)
+	}))
+	defer srv.Close()
+
+	sum := sha256.Sum256(binary)
+	sumHex := hex.EncodeToString(sum[:])
+	sig := signedRelease(t, sumHex)
+
+	// Signed release passes.
+	rel := &Release{Version: "1.0.0", URL: srv.URL, SHA256: sumHex, Signature: sig}
+	_, err := Download(rel)
+	require.NoError(t, err)
+
+	// The same release without a signature must be rejected.
+	rel.Signature = ""
+	_, err = Download(rel)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsigned")
+}
@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
+
 	"github.com/emersion/go-sasl"
 	"github.com/emersion/go-smtp"
 )
@@ -23,6 +26,10 @@ type Client struct {
 	noTLS    bool
 	email    string
 	password string
+
+	dkimKeyFile  string
+	dkimSelector string
+	dkimDomain   string
 }
 
 // Config holds SMTP connection configuration.
@@ -35,6 +42,12 @@ type Config struct {
 	NoTLS    bool // Disable TLS entirely
 	Email    string
 	Password string
+
+	// DKIM signing for direct sends. Signing is enabled when
+	// DKIMKeyFile is set; DKIMDomain defaults to the sender's domain.
+	DKIMKeyFile  string // Path to PEM-encoded RSA or Ed25519 private key
+	DKIMSelector string
+	DKIMDomain   string
 }
 
 // NewClient creates a new SMTP client.
@@ -48,6 +61,10 @@ func NewClient(cfg Config) *Client {
 		noTLS:    cfg.NoTLS,
 		email:    cfg.Email,
 		password: cfg.Password,
+
+		dkimKeyFile:  cfg.DKIMKeyFile,
+		dkimSelector: cfg.DKIMSelector,
+		dkimDomain:   cfg.DKIMDomain,
 	}
 }
 
@@ -134,41 +151,38 @@ func (c *Client) Send(ctx context.Context, msg *Message) error {
 		content.WriteString(msg.Body)
 	}
 
-	tlsConfig := &tls.Config{
-		ServerName:         c.host,
-		InsecureSkipVerify: c.insecure,
+	data := []byte(content.String())
+	if c.dkimKeyFile != "" {
+		signed, err := c.signDKIM(msg.From, data)
+		if err != nil {
+			return err
+		}
+		data = signed
 	}
 
-	var client *smtp.Client
-	var err error
-
-	if c.noTLS {
-		client, err = smtp.Dial(addr)
-	} else if c.tls {
-		client, err = smtp.DialTLS(addr, tlsConfig)
-	} else if c.startTLS {
-		client, err = smtp.DialStartTLS(addr, tlsConfig)
-	} else {
-		client, err = smtp.Dial(addr)
-	}
+	client, err := c.dial()
 	if err != nil {
-		return fmt.Errorf("failed to connect: %w", err)
+		return err
 	}
 	defer client.Close()
+	log.Debugf("SMTP connected to %s", addr)
 
 	// Authenticate
 	auth := sasl.NewPlainClient("", c.email, c.password)
+	log.Debugf("SMTP > AUTH PLAIN [REDACTED]")
 	if err := client.Auth(auth); err != nil {
 		return fmt.Errorf("failed to authenticate: %w", err)
 	}
 
 	// Set sender
+	log.Debugf("SMTP > MAIL FROM:<%s>", msg.From)
 	if err := client.Mail(msg.From, nil); err != nil {
 		return fmt.Errorf("failed to set sender: %w", err)
 	}
 
 	// Set recipients
 	for _, rcpt := range recipients {
+		log.Debugf("SMTP > RCPT TO:<%s>", rcpt)
 		if err := client.Rcpt(rcpt, nil); err != nil {
 			return fmt.Errorf("failed to set recipient %s: %w", rcpt, err)
 		}
@@ -180,17 +194,43 @@ func (c *Client) Send(ctx context.Context, msg *Message) error {
 		return fmt.Errorf("failed to start data: %w", err)
 	}
 
-	if _, err := wc.Write([]byte(content.String())); err != nil {
+	if _, err := wc.Write(data); err != nil {
 		return fmt.Errorf("failed to write data: %w", err)
 	}
 
 	if err := wc.Close(); err != nil {
 		return fmt.Errorf("failed to close data: %w", err)
 	}
+	log.Debugf("SMTP > DATA (%d bytes)", len(data))
 
 	return client.Quit()
 }
 
+// signDKIM signs the message with the configured DKIM key. The signing
+// domain defaults to the sender address's domain when not configured.
+func (c *Client) signDKIM(from string, data []byte) ([]byte, error) {
+	domain := c.dkimDomain
+	if domain == "" {
+		if _, d, ok := strings.Cut(from, "@"); ok {
+			domain = d
+		}
+	}
+	if domain == "" || c.dkimSelector == "" {
+		return nil, fmt.Errorf("DKIM signing requires a selector and domain")
+	}
+
+	s, err := loadSigner(c.dkimKeyFile, domain, c.dkimSelector)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := s.Sign(data)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("DKIM signed as d=%s s=%s", domain, c.dkimSelector)
+	return signed, nil
+}
+
 // generateBoundary generates a random MIME boundary.
 func generateBoundary() string {
 	b := make([]byte, 16)
@@ -198,32 +238,44 @@ func generateBoundary() string {
 	return fmt.Sprintf("----=_Part_%x", b)
 }
 
-// TestConnection tests the SMTP connection.
-func (c *Client) TestConnection() error {
+// dial establishes the SMTP connection, retrying transient network
+// failures per the account's retry policy.
+func (c *Client) dial() (*smtp.Client, error) {
 	addr := fmt.Sprintf("%s:%d", c.host, c.port)
 
-	var client *smtp.Client
-	var err error
-
 	tlsConfig := &tls.Config{
 		ServerName:         c.host,
 		InsecureSkipVerify: c.insecure,
 	}
 
-	if c.noTLS {
-		// Plain text connection
-		client, err = smtp.Dial(addr)
-	} else if c.tls {
-		// Direct TLS (SMTPS, port 465)
-		client, err = smtp.DialTLS(addr, tlsConfig)
-	} else if c.startTLS {
-		// STARTTLS (port 587)
-		client, err = smtp.DialStartTLS(addr, tlsConfig)
-	} else {
-		client, err = smtp.Dial(addr)
+	var client *smtp.Client
+	err := retry.Do(func() error {
+		var err error
+		if c.noTLS {
+			// Plain text connection
+			client, err = smtp.Dial(addr)
+		} else if c.tls {
+			// Direct TLS (SMTPS, port 465)
+			client, err = smtp.DialTLS(addr, tlsConfig)
+		} else if c.startTLS {
+			// STARTTLS (port 587)
+			client, err = smtp.DialStartTLS(addr, tlsConfig)
+		} else {
+			client, err = smtp.Dial(addr)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
 	}
+	return client, nil
+}
+
+// TestConnection tests the SMTP connection.
+func (c *Client) TestConnection() error {
+	client, err := c.dial()
 	if err != nil {
-		return fmt.Errorf("failed to connect: %w", err)
+		return err
 	}
 	defer client.Close()
 
@@ -235,3 +287,45 @@ func (c *Client) TestConnection() error {
 
 	return client.Quit()
 }
+
+// SendRaw sends a pre-built RFC822 message unchanged. Used for
+// redirect/bounce where the original message must not be rewrapped.
+func (c *Client) SendRaw(ctx context.Context, from string, recipients []string, raw []byte) error {
+	client, err := c.dial()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	auth := sasl.NewPlainClient("", c.email, c.password)
+	log.Debugf("SMTP > AUTH PLAIN [REDACTED]")
+	if err := client.Auth(auth); err != nil {
+		return fmt.Errorf("failed to authenticate: %w", err)
+	}
+
+	log.Debugf("SMTP > MAIL FROM:<%s>", from)
+	if err := client.Mail(from, nil); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	for _, rcpt := range recipients {
+		log.Debugf("SMTP > RCPT TO:<%s>", rcpt)
+		if err := client.Rcpt(rcpt, nil); err != nil {
+			return fmt.Errorf("failed to set recipient %s: %w", rcpt, err)
+		}
+	}
+
+	wc, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start data: %w", err)
+	}
+	if _, err := wc.Write(raw); err != nil {
+		return fmt.Errorf("failed to write data: %w", err)
+	}
+	if err := wc.Close(); err != nil {
+		return fmt.Errorf("failed to close data: %w", err)
+	}
+	log.Debugf("SMTP > DATA (%d bytes)", len(raw))
+
+	return client.Quit()
+}
@@ -0,0 +1,189 @@
+package smtp
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// signer signs outgoing messages with DKIM (RFC 6376) using
+// relaxed/relaxed canonicalization. RSA keys are signed with
+// rsa-sha256; Ed25519 keys with ed25519-sha256 (RFC 8463).
+type signer struct {
+	domain   string
+	selector string
+	key      crypto.Signer
+	algo     string
+}
+
+// signedHeaders is the header set included in the signature, in order.
+// Headers absent from the message are skipped.
+var signedHeaders = []string{
+	"from", "to", "cc", "reply-to", "subject", "date",
+	"message-id", "mime-version", "content-type",
+}
+
+// loadSigner reads a PEM-encoded private key from keyFile and returns a
+// signer for the given domain and selector.
+func loadSigner(keyFile, domain, selector string) (*signer, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read DKIM key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block in DKIM key file %s", keyFile)
+	}
+
+	var key crypto.Signer
+	if parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		s, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported DKIM key type %T", parsed)
+		}
+		key = s
+	} else if rsaKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		key = rsaKey
+	} else {
+		return nil, fmt.Errorf("failed to parse DKIM key: unsupported format")
+	}
+
+	var algo string
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		algo = "rsa-sha256"
+	case ed25519.PrivateKey:
+		algo = "ed25519-sha256"
+	default:
+		return nil, fmt.Errorf("unsupported DKIM key type %T", key)
+	}
+
+	return &signer{
+		domain:   domain,
+		selector: selector,
+		key:      key,
+		algo:     algo,
+	}, nil
+}
+
+// Sign returns the message with a DKIM-Signature header prepended.
+// The input must use CRLF line endings.
+func (s *signer) Sign(raw []byte) ([]byte, error) {
+	msg := string(raw)
+	headers, body, found := strings.Cut(msg, "\r\n\r\n")
+	if !found {
+		// Headers only, empty body.
+		headers = strings.TrimSuffix(msg, "\r\n")
+		body = ""
+	}
+
+	bodyHash := sha256.Sum256([]byte(relaxedBody(body)))
+	bh := base64.StdEncoding.EncodeToString(bodyHash[:])
+
+	// Pick the signed headers present in the message, last instance
+	// first per RFC 6376 section 5.4.2.
+	parsed := splitHeaders(headers)
+	var hNames []string
+	var canonHeaders []string
+	for _, name := range signedHeaders {
+		for i := len(parsed) - 1; i >= 0; i-- {
+			if headerName(parsed[i]) == name {
+				hNames = append(hNames, name)
+				canonHeaders = append(canonHeaders, relaxedHeader(parsed[i]))
+				break
+			}
+		}
+	}
+
+	dkimHeader := fmt.Sprintf(
+		"DKIM-Signature: v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s;\r\n"+
+			"\tt=%d; h=%s;\r\n\tbh=%s;\r\n\tb=",
+		s.algo, s.domain, s.selector, time.Now().Unix(),
+		strings.Join(hNames, ":"), bh)
+
+	// Hash the selected headers followed by the signature header itself
+	// (with empty b=), without a trailing CRLF.
+	h := sha256.New()
+	for _, ch := range canonHeaders {
+		h.Write([]byte(ch))
+	}
+	h.Write([]byte(strings.TrimSuffix(relaxedHeader(dkimHeader), "\r\n")))
+	digest := h.Sum(nil)
+
+	var sig []byte
+	var err error
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(cryptorand.Reader, key, crypto.SHA256, digest)
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(key, digest)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+
+	signed := dkimHeader + base64.StdEncoding.EncodeToString(sig) + "\r\n" + msg
+	return []byte(signed), nil
+}
+
+// splitHeaders splits a header block into individual headers,
+// keeping folded continuation lines attached to their header.
+func splitHeaders(block string) []string {
+	var headers []string
+	for _, line := range strings.Split(block, "\r\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(headers) > 0 {
+			headers[len(headers)-1] += "\r\n" + line
+			continue
+		}
+		headers = append(headers, line)
+	}
+	return headers
+}
+
+// headerName returns the lowercased field name of a raw header.
+func headerName(header string) string {
+	name, _, _ := strings.Cut(header, ":")
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+var wspRun = regexp.MustCompile(`[ \t]+`)
+
+// relaxedHeader canonicalizes one header per RFC 6376 section 3.4.2:
+// lowercase the name, unfold, collapse whitespace, trim the value.
+func relaxedHeader(header string) string {
+	name, value, _ := strings.Cut(header, ":")
+	value = strings.ReplaceAll(value, "\r\n", " ")
+	value = wspRun.ReplaceAllString(value, " ")
+	return strings.ToLower(strings.TrimSpace(name)) + ":" + strings.TrimSpace(value) + "\r\n"
+}
+
+// relaxedBody canonicalizes the body per RFC 6376 section 3.4.4:
+// strip trailing whitespace per line, collapse whitespace runs, and
+// remove trailing empty lines.
+func relaxedBody(body string) string {
+	lines := strings.Split(body, "\r\n")
+	for i, line := range lines {
+		line = wspRun.ReplaceAllString(line, " ")
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	out := strings.Join(lines, "\r\n")
+	out = strings.TrimRight(out, "\r\n")
+	if out == "" {
+		return ""
+	}
+	return out + "\r\n"
+}
@@ -0,0 +1,97 @@
+package smtp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelaxedBody(t *testing.T) {
+	// Vectors from RFC 6376 section 3.4.5.
+	assert.Equal(t, " C\r\nD E\r\n", relaxedBody(" C \r\nD \t E\r\n\r\n\r\n"))
+	assert.Equal(t, "", relaxedBody(""))
+	assert.Equal(t, "", relaxedBody("\r\n\r\n"))
+	assert.Equal(t, "hello\r\n", relaxedBody("hello"))
+}
+
+func TestRelaxedHeader(t *testing.T) {
+	assert.Equal(t, "a:X\r\n", relaxedHeader("A: X"))
+	assert.Equal(t, "b:Y Z", strings.TrimSuffix(relaxedHeader("B : Y\r\n\tZ  "), "\r\n"))
+	assert.Equal(t, "subject:Hello world\r\n", relaxedHeader("Subject:  Hello \t world"))
+}
+
+func TestSplitHeaders(t *testing.T) {
+	headers := splitHeaders("From: a@example.com\r\nSubject: one\r\n two\r\nTo: b@example.com")
+	require.Len(t, headers, 3)
+	assert.Equal(t, "Subject: one\r\n two", headers[1])
+	assert.Equal(t, "to", headerName(headers[2]))
+}
+
+func TestSignVerifiable(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "dkim.pem")
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyPath,
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	s, err := loadSigner(keyPath, "example.com", "mail")
+	require.NoError(t, err)
+	assert.Equal(t, "rsa-sha256", s.algo)
+
+	msg := "From: a@example.com\r\n" +
+		"To: b@example.com\r\n" +
+		"Subject: Test\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"\r\n" +
+		"Hello, world.\r\n"
+
+	signed, err := s.Sign([]byte(msg))
+	require.NoError(t, err)
+
+	text := string(signed)
+	require.True(t, strings.HasPrefix(text, "DKIM-Signature:"))
+	assert.True(t, strings.HasSuffix(text, msg), "original message must be unchanged")
+	assert.Contains(t, text, "d=example.com")
+	assert.Contains(t, text, "s=mail")
+	assert.Contains(t, text, "h=from:to:subject:mime-version")
+
+	// Recompute the header hash and verify the signature against it.
+	sigHeader := strings.TrimSuffix(strings.TrimSuffix(text, msg), "\r\n")
+	b := regexp.MustCompile(`b=([A-Za-z0-9+/=\s]+)$`).FindStringSubmatch(sigHeader)
+	require.NotNil(t, b)
+	sig, err := base64.StdEncoding.DecodeString(regexp.MustCompile(`\s`).ReplaceAllString(b[1], ""))
+	require.NoError(t, err)
+
+	unsigned := regexp.MustCompile(`b=[A-Za-z0-9+/=\s]+$`).ReplaceAllString(sigHeader, "b=")
+	h := sha256.New()
+	h.Write([]byte(relaxedHeader("From: a@example.com")))
+	h.Write([]byte(relaxedHeader("To: b@example.com")))
+	h.Write([]byte(relaxedHeader("Subject: Test")))
+	h.Write([]byte(relaxedHeader("MIME-Version: 1.0")))
+	h.Write([]byte(strings.TrimSuffix(relaxedHeader(unsigned), "\r\n")))
+
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, h.Sum(nil), sig))
+}
+
+func TestLoadSignerBadKey(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "bad.pem")
+	require.NoError(t, os.WriteFile(keyPath, []byte("not a key"), 0600))
+
+	_, err := loadSigner(keyPath, "example.com", "mail")
+	assert.Error(t, err)
+}
@@ -5,12 +5,14 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path"
 	"strings"
 	"time"
 
+	"github.com/visionik/sogcli/internal/log"
+	"github.com/visionik/sogcli/internal/retry"
+
 	"github.com/emersion/go-webdav"
 )
 
@@ -40,7 +42,7 @@ type FileInfo struct {
 
 // Connect establishes a connection to a WebDAV server.
 func Connect(cfg Config) (*Client, error) {
-	httpClient := webdav.HTTPClientWithBasicAuth(http.DefaultClient, cfg.Email, cfg.Password)
+	httpClient := webdav.HTTPClientWithBasicAuth(retry.HTTPClient(log.HTTPClient(nil, "WebDAV")), cfg.Email, cfg.Password)
 
 	client, err := webdav.NewClient(httpClient, cfg.URL)
 	if err != nil {